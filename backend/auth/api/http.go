@@ -55,10 +55,28 @@ func (a *APIServer) getHandler() http.Handler {
 	wrap("DELETE /v1/auth/logout", a.authHandler.LogOutHandler)
 	wrap("POST /v1/auth/verify-email", a.authHandler.RequestEmailVerificationHandler)
 
+	wrap("POST /v1/auth/login/2fa", a.authHandler.VerifyTwoFactorLogInHandler)
+	wrap("GET /v1/auth/2fa", a.authHandler.GetTwoFactorStatusHandler)
+	wrap("POST /v1/auth/2fa/totp/enroll", a.authHandler.BeginTOTPEnrollmentHandler)
+	wrap("POST /v1/auth/2fa/totp/confirm", a.authHandler.ConfirmTOTPEnrollmentHandler)
+	wrap("POST /v1/auth/2fa/totp/disable", a.authHandler.DisableTOTPHandler)
+
 	wrap("GET /v1/auth/google", a.authHandler.OAuthGoogleLoginHandler)
 	wrap("GET /v1/auth/google/callback", a.authHandler.OAuthGoogleCallBackHandler)
 	wrap("POST /v1/auth/google/mobile", a.authHandler.OAuthGoogleMobileHandler)
 
+	wrap("GET /v1/auth/oidc/providers", a.authHandler.GetOIDCProvidersHandler)
+	wrap("GET /v1/auth/oidc/{provider}", a.authHandler.OIDCLogInHandler)
+	wrap("GET /v1/auth/oidc/{provider}/link", a.authHandler.OIDCLinkHandler)
+	wrap("GET /v1/auth/oidc/{provider}/callback", a.authHandler.OIDCCallbackHandler)
+	wrap("POST /v1/auth/oidc/{provider}/mobile", a.authHandler.OIDCMobileHandler)
+	wrap("GET /v1/auth/identities", a.authHandler.GetAuthIdentitiesHandler)
+	wrap("DELETE /v1/auth/identities/{provider}", a.authHandler.UnlinkAuthIdentityHandler)
+
+	// the first one is for services inside the cluster, the second one is exposed through the gateway
+	wrap("GET /.well-known/jwks.json", a.authHandler.GetJWKSHandler)
+	wrap("GET /v1/auth/.well-known/jwks.json", a.authHandler.GetJWKSHandler)
+
 	sm.HandleFunc("GET /v1/health", a.generalHandler.RouteServiceHealth)
 	wrap("GET /", a.generalHandler.RouteNotFoundHandler)
 
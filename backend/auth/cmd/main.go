@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"sync"
@@ -14,6 +15,8 @@ import (
 	"sen1or/letslive/auth/repositories"
 	"sen1or/letslive/auth/services"
 
+	oidcgateway "sen1or/letslive/auth/gateway/oidc"
+	oidchttpgateway "sen1or/letslive/auth/gateway/oidc/http"
 	usergateway "sen1or/letslive/auth/gateway/user/http"
 
 	sharedconfig "sen1or/letslive/shared/config"
@@ -65,7 +68,10 @@ func main() {
 	dbConn := sharedutils.ConnectDB(ctx, config.Database.ConnectionString)
 	defer dbConn.Close()
 
-	server := SetupServer(dbConn, registry, config)
+	server, err := SetupServer(dbConn, registry, config)
+	if err != nil {
+		logger.Panicf(ctx, "failed to set up server: %v", err)
+	}
 	go func() {
 		logger.Infof(ctx, "starting server on %s:%d...", config.Service.Hostname, config.Service.APIPort)
 		// ListenAndServe should ideally block until an error occurs (e.g., server stopped)
@@ -109,16 +115,38 @@ func main() {
 	logger.Infof(shutdownCtx, "service shut down complete.")
 }
 
-func SetupServer(dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) *api.APIServer {
+func SetupServer(dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) (*api.APIServer, error) {
 	var userRepo = repositories.NewAuthRepository(dbConn)
 	var refreshTokenRepo = repositories.NewRefreshTokenRepository(dbConn)
 	var signUpOTPRepo = repositories.NewSignUpOTPRepo(dbConn)
+	var totpRepo = repositories.NewTOTPRepository(dbConn)
+	var twoFactorChallengeRepo = repositories.NewTwoFactorChallengeRepository(dbConn)
+	var authIdentityRepo = repositories.NewAuthIdentityRepository(dbConn)
 
 	userGateway := usergateway.NewUserGateway(registry)
 	var authService = services.NewAuthService(userRepo, userGateway)
 	var googleAuthService = services.NewGoogleAuthService(userRepo, userGateway)
-	var jwtService = services.NewJWTService(refreshTokenRepo, cfg.JWT)
+	jwtService, err := services.NewJWTService(refreshTokenRepo, cfg.JWT)
+	if err != nil {
+		return nil, err
+	}
 	var verificationService = services.NewVerificationService(signUpOTPRepo)
-	var authHandler = handlers.NewAuthHandler(*jwtService, *authService, *verificationService, *googleAuthService, cfg.Verification.Gateway)
-	return api.NewAPIServer(authHandler, registry, cfg, dbConn)
+	var twoFactorService = services.NewTwoFactorService(totpRepo, twoFactorChallengeRepo, userRepo, cfg.TwoFactor)
+
+	oidcProviders := make([]oidcgateway.Provider, 0, len(cfg.OIDC.Providers))
+	seenProviders := make(map[string]bool, len(cfg.OIDC.Providers))
+	for _, providerConfig := range cfg.OIDC.Providers {
+		if len(providerConfig.Name) == 0 || len(providerConfig.Issuer) == 0 || len(providerConfig.ClientID) == 0 {
+			return nil, fmt.Errorf("oidc provider %q requires a name, an issuer and a client id", providerConfig.Name)
+		}
+		if seenProviders[providerConfig.Name] {
+			return nil, fmt.Errorf("duplicated oidc provider %s", providerConfig.Name)
+		}
+		seenProviders[providerConfig.Name] = true
+		oidcProviders = append(oidcProviders, oidchttpgateway.NewOIDCProvider(providerConfig))
+	}
+	var oidcAuthService = services.NewOIDCAuthService(oidcProviders, userRepo, authIdentityRepo, userGateway)
+
+	var authHandler = handlers.NewAuthHandler(*jwtService, *authService, *verificationService, *googleAuthService, *twoFactorService, *oidcAuthService, cfg.Verification.Gateway)
+	return api.NewAPIServer(authHandler, registry, cfg, dbConn), nil
 }
@@ -7,12 +7,28 @@ import (
 	"strings"
 )
 
+// SigningKey points to a PEM encoded RSA (RS256) or Ed25519 (EdDSA) private key,
+// read either from a file or from an environment variable.
+// only the active key signs new tokens, the others are kept to verify tokens issued before a rotation.
+type SigningKey struct {
+	Kid            string `yaml:"kid"`
+	PrivateKeyPath string `yaml:"private-key-path"`
+	PrivateKeyEnv  string `yaml:"private-key-env"`
+	Active         bool   `yaml:"active"`
+}
+
 type JWT struct {
 	RefreshTokenMaxAge int    `yaml:"refresh-token-max-age"`
 	AccessTokenMaxAge  int    `yaml:"access-token-max-age"`
 	Consumer           string `yaml:"consumer"`
 	Issuer             string `yaml:"issuer"`
 	Subject            string `yaml:"subject"`
+
+	// when empty, tokens are signed with HS256 using ACCESS_TOKEN_SECRET/REFRESH_TOKEN_SECRET
+	AccessSigningKeys  []SigningKey `yaml:"access-signing-keys"`
+	RefreshSigningKeys []SigningKey `yaml:"refresh-signing-keys"`
+	// keep accepting HS256 tokens while migrating to asymmetric keys, so existing sessions survive the switch
+	AcceptLegacyTokens bool `yaml:"accept-legacy-tokens"`
 }
 
 type Service struct {
@@ -35,6 +51,33 @@ type Verification struct {
 	Gateway string `yaml:"gateway"`
 }
 
+type TwoFactor struct {
+	Issuer            string `yaml:"issuer"`              // shown in the authenticator app
+	ChallengeMaxAge   int    `yaml:"challenge-max-age"`   // in seconds
+	MaxAttempts       int    `yaml:"max-attempts"`        // codes that can be tried per login challenge
+	RecoveryCodeCount int    `yaml:"recovery-code-count"` // codes generated on enrollment
+}
+
+// OIDCProvider is an OpenID Connect provider users can log in with,
+// endpoints are discovered from {issuer}/.well-known/openid-configuration
+type OIDCProvider struct {
+	Name            string   `yaml:"name"`              // used in the routes, e.g. /v1/auth/oidc/{name}
+	DisplayName     string   `yaml:"display-name"`
+	Issuer          string   `yaml:"issuer"`
+	ClientID        string   `yaml:"client-id"`
+	ClientSecretEnv string   `yaml:"client-secret-env"` // name of the env variable holding the client secret
+	Scopes          []string `yaml:"scopes"`
+	RedirectURL     string   `yaml:"redirect-url"`
+	// link to an existing account with the same email on first login, only when the provider says the email is verified
+	TrustEmail bool `yaml:"trust-email"`
+	// client ids of native apps whose id tokens are accepted on the mobile endpoint
+	AdditionalAudiences []string `yaml:"additional-audiences"`
+}
+
+type OIDC struct {
+	Providers []OIDCProvider `yaml:"providers"`
+}
+
 type Tracer struct {
 	Endpoint     string `yaml:"endpoint"`
 	Secure       bool   `yaml:"secure"`
@@ -46,6 +89,8 @@ type Config struct {
 	JWT          `yaml:"jwt"`
 	Database     `yaml:"database"`
 	Verification `yaml:"verification"`
+	TwoFactor    `yaml:"two-factor"`
+	OIDC         `yaml:"oidc"`
 	Tracer       `yaml:"tracer"`
 }
 
@@ -55,7 +100,8 @@ func (c Config) GetTracerEndpoint() string   { return c.Tracer.Endpoint }
 func (c Config) GetTracerBatchTimeout() int  { return c.Tracer.BatchTimeout }
 func (c Config) IsSecure() bool              { return c.Tracer.Secure }
 
-// PostProcess builds the database connection string from environment variables.
+// PostProcess builds the database connection string from environment variables
+// and fills the two-factor and oidc defaults when they are not set in the config.
 func PostProcess(config *Config) error {
 	dbUser := os.Getenv("AUTH_DB_USER")
 	dbPassword := os.Getenv("AUTH_DB_PASSWORD")
@@ -71,5 +117,24 @@ func PostProcess(config *Config) error {
 	}
 	config.Database.ConnectionString = dbURL.String()
 
+	if len(config.TwoFactor.Issuer) == 0 {
+		config.TwoFactor.Issuer = "LetsLive"
+	}
+	if config.TwoFactor.ChallengeMaxAge <= 0 {
+		config.TwoFactor.ChallengeMaxAge = 300
+	}
+	if config.TwoFactor.MaxAttempts <= 0 {
+		config.TwoFactor.MaxAttempts = 5
+	}
+	if config.TwoFactor.RecoveryCodeCount <= 0 {
+		config.TwoFactor.RecoveryCodeCount = 10
+	}
+
+	for i := range config.OIDC.Providers {
+		if len(config.OIDC.Providers[i].Scopes) == 0 {
+			config.OIDC.Providers[i].Scopes = []string{"openid", "email", "profile"}
+		}
+	}
+
 	return nil
 }
@@ -23,4 +23,6 @@ type AuthRepository interface {
 
 	Create(ctx context.Context, auth Auth) (*Auth, *serviceresponse.Response[any])
 	UpdatePasswordHash(ctx context.Context, authId, newPasswordHash string) *serviceresponse.Response[any]
+	// DeleteByUserID removes the auth of a sign up that could not be finished, its identities go with it
+	DeleteByUserID(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any]
 }
@@ -0,0 +1,30 @@
+package domains
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// AuthIdentity links an account at an external OIDC provider (identified by the provider's subject) to an auth record,
+// a user can have at most one identity per provider
+type AuthIdentity struct {
+	Id          uuid.UUID  `json:"id" db:"id"`
+	UserId      uuid.UUID  `json:"userId" db:"user_id"`
+	Provider    string     `json:"provider" db:"provider"`
+	Subject     string     `json:"-" db:"subject"`
+	Email       *string    `json:"email" db:"email"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	LastLoginAt *time.Time `json:"lastLoginAt" db:"last_login_at"`
+}
+
+type AuthIdentityRepository interface {
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*AuthIdentity, *serviceresponse.Response[any])
+	GetByUserID(ctx context.Context, userId uuid.UUID) ([]AuthIdentity, *serviceresponse.Response[any])
+
+	Create(ctx context.Context, identity AuthIdentity) (*AuthIdentity, *serviceresponse.Response[any])
+	UpdateLastLoginAt(ctx context.Context, identityId uuid.UUID, lastLoginAt time.Time) *serviceresponse.Response[any]
+	Delete(ctx context.Context, userId uuid.UUID, provider string) *serviceresponse.Response[any]
+}
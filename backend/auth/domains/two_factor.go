@@ -0,0 +1,55 @@
+package domains
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// TOTPCredential holds the (encrypted) shared secret of a user's authenticator app.
+// the credential only protects the account once EnabledAt is set, before that it is a pending enrollment.
+type TOTPCredential struct {
+	Id           uuid.UUID  `json:"id" db:"id"`
+	UserId       uuid.UUID  `json:"userId" db:"user_id"`
+	Secret       string     `json:"-" db:"secret"`
+	EnabledAt    *time.Time `json:"enabledAt" db:"enabled_at"`
+	LastUsedStep *int64     `json:"-" db:"last_used_step"`
+	CreatedAt    time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// TwoFactorChallenge is issued after the first login step succeeds for an account with 2FA enabled,
+// only the sha256 hash of the token handed to the client is stored
+type TwoFactorChallenge struct {
+	Id        uuid.UUID  `json:"id" db:"id"`
+	UserId    uuid.UUID  `json:"userId" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	Attempts  int        `json:"attempts" db:"attempts"`
+	ExpiresAt time.Time  `json:"expiresAt" db:"expires_at"`
+	UsedAt    *time.Time `json:"usedAt" db:"used_at"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+}
+
+type TOTPRepository interface {
+	GetByUserID(ctx context.Context, userId uuid.UUID) (*TOTPCredential, *serviceresponse.Response[any])
+	// UpsertPending replaces any not yet enabled credential of the user with a new secret
+	UpsertPending(ctx context.Context, userId uuid.UUID, encryptedSecret string) *serviceresponse.Response[any]
+	// Enable marks the credential as enabled and replaces the recovery codes in a single transaction
+	Enable(ctx context.Context, userId uuid.UUID, usedStep int64, recoveryCodeHashes []string) *serviceresponse.Response[any]
+	// ConsumeStep records the time step of an accepted code, it fails if the step (or a later one) was already used
+	ConsumeStep(ctx context.Context, userId uuid.UUID, step int64) *serviceresponse.Response[any]
+	// ConsumeRecoveryCode marks an unused recovery code as used
+	ConsumeRecoveryCode(ctx context.Context, userId uuid.UUID, codeHash string) *serviceresponse.Response[any]
+	CountUnusedRecoveryCodes(ctx context.Context, userId uuid.UUID) (int, *serviceresponse.Response[any])
+	// Delete removes the credential along with its recovery codes
+	Delete(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any]
+}
+
+type TwoFactorChallengeRepository interface {
+	Insert(ctx context.Context, challenge TwoFactorChallenge) *serviceresponse.Response[any]
+	GetByTokenHash(ctx context.Context, tokenHash string) (*TwoFactorChallenge, *serviceresponse.Response[any])
+	// IncrementAttempts counts an attempt, it fails when the challenge is used, expired or out of attempts
+	IncrementAttempts(ctx context.Context, challengeId uuid.UUID, maxAttempts int) *serviceresponse.Response[any]
+	UpdateUsedAt(ctx context.Context, challengeId uuid.UUID, usedAt time.Time) *serviceresponse.Response[any]
+}
@@ -0,0 +1,12 @@
+package dto
+
+type OIDCProviderResponseDTO struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// native apps sign in with the provider sdk and hand over the id token they received
+type OIDCMobileLogInRequestDTO struct {
+	IDToken string `json:"idToken" validate:"required"`
+	Nonce   string `json:"nonce" validate:"omitempty,lte=255"`
+}
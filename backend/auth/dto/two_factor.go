@@ -0,0 +1,37 @@
+package dto
+
+type TOTPEnrollmentResponseDTO struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"`
+}
+
+type ConfirmTOTPRequestDTO struct {
+	Code string `json:"code" validate:"required,len=6,numeric" example:"123456"`
+}
+
+type RecoveryCodesResponseDTO struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// either the code from the authenticator app or one of the recovery codes has to be provided
+type DisableTOTPRequestDTO struct {
+	Password     string `json:"password" validate:"omitempty,lte=72"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recoveryCode" validate:"required_without=Code,omitempty,lte=32"`
+}
+
+type TwoFactorStatusResponseDTO struct {
+	Enabled                bool `json:"enabled"`
+	RemainingRecoveryCodes int  `json:"remainingRecoveryCodes"`
+}
+
+type TwoFactorChallengeResponseDTO struct {
+	ChallengeToken string `json:"challengeToken"`
+	ExpiresIn      int    `json:"expiresIn"`
+}
+
+type VerifyTwoFactorLogInRequestDTO struct {
+	ChallengeToken string `json:"challengeToken" validate:"required,lte=128"`
+	Code           string `json:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric"`
+	RecoveryCode   string `json:"recoveryCode" validate:"required_without=Code,omitempty,lte=32"`
+}
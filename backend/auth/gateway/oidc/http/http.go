@@ -0,0 +1,446 @@
+package gateway
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sen1or/letslive/auth/config"
+	oidcgateway "sen1or/letslive/auth/gateway/oidc"
+	"sen1or/letslive/auth/gateway/oidc/dto"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// signing algorithms accepted for id tokens, HS256 is left out on purpose since the client secret is not a signing key we trust
+var supportedSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// refetch the provider keys on an unknown kid at most once per this interval
+const jwksRefetchInterval = time.Minute
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// some providers send email_verified as a string
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = flexibleBool(v)
+	case string:
+		*b = flexibleBool(strings.EqualFold(v, "true"))
+	default:
+		*b = false
+	}
+
+	return nil
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+	Picture       string       `json:"picture"`
+}
+
+type userInfo struct {
+	Subject       string       `json:"sub"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+	Picture       string       `json:"picture"`
+}
+
+type oidcProvider struct {
+	config       config.OIDCProvider
+	clientSecret string
+	httpClient   *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+func NewOIDCProvider(cfg config.OIDCProvider) oidcgateway.Provider {
+	return &oidcProvider{
+		config:       cfg,
+		clientSecret: os.Getenv(cfg.ClientSecretEnv),
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *oidcProvider) DisplayName() string {
+	if len(p.config.DisplayName) == 0 {
+		return p.config.Name
+	}
+	return p.config.DisplayName
+}
+
+func (p *oidcProvider) TrustEmail() bool {
+	return p.config.TrustEmail
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	oauthConfig, err := p.oauthConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oauthConfig.AuthCodeURL(
+		state,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*dto.OIDCClaims, error) {
+	oauthConfig, err := p.oauthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("code exchange failed: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || len(rawIDToken) == 0 {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	claims, err := p.verify(ctx, rawIDToken, nonce, []string{p.config.ClientID})
+	if err != nil {
+		return nil, err
+	}
+
+	// the id token does not always carry the profile, ask the userinfo endpoint for it
+	if len(claims.Email) == 0 {
+		if err := p.fillFromUserInfo(ctx, token.AccessToken, claims); err != nil {
+			return nil, err
+		}
+	}
+
+	return claims, nil
+}
+
+func (p *oidcProvider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*dto.OIDCClaims, error) {
+	audiences := append([]string{p.config.ClientID}, p.config.AdditionalAudiences...)
+	return p.verify(ctx, rawIDToken, nonce, audiences)
+}
+
+func (p *oidcProvider) verify(ctx context.Context, rawIDToken, nonce string, audiences []string) (*dto.OIDCClaims, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(supportedSigningMethods),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if _, err := parser.ParseWithClaims(rawIDToken, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	}); err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool { return slices.Contains(audiences, aud) }) {
+		return nil, fmt.Errorf("id token audience %v not accepted", claims.Audience)
+	}
+
+	if len(nonce) > 0 && claims.Nonce != nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+
+	if len(claims.Subject) == 0 {
+		return nil, errors.New("id token has no subject")
+	}
+
+	return &dto.OIDCClaims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+func (p *oidcProvider) fillFromUserInfo(ctx context.Context, accessToken string, claims *dto.OIDCClaims) error {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(discovery.UserInfoEndpoint) == 0 {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.UserInfoEndpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	var info userInfo
+	if err := p.getJSON(req, &info); err != nil {
+		return fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+
+	// userinfo must describe the same user as the id token
+	if info.Subject != claims.Subject {
+		return errors.New("userinfo subject does not match the id token")
+	}
+
+	claims.Email = info.Email
+	claims.EmailVerified = bool(info.EmailVerified)
+	if len(claims.Name) == 0 {
+		claims.Name = info.Name
+	}
+	if len(claims.Picture) == 0 {
+		claims.Picture = info.Picture
+	}
+
+	return nil
+}
+
+func (p *oidcProvider) oauthConfig(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.clientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       p.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+	}, nil
+}
+
+// getDiscovery fetches the discovery document on first use, so an unreachable provider does not stop the service from starting
+func (p *oidcProvider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var discovery discoveryDocument
+	if err := p.getJSON(req, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to discover provider %s: %w", p.config.Name, err)
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovered issuer %s does not match the configured issuer %s", discovery.Issuer, p.config.Issuer)
+	}
+
+	if len(discovery.AuthorizationEndpoint) == 0 || len(discovery.TokenEndpoint) == 0 || len(discovery.JWKSURI) == 0 {
+		return nil, fmt.Errorf("discovery document of provider %s is missing endpoints", p.config.Name)
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// getKey looks the key up in the cached key set, refetching it when the kid is unknown since the provider may have rotated its keys
+func (p *oidcProvider) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+
+	if time.Since(p.keysFetchedAt) < jwksRefetchInterval {
+		return nil, fmt.Errorf("unknown kid %s", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(req, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if len(jwk.Use) > 0 && jwk.Use != "sig" {
+			continue
+		}
+
+		// keys with an unsupported type are skipped, the provider may publish keys we never need
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown kid %s", kid)
+}
+
+// lookupKey must be called with the lock held, a token without kid is only accepted when the provider has a single key
+func (p *oidcProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if len(kid) == 0 {
+		if len(p.keys) == 1 {
+			for _, key := range p.keys {
+				return key, true
+			}
+		}
+		return nil, false
+	}
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *oidcProvider) getJSON(req *http.Request, v any) error {
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", res.Status, req.URL)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("ec point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
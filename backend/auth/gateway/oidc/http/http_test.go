@@ -0,0 +1,253 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sen1or/letslive/auth/config"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// stubOIDCServer is a minimal OpenID provider: discovery, jwks, token and userinfo endpoints
+type stubOIDCServer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	// claims put into the id token returned by the token endpoint
+	idTokenClaims jwt.MapClaims
+	// code and verifier the token endpoint expects
+	code     string
+	verifier string
+	userInfo map[string]any
+}
+
+func newStubOIDCServer(t *testing.T) *stubOIDCServer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	s := &stubOIDCServer{t: t, key: key, kid: "stub-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 s.server.URL,
+			"authorization_endpoint": s.server.URL + "/authorize",
+			"token_endpoint":         s.server.URL + "/token",
+			"userinfo_endpoint":      s.server.URL + "/userinfo",
+			"jwks_uri":               s.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": s.kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != s.code || r.FormValue("code_verifier") != s.verifier {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "stub-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     s.sign(s.idTokenClaims),
+		})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer stub-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(s.userInfo)
+	})
+
+	s.server = httptest.NewServer(mux)
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+func (s *stubOIDCServer) sign(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.kid
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		s.t.Fatalf("failed to sign id token: %s", err)
+	}
+	return signed
+}
+
+func (s *stubOIDCServer) claims(aud, nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            s.server.URL,
+		"sub":            "subject-123",
+		"aud":            aud,
+		"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "someone@example.com",
+		"email_verified": true,
+		"name":           "Some One",
+	}
+}
+
+func newTestProvider(t *testing.T, s *stubOIDCServer) *oidcProvider {
+	t.Setenv("STUB_OIDC_CLIENT_SECRET", "secret")
+	return NewOIDCProvider(config.OIDCProvider{
+		Name:                "stub",
+		Issuer:              s.server.URL,
+		ClientID:            "web-client",
+		ClientSecretEnv:     "STUB_OIDC_CLIENT_SECRET",
+		Scopes:              []string{"openid", "email"},
+		RedirectURL:         "http://localhost/callback",
+		AdditionalAudiences: []string{"mobile-client"},
+	}).(*oidcProvider)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	s := newStubOIDCServer(t)
+	p := newTestProvider(t, s)
+
+	raw, err := p.AuthCodeURL(context.Background(), "the-state", "the-nonce", "the-verifier")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("invalid url: %s", err)
+	}
+
+	q := u.Query()
+	if u.Path != "/authorize" || q.Get("state") != "the-state" || q.Get("nonce") != "the-nonce" || q.Get("client_id") != "web-client" {
+		t.Errorf("unexpected authorization url %s", raw)
+	}
+	if q.Get("code_challenge_method") != "S256" || len(q.Get("code_challenge")) == 0 {
+		t.Errorf("authorization url is missing the pkce challenge: %s", raw)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	s := newStubOIDCServer(t)
+	p := newTestProvider(t, s)
+	s.code, s.verifier = "the-code", "the-verifier"
+	s.idTokenClaims = s.claims("web-client", "the-nonce")
+
+	claims, err := p.Exchange(context.Background(), "the-code", "the-verifier", "the-nonce")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if claims.Subject != "subject-123" || claims.Email != "someone@example.com" || !claims.EmailVerified {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+
+	if _, err := p.Exchange(context.Background(), "the-code", "the-verifier", "other-nonce"); err == nil {
+		t.Error("expected a nonce mismatch to be rejected")
+	}
+
+	if _, err := p.Exchange(context.Background(), "the-code", "wrong-verifier", "the-nonce"); err == nil {
+		t.Error("expected a wrong pkce verifier to be rejected")
+	}
+}
+
+func TestExchangeFallsBackToUserInfo(t *testing.T) {
+	s := newStubOIDCServer(t)
+	p := newTestProvider(t, s)
+	s.code, s.verifier = "the-code", "the-verifier"
+	s.idTokenClaims = s.claims("web-client", "the-nonce")
+	delete(s.idTokenClaims, "email")
+	delete(s.idTokenClaims, "email_verified")
+	s.userInfo = map[string]any{"sub": "subject-123", "email": "someone@example.com", "email_verified": "true"}
+
+	claims, err := p.Exchange(context.Background(), "the-code", "the-verifier", "the-nonce")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if claims.Email != "someone@example.com" || !claims.EmailVerified {
+		t.Errorf("expected the email from userinfo, got %+v", claims)
+	}
+
+	s.userInfo["sub"] = "someone-else"
+	if _, err := p.Exchange(context.Background(), "the-code", "the-verifier", "the-nonce"); err == nil {
+		t.Error("expected a userinfo subject mismatch to be rejected")
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	s := newStubOIDCServer(t)
+	p := newTestProvider(t, s)
+	ctx := context.Background()
+
+	if _, err := p.VerifyIDToken(ctx, s.sign(s.claims("mobile-client", "")), ""); err != nil {
+		t.Errorf("expected a token for an additional audience to be accepted: %s", err)
+	}
+
+	if _, err := p.VerifyIDToken(ctx, s.sign(s.claims("someone-elses-client", "")), ""); err == nil {
+		t.Error("expected a token for another audience to be rejected")
+	}
+
+	expired := s.claims("web-client", "")
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	if _, err := p.VerifyIDToken(ctx, s.sign(expired), ""); err == nil {
+		t.Error("expected an expired token to be rejected")
+	}
+
+	wrongIssuer := s.claims("web-client", "")
+	wrongIssuer["iss"] = "https://evil.example.com"
+	if _, err := p.VerifyIDToken(ctx, s.sign(wrongIssuer), ""); err == nil {
+		t.Error("expected a token from another issuer to be rejected")
+	}
+
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged := jwt.NewWithClaims(jwt.SigningMethodRS256, s.claims("web-client", ""))
+	forged.Header["kid"] = s.kid
+	forgedToken, _ := forged.SignedString(otherKey)
+	if _, err := p.VerifyIDToken(ctx, forgedToken, ""); err == nil {
+		t.Error("expected a token signed with another key to be rejected")
+	}
+}
+
+func TestVerifyIDTokenAfterKeyRotation(t *testing.T) {
+	s := newStubOIDCServer(t)
+	p := newTestProvider(t, s)
+	ctx := context.Background()
+
+	if _, err := p.VerifyIDToken(ctx, s.sign(s.claims("web-client", "")), ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	s.key, s.kid = newKey, "stub-2"
+	// pretend the keys were fetched a while ago so the unknown kid triggers a refetch
+	p.keysFetchedAt = time.Now().Add(-2 * jwksRefetchInterval)
+
+	if _, err := p.VerifyIDToken(ctx, s.sign(s.claims("web-client", "")), ""); err != nil {
+		t.Errorf("expected the rotated key to be fetched: %s", err)
+	}
+}
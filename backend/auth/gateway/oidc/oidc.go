@@ -0,0 +1,20 @@
+package oidc
+
+import (
+	"context"
+	"sen1or/letslive/auth/gateway/oidc/dto"
+)
+
+// Provider talks to one OpenID Connect provider configured in the oidc section of the config
+type Provider interface {
+	Name() string
+	DisplayName() string
+	TrustEmail() bool
+
+	// AuthCodeURL builds the authorization url, the verifier is used for PKCE (S256)
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// Exchange redeems the authorization code and returns the claims of the verified id token
+	Exchange(ctx context.Context, code, verifier, nonce string) (*dto.OIDCClaims, error)
+	// VerifyIDToken verifies an id token obtained directly by a native app, nonce is only checked when not empty
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*dto.OIDCClaims, error)
+}
@@ -5,5 +5,6 @@ type AuthProvider string
 const (
 	ProviderGoogle AuthProvider = "google"
 	ProviderLocal  AuthProvider = "local"
+	ProviderOIDC   AuthProvider = "oidc"
 )
 
@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/gofrs/uuid/v5"
+
+type GetUserResponseDTO struct {
+	Id           uuid.UUID    `json:"id"`
+	AuthProvider AuthProvider `json:"authProvider"`
+}
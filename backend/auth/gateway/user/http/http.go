@@ -12,6 +12,8 @@ import (
 	serviceresponse "sen1or/letslive/auth/response"
 	"sen1or/letslive/shared/pkg/discovery"
 	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
 )
 
 type userGateway struct {
@@ -109,3 +111,140 @@ func (g *userGateway) CreateNewUser(ctx context.Context, userRequestDTO dto.Crea
 
 	return createdUser.Data, nil
 }
+
+func (g *userGateway) DeleteUser(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any] {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/users/%s", addr, userId)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the request: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Errorf(ctx, "failed to create the request: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call request: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		resInfo := serviceresponse.Response[any]{}
+		if err := json.NewDecoder(resp.Body).Decode(&resInfo); err != nil {
+			logger.Errorf(ctx, "failed to decode error response from user service: %s", err)
+			return serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_INTERNAL_SERVER,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		return &resInfo
+	}
+
+	return nil
+}
+
+func (g *userGateway) GetUserById(ctx context.Context, userId uuid.UUID) (*dto.GetUserResponseDTO, *serviceresponse.Response[any]) {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/user/%s", addr, userId)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the request: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Errorf(ctx, "failed to create the request: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call request: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		resInfo := serviceresponse.Response[any]{}
+		if err := json.NewDecoder(resp.Body).Decode(&resInfo); err != nil {
+			logger.Errorf(ctx, "failed to decode error response from user service: %s", err)
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_INTERNAL_SERVER,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		return nil, &resInfo
+	}
+
+	var user serviceresponse.Response[dto.GetUserResponseDTO]
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		logger.Errorf(ctx, "failed to decode resp body: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return user.Data, nil
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"sen1or/letslive/auth/gateway/user/dto"
 	serviceresponse "sen1or/letslive/auth/response"
+
+	"github.com/gofrs/uuid/v5"
 )
 
 type UserGateway interface {
 	CreateNewUser(ctx context.Context, userRequestDTO dto.CreateUserRequestDTO) (*dto.CreateUserResponseDTO, *serviceresponse.Response[any])
+	// DeleteUser rolls back a user created for a sign up that could not be finished
+	DeleteUser(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any]
+	GetUserById(ctx context.Context, userId uuid.UUID) (*dto.GetUserResponseDTO, *serviceresponse.Response[any])
 }
@@ -18,6 +18,8 @@ type AuthHandler struct {
 	authService         services.AuthService
 	googleAuthService   services.GoogleAuthService
 	verificationService services.VerificationService
+	twoFactorService    services.TwoFactorService
+	oidcAuthService     services.OIDCAuthService
 	verificationGateway string
 }
 
@@ -26,12 +28,16 @@ func NewAuthHandler(
 	authService services.AuthService,
 	verificationService services.VerificationService,
 	googleAuthService services.GoogleAuthService,
+	twoFactorService services.TwoFactorService,
+	oidcAuthService services.OIDCAuthService,
 	verficationGateway string,
 ) *AuthHandler {
 	return &AuthHandler{
 		authService:         authService,
 		googleAuthService:   googleAuthService,
 		verificationService: verificationService,
+		twoFactorService:    twoFactorService,
+		oidcAuthService:     oidcAuthService,
 		jwtService:          jwtService,
 		verificationGateway: verficationGateway,
 	}
@@ -66,11 +72,22 @@ func (h *AuthHandler) LogInHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.setAuthJWTsInCookie(ctx, auth.UserId.String(), w); err != nil {
+	challenge, err := h.startLogInSession(ctx, *auth.UserId, w)
+	if err != nil {
 		writeResponse(w, ctx, err)
 		return
 	}
 
+	if challenge != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+			serviceresponse.RES_SUCC_TWO_FACTOR_REQUIRED,
+			challenge,
+			nil,
+			nil,
+		))
+		return
+	}
+
 	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
 		serviceresponse.RES_SUCC_LOGIN,
 		nil,
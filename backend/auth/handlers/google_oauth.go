@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	serviceresponse "sen1or/letslive/auth/response"
 	"time"
@@ -63,8 +64,16 @@ func (h *AuthHandler) OAuthGoogleCallBackHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if err := h.setAuthJWTsInCookie(ctx, createdAuth.UserId.String(), w); err != nil {
-		http.Redirect(w, r, GetRedirectURLOnFail(err.Message), http.StatusTemporaryRedirect)
+	challenge, startErr := h.startLogInSession(ctx, *createdAuth.UserId, w)
+	if startErr != nil {
+		http.Redirect(w, r, GetRedirectURLOnFail(startErr.Message), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if challenge != nil {
+		clientAddr := os.Getenv("CLIENT_URL")
+		redirectURL := fmt.Sprintf("%s/login/2fa?challengeToken=%s", clientAddr, url.QueryEscape(challenge.ChallengeToken))
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 		return
 	}
 
@@ -93,11 +102,19 @@ func (h *AuthHandler) OAuthGoogleMobileHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	if err := h.setAuthJWTsInCookie(ctx, createdAuth.UserId.String(), w); err != nil {
+	challenge, err := h.startLogInSession(ctx, *createdAuth.UserId, w)
+	if err != nil {
 		writeResponse(w, ctx, err)
 		return
 	}
 
+	if challenge != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+			serviceresponse.RES_SUCC_TWO_FACTOR_REQUIRED, challenge, nil, nil,
+		))
+		return
+	}
+
 	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
 		serviceresponse.RES_SUCC_LOGIN, nil, nil, nil,
 	))
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// GetJWKSHandler publishes the public keys for verifying access tokens, the response is a plain
+// JWK set (not wrapped in the usual response body) so standard JWT libraries can consume it
+func (h *AuthHandler) GetJWKSHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// verifiers refetch on an unknown kid anyway, so a short cache is enough during rotation
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.jwtService.JWKS())
+}
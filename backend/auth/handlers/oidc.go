@@ -0,0 +1,350 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sen1or/letslive/auth/dto"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/auth/utils"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcFlowCookieName = "oidcflow"
+	oidcFlowMaxAge     = 10 * time.Minute
+
+	oidcFlowModeLogIn = "login"
+	oidcFlowModeLink  = "link"
+)
+
+// oidcFlow is kept in a cookie between the redirect to the provider and the callback
+type oidcFlow struct {
+	Provider string `json:"provider"`
+	Mode     string `json:"mode"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func (h *AuthHandler) GetOIDCProvidersHandler(w http.ResponseWriter, r *http.Request) {
+	providers := h.oidcAuthService.ListProviders()
+	writeResponse(w, r.Context(), serviceresponse.NewResponseFromTemplate(
+		serviceresponse.RES_SUCC_OK,
+		&providers,
+		nil,
+		nil,
+	))
+}
+
+func (h *AuthHandler) OIDCLogInHandler(w http.ResponseWriter, r *http.Request) {
+	h.startOIDCFlow(w, r, oidcFlowModeLogIn)
+}
+
+// OIDCLinkHandler starts the same flow as the login but attaches the provider account to the logged in user on callback
+func (h *AuthHandler) OIDCLinkHandler(w http.ResponseWriter, r *http.Request) {
+	if _, err := h.getUserIDFromCookie(r); err != nil {
+		writeResponse(w, r.Context(), serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	h.startOIDCFlow(w, r, oidcFlowModeLink)
+}
+
+func (h *AuthHandler) startOIDCFlow(w http.ResponseWriter, r *http.Request, mode string) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	flow, err := newOIDCFlow(r.PathValue("provider"), mode)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	authURL, serviceErr := h.oidcAuthService.GenerateAuthCodeURL(ctx, flow.Provider, flow.State, flow.Nonce, flow.Verifier)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	if err := setOIDCFlowCookie(w, flow); err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	http.Redirect(w, r, authURL, http.StatusTemporaryRedirect)
+}
+
+func (h *AuthHandler) OIDCCallbackHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	clientAddr := os.Getenv("CLIENT_URL")
+
+	flow, err := readOIDCFlowCookie(r)
+	clearOIDCFlowCookie(w)
+	if err != nil || flow.Provider != r.PathValue("provider") {
+		http.Redirect(w, r, fmt.Sprintf("%s/login?errorMessage=%s", clientAddr, url.QueryEscape("Missing or invalid login session")), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if flow.Mode == oidcFlowModeLink {
+		h.handleOIDCLinkCallback(w, r, flow)
+		return
+	}
+
+	GetRedirectURLOnFail := func(errMsg string) string {
+		return fmt.Sprintf("%s/login?errorMessage=%s", clientAddr, url.QueryEscape(errMsg))
+	}
+
+	if len(r.FormValue("error")) > 0 {
+		http.Redirect(w, r, GetRedirectURLOnFail(r.FormValue("error")), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if r.FormValue("state") != flow.State {
+		http.Redirect(w, r, GetRedirectURLOnFail("Invalid state"), http.StatusTemporaryRedirect)
+		return
+	}
+
+	auth, serviceErr := h.oidcAuthService.CallbackLogIn(ctx, flow.Provider, r.FormValue("code"), flow.Verifier, flow.Nonce)
+	if serviceErr != nil {
+		http.Redirect(w, r, GetRedirectURLOnFail(serviceErr.Message), http.StatusTemporaryRedirect)
+		return
+	}
+
+	challenge, startErr := h.startLogInSession(ctx, *auth.UserId, w)
+	if startErr != nil {
+		http.Redirect(w, r, GetRedirectURLOnFail(startErr.Message), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if challenge != nil {
+		redirectURL := fmt.Sprintf("%s/login/2fa?challengeToken=%s", clientAddr, url.QueryEscape(challenge.ChallengeToken))
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("%s/login?redirectUrl=%s", clientAddr, "/account-setup"), http.StatusTemporaryRedirect)
+}
+
+func (h *AuthHandler) handleOIDCLinkCallback(w http.ResponseWriter, r *http.Request, flow *oidcFlow) {
+	ctx := r.Context()
+	clientAddr := os.Getenv("CLIENT_URL")
+	GetRedirectURL := func(query string) string {
+		return fmt.Sprintf("%s/settings/security?%s", clientAddr, query)
+	}
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		http.Redirect(w, r, GetRedirectURL("errorMessage="+url.QueryEscape("Please log in again")), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if len(r.FormValue("error")) > 0 {
+		http.Redirect(w, r, GetRedirectURL("errorMessage="+url.QueryEscape(r.FormValue("error"))), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if r.FormValue("state") != flow.State {
+		http.Redirect(w, r, GetRedirectURL("errorMessage="+url.QueryEscape("Invalid state")), http.StatusTemporaryRedirect)
+		return
+	}
+
+	if serviceErr := h.oidcAuthService.CallbackLink(ctx, *userUUID, flow.Provider, r.FormValue("code"), flow.Verifier, flow.Nonce); serviceErr != nil {
+		http.Redirect(w, r, GetRedirectURL("errorMessage="+url.QueryEscape(serviceErr.Message)), http.StatusTemporaryRedirect)
+		return
+	}
+
+	http.Redirect(w, r, GetRedirectURL("linkedProvider="+url.QueryEscape(flow.Provider)), http.StatusTemporaryRedirect)
+}
+
+// OIDCMobileHandler logs in with an id token the native app obtained from the provider sdk
+func (h *AuthHandler) OIDCMobileHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var body dto.OIDCMobileLogInRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INVALID_PAYLOAD, nil, nil, nil,
+		))
+		return
+	}
+
+	auth, serviceErr := h.oidcAuthService.LogInWithIDToken(ctx, r.PathValue("provider"), body)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	challenge, err := h.startLogInSession(ctx, *auth.UserId, w)
+	if err != nil {
+		writeResponse(w, ctx, err)
+		return
+	}
+
+	if challenge != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+			serviceresponse.RES_SUCC_TWO_FACTOR_REQUIRED, challenge, nil, nil,
+		))
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+		serviceresponse.RES_SUCC_LOGIN, nil, nil, nil,
+	))
+}
+
+func (h *AuthHandler) GetAuthIdentitiesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	identities, serviceErr := h.oidcAuthService.ListIdentities(ctx, *userUUID)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+		serviceresponse.RES_SUCC_OK,
+		&identities,
+		nil,
+		nil,
+	))
+}
+
+func (h *AuthHandler) UnlinkAuthIdentityHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	if serviceErr := h.oidcAuthService.Unlink(ctx, *userUUID, r.PathValue("provider")); serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+		serviceresponse.RES_SUCC_OK,
+		nil,
+		nil,
+		nil,
+	))
+}
+
+func newOIDCFlow(provider, mode string) (*oidcFlow, error) {
+	state, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &oidcFlow{
+		Provider: provider,
+		Mode:     mode,
+		State:    state,
+		Nonce:    nonce,
+		Verifier: oauth2.GenerateVerifier(),
+	}, nil
+}
+
+func setOIDCFlowCookie(w http.ResponseWriter, flow *oidcFlow) error {
+	b, err := json.Marshal(flow)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcFlowCookieName,
+		Value:    base64.RawURLEncoding.EncodeToString(b),
+		Path:     "/",
+		MaxAge:   int(oidcFlowMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode, // has to survive the top level redirect back from the provider
+	})
+
+	return nil
+}
+
+func readOIDCFlowCookie(r *http.Request) (*oidcFlow, error) {
+	cookie, err := r.Cookie(oidcFlowCookieName)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var flow oidcFlow
+	if err := json.Unmarshal(b, &flow); err != nil {
+		return nil, err
+	}
+
+	if len(flow.State) == 0 || len(flow.Nonce) == 0 || len(flow.Verifier) == 0 {
+		return nil, errors.New("incomplete oidc flow")
+	}
+
+	return &flow, nil
+}
+
+// the flow can only be completed once
+func clearOIDCFlowCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcFlowCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/auth/dto"
+	serviceresponse "sen1or/letslive/auth/response"
+)
+
+func (h *AuthHandler) GetTwoFactorStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	status, serviceErr := h.twoFactorService.GetStatus(ctx, *userUUID)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+		serviceresponse.RES_SUCC_OK,
+		status,
+		nil,
+		nil,
+	))
+}
+
+func (h *AuthHandler) BeginTOTPEnrollmentHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	enrollment, serviceErr := h.twoFactorService.BeginEnrollment(ctx, *userUUID)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+		serviceresponse.RES_SUCC_TOTP_ENROLLMENT_STARTED,
+		enrollment,
+		nil,
+		nil,
+	))
+}
+
+func (h *AuthHandler) ConfirmTOTPEnrollmentHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	var reqDTO dto.ConfirmTOTPRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INVALID_PAYLOAD,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+	defer r.Body.Close()
+
+	recoveryCodes, serviceErr := h.twoFactorService.ConfirmEnrollment(ctx, *userUUID, reqDTO)
+	if serviceErr != nil {
+		writeResponse(w, ctx, serviceErr)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate(
+		serviceresponse.RES_SUCC_TWO_FACTOR_ENABLED,
+		recoveryCodes,
+		nil,
+		nil,
+	))
+}
+
+func (h *AuthHandler) DisableTOTPHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userUUID, err := h.getUserIDFromCookie(r)
+	if err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	var reqDTO dto.DisableTOTPRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INVALID_PAYLOAD,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+	defer r.Body.Close()
+
+	if err := h.twoFactorService.Disable(ctx, *userUUID, reqDTO); err != nil {
+		writeResponse(w, ctx, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// VerifyTwoFactorLogInHandler is the second login step, the token pair is only issued here
+// for accounts that have two-factor enabled
+func (h *AuthHandler) VerifyTwoFactorLogInHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var reqDTO dto.VerifyTwoFactorLogInRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INVALID_PAYLOAD,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+	defer r.Body.Close()
+
+	userUUID, err := h.twoFactorService.VerifyLogInChallenge(ctx, reqDTO)
+	if err != nil {
+		writeResponse(w, ctx, err)
+		return
+	}
+
+	if err := h.setAuthJWTsInCookie(ctx, userUUID.String(), w); err != nil {
+		writeResponse(w, ctx, err)
+		return
+	}
+
+	writeResponse(w, ctx, serviceresponse.NewResponseFromTemplate[any](
+		serviceresponse.RES_SUCC_LOGIN,
+		nil,
+		nil,
+		nil,
+	))
+}
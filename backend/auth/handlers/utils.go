@@ -5,14 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"sen1or/letslive/auth/dto"
 	serviceresponse "sen1or/letslive/auth/response"
-	"sen1or/letslive/auth/types"
 
 	"github.com/gofrs/uuid/v5"
-	"github.com/golang-jwt/jwt/v5"
 )
 
-func writeResponse(w http.ResponseWriter, ctx context.Context, res *serviceresponse.Response[any]) {
+func writeResponse[T any](w http.ResponseWriter, ctx context.Context, res *serviceresponse.Response[T]) {
 	requestId, ok := ctx.Value("requestId").(string)
 	if ok && len(requestId) > 0 {
 		res.RequestId = requestId
@@ -36,6 +35,21 @@ func (h *AuthHandler) setAuthJWTsInCookie(ctx context.Context, userId string, w
 	return nil
 }
 
+// startLogInSession sets the auth cookies right away, or when the account has two-factor enabled,
+// returns the challenge that has to be answered through the second login step instead
+func (h *AuthHandler) startLogInSession(ctx context.Context, userId uuid.UUID, w http.ResponseWriter) (*dto.TwoFactorChallengeResponseDTO, *serviceresponse.Response[any]) {
+	enabled, err := h.twoFactorService.IsEnabled(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		return h.twoFactorService.CreateLogInChallenge(ctx, userId)
+	}
+
+	return nil, h.setAuthJWTsInCookie(ctx, userId.String(), w)
+}
+
 func (h *AuthHandler) setRefreshTokenCookie(w http.ResponseWriter, refreshToken string, maxAge int) {
 	http.SetCookie(w, &http.Cookie{
 		Name:  "REFRESH_TOKEN",
@@ -70,8 +84,10 @@ func (h *AuthHandler) getUserIDFromCookie(r *http.Request) (*uuid.UUID, error) {
 		return nil, errors.New("missing credentials")
 	}
 
-	myClaims := types.MyClaims{}
-	_, _, err = jwt.NewParser().ParseUnverified(accessTokenCookie.Value, &myClaims)
+	myClaims, err := h.jwtService.ParseAccessToken(accessTokenCookie.Value)
+	if err != nil {
+		return nil, errors.New("invalid credentials")
+	}
 
 	userUUID, err := uuid.FromString(myClaims.UserId)
 	if err != nil {
@@ -0,0 +1,42 @@
+-- +goose Up
+CREATE TABLE "totp_credentials" (
+  "id" uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  "user_id" uuid NOT NULL,
+  "secret" VARCHAR(255) NOT NULL,
+  "enabled_at" timestamptz,
+  "last_used_step" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT current_timestamp,
+  CONSTRAINT "uni_totp_credentials_user_id" UNIQUE ("user_id"),
+  CONSTRAINT "fk_auths_totp_credentials" FOREIGN KEY ("user_id") REFERENCES "auths"("user_id") ON DELETE CASCADE
+);
+
+CREATE TABLE "totp_recovery_codes" (
+  "id" uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  "user_id" uuid NOT NULL,
+  "code_hash" char(64) NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT current_timestamp,
+  CONSTRAINT "fk_totp_credentials_totp_recovery_codes" FOREIGN KEY ("user_id") REFERENCES "totp_credentials"("user_id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "idx_totp_recovery_codes_user_id" ON "totp_recovery_codes" ("user_id");
+
+CREATE TABLE "two_factor_challenges" (
+  "id" uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  "user_id" uuid NOT NULL,
+  "token_hash" char(64) NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT current_timestamp,
+  CONSTRAINT "uni_two_factor_challenges_token_hash" UNIQUE ("token_hash"),
+  CONSTRAINT "fk_auths_two_factor_challenges" FOREIGN KEY ("user_id") REFERENCES "auths"("user_id") ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "two_factor_challenges";
+
+DROP INDEX IF EXISTS "idx_totp_recovery_codes_user_id";
+DROP TABLE IF EXISTS "totp_recovery_codes";
+
+DROP TABLE IF EXISTS "totp_credentials";
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE "auth_identities" (
+  "id" uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  "user_id" uuid NOT NULL,
+  "provider" VARCHAR(50) NOT NULL,
+  "subject" VARCHAR(255) NOT NULL,
+  "email" VARCHAR(320),
+  "created_at" timestamptz NOT NULL DEFAULT current_timestamp,
+  "last_login_at" timestamptz,
+  CONSTRAINT "uni_auth_identities_provider_subject" UNIQUE ("provider", "subject"),
+  CONSTRAINT "uni_auth_identities_user_id_provider" UNIQUE ("user_id", "provider"),
+  CONSTRAINT "fk_auths_auth_identities" FOREIGN KEY ("user_id") REFERENCES "auths"("user_id") ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "auth_identities";
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresAuthRepo) DeleteByUserID(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		DELETE FROM auths
+		WHERE user_id = $1
+	`, userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to delete auth: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_AUTH_NOT_FOUND,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	return nil
+}
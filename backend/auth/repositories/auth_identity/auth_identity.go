@@ -0,0 +1,17 @@
+package auth_identity
+
+import (
+	"sen1or/letslive/auth/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresAuthIdentityRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewAuthIdentityRepository(conn *pgxpool.Pool) domains.AuthIdentityRepository {
+	return &postgresAuthIdentityRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,79 @@
+package auth_identity
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r *postgresAuthIdentityRepo) Create(ctx context.Context, identity domains.AuthIdentity) (*domains.AuthIdentity, *serviceresponse.Response[any]) {
+	params := pgx.NamedArgs{
+		"user_id":  identity.UserId,
+		"provider": identity.Provider,
+		"subject":  identity.Subject,
+		"email":    identity.Email,
+	}
+
+	rows, err := r.dbConn.Query(ctx, `
+		INSERT INTO auth_identities (
+			user_id,
+			provider,
+			subject,
+			email,
+			last_login_at
+		) VALUES (
+			@user_id,
+			@provider,
+			@subject,
+			@email,
+			current_timestamp
+		) RETURNING id, user_id, provider, subject, email, created_at, last_login_at
+	`, params)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create auth identity: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": identity.Provider, "userId": identity.UserId}},
+		)
+	}
+	defer rows.Close()
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.AuthIdentity])
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "uni_auth_identities_user_id_provider" {
+				return nil, serviceresponse.NewResponseFromTemplate[any](
+					serviceresponse.RES_ERR_OIDC_PROVIDER_ALREADY_LINKED,
+					nil,
+					nil,
+					&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": identity.Provider}},
+				)
+			}
+
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_OIDC_IDENTITY_ALREADY_LINKED,
+				nil,
+				nil,
+				&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": identity.Provider}},
+			)
+		}
+
+		logger.Errorf(ctx, "failed to collect created auth identity: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": identity.Provider, "userId": identity.UserId}},
+		)
+	}
+
+	return &created, nil
+}
@@ -0,0 +1,36 @@
+package auth_identity
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresAuthIdentityRepo) Delete(ctx context.Context, userId uuid.UUID, provider string) *serviceresponse.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		DELETE FROM auth_identities
+		WHERE user_id = $1 AND provider = $2
+	`, userId, provider)
+	if err != nil {
+		logger.Errorf(ctx, "failed to delete auth identity: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId, "provider": provider}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_AUTH_IDENTITY_NOT_FOUND,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId, "provider": provider}},
+		)
+	}
+
+	return nil
+}
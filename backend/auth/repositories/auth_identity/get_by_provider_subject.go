@@ -0,0 +1,51 @@
+package auth_identity
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresAuthIdentityRepo) GetByProviderSubject(ctx context.Context, provider, subject string) (*domains.AuthIdentity, *serviceresponse.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT id, user_id, provider, subject, email, created_at, last_login_at
+		FROM auth_identities
+		WHERE provider = $1 AND subject = $2
+	`, provider, subject)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get auth identity: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": provider}},
+		)
+	}
+	defer rows.Close()
+
+	identity, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.AuthIdentity])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_AUTH_IDENTITY_NOT_FOUND,
+				nil,
+				nil,
+				&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": provider}},
+			)
+		}
+
+		logger.Errorf(ctx, "failed to collect auth identity: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": provider}},
+		)
+	}
+
+	return &identity, nil
+}
@@ -0,0 +1,43 @@
+package auth_identity
+
+import (
+	"context"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresAuthIdentityRepo) GetByUserID(ctx context.Context, userId uuid.UUID) ([]domains.AuthIdentity, *serviceresponse.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT id, user_id, provider, subject, email, created_at, last_login_at
+		FROM auth_identities
+		WHERE user_id = $1
+		ORDER BY created_at
+	`, userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get auth identities of user: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+	defer rows.Close()
+
+	identities, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.AuthIdentity])
+	if err != nil {
+		logger.Errorf(ctx, "failed to collect auth identities: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	return identities, nil
+}
@@ -0,0 +1,38 @@
+package auth_identity
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresAuthIdentityRepo) UpdateLastLoginAt(ctx context.Context, identityId uuid.UUID, lastLoginAt time.Time) *serviceresponse.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		UPDATE auth_identities
+		SET last_login_at = $1
+		WHERE id = $2
+	`, lastLoginAt, identityId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to update auth identity last login: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"identityId": identityId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_AUTH_IDENTITY_NOT_FOUND,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"identityId": identityId}},
+		)
+	}
+
+	return nil
+}
@@ -3,8 +3,11 @@ package repositories
 import (
 	"sen1or/letslive/auth/domains"
 	authrepo "sen1or/letslive/auth/repositories/auth"
+	identityrepo "sen1or/letslive/auth/repositories/auth_identity"
 	jwtrepo "sen1or/letslive/auth/repositories/jwt_token"
 	otprepo "sen1or/letslive/auth/repositories/sign_up_otp"
+	totprepo "sen1or/letslive/auth/repositories/totp"
+	challengerepo "sen1or/letslive/auth/repositories/two_factor_challenge"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -20,3 +23,15 @@ func NewRefreshTokenRepository(conn *pgxpool.Pool) domains.RefreshTokenRepositor
 func NewSignUpOTPRepo(conn *pgxpool.Pool) domains.SignUpOTPRepository {
 	return otprepo.NewSignUpOTPRepo(conn)
 }
+
+func NewTOTPRepository(conn *pgxpool.Pool) domains.TOTPRepository {
+	return totprepo.NewTOTPRepository(conn)
+}
+
+func NewTwoFactorChallengeRepository(conn *pgxpool.Pool) domains.TwoFactorChallengeRepository {
+	return challengerepo.NewTwoFactorChallengeRepository(conn)
+}
+
+func NewAuthIdentityRepository(conn *pgxpool.Pool) domains.AuthIdentityRepository {
+	return identityrepo.NewAuthIdentityRepository(conn)
+}
@@ -0,0 +1,37 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTOTPRepo) ConsumeRecoveryCode(ctx context.Context, userId uuid.UUID, codeHash string) *serviceresponse.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		UPDATE totp_recovery_codes
+		SET used_at = current_timestamp
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`, userId, codeHash)
+	if err != nil {
+		logger.Errorf(ctx, "failed to consume recovery code: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_CODE_INVALID,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTOTPRepo) ConsumeStep(ctx context.Context, userId uuid.UUID, step int64) *serviceresponse.Response[any] {
+	// the comparison makes the check-and-set atomic, so a code can not be replayed by two concurrent requests
+	result, err := r.dbConn.Exec(ctx, `
+		UPDATE totp_credentials
+		SET last_used_step = $2
+		WHERE user_id = $1 AND (last_used_step IS NULL OR last_used_step < $2)
+	`, userId, step)
+	if err != nil {
+		logger.Errorf(ctx, "failed to update totp last used step: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_CODE_INVALID,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTOTPRepo) CountUnusedRecoveryCodes(ctx context.Context, userId uuid.UUID) (int, *serviceresponse.Response[any]) {
+	var count int
+	err := r.dbConn.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM totp_recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+	`, userId).Scan(&count)
+	if err != nil {
+		logger.Errorf(ctx, "failed to count recovery codes: %s", err)
+		return 0, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	return count, nil
+}
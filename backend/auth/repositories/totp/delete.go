@@ -0,0 +1,37 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// recovery codes are removed by the ON DELETE CASCADE constraint
+func (r *postgresTOTPRepo) Delete(ctx context.Context, userId uuid.UUID) *serviceresponse.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		DELETE FROM totp_credentials
+		WHERE user_id = $1
+	`, userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to delete totp credential: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TOTP_CREDENTIAL_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
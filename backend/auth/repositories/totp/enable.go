@@ -0,0 +1,82 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTOTPRepo) Enable(ctx context.Context, userId uuid.UUID, usedStep int64, recoveryCodeHashes []string) *serviceresponse.Response[any] {
+	tx, err := r.dbConn.Begin(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE totp_credentials
+		SET enabled_at = current_timestamp, last_used_step = $2
+		WHERE user_id = $1 AND enabled_at IS NULL
+	`, userId, usedStep)
+	if err != nil {
+		logger.Errorf(ctx, "failed to enable totp credential: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TOTP_CREDENTIAL_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM totp_recovery_codes WHERE user_id = $1`, userId); err != nil {
+		logger.Errorf(ctx, "failed to delete old recovery codes: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO totp_recovery_codes (user_id, code_hash)
+		SELECT $1, unnest($2::text[])
+	`, userId, recoveryCodeHashes); err != nil {
+		logger.Errorf(ctx, "failed to insert recovery codes: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit enable totp transaction: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
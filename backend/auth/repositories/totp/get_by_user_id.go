@@ -0,0 +1,52 @@
+package totp
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTOTPRepo) GetByUserID(ctx context.Context, userId uuid.UUID) (*domains.TOTPCredential, *serviceresponse.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT id, user_id, secret, enabled_at, last_used_step, created_at
+		FROM totp_credentials
+		WHERE user_id = $1
+	`, userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get totp credential: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+	defer rows.Close()
+
+	credential, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.TOTPCredential])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_TOTP_CREDENTIAL_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		logger.Errorf(ctx, "failed to collect totp credential: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	return &credential, nil
+}
@@ -0,0 +1,17 @@
+package totp
+
+import (
+	"sen1or/letslive/auth/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresTOTPRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewTOTPRepository(conn *pgxpool.Pool) domains.TOTPRepository {
+	return &postgresTOTPRepo{
+		dbConn: conn,
+	}
+}
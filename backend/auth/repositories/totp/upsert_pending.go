@@ -0,0 +1,40 @@
+package totp
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTOTPRepo) UpsertPending(ctx context.Context, userId uuid.UUID, encryptedSecret string) *serviceresponse.Response[any] {
+	// an enabled credential is never overwritten here, it has to be disabled first
+	result, err := r.dbConn.Exec(ctx, `
+		INSERT INTO totp_credentials (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = NULL, created_at = current_timestamp
+		WHERE totp_credentials.enabled_at IS NULL
+	`, userId, encryptedSecret)
+	if err != nil {
+		logger.Errorf(ctx, "failed to upsert pending totp credential: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"userId": userId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_ALREADY_ENABLED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package two_factor_challenge
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTwoFactorChallengeRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.TwoFactorChallenge, *serviceresponse.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT id, user_id, token_hash, attempts, expires_at, used_at, created_at
+		FROM two_factor_challenges
+		WHERE token_hash = $1
+	`, tokenHash)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get two factor challenge: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer rows.Close()
+
+	challenge, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.TwoFactorChallenge])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_TWO_FACTOR_CHALLENGE_INVALID,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		logger.Errorf(ctx, "failed to collect two factor challenge: %s", err)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &challenge, nil
+}
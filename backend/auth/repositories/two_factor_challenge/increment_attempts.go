@@ -0,0 +1,38 @@
+package two_factor_challenge
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTwoFactorChallengeRepo) IncrementAttempts(ctx context.Context, challengeId uuid.UUID, maxAttempts int) *serviceresponse.Response[any] {
+	// the attempt is counted and checked in one statement so parallel requests cannot go over maxAttempts
+	result, err := r.dbConn.Exec(ctx, `
+		UPDATE two_factor_challenges
+		SET attempts = attempts + 1
+		WHERE id = $1 AND used_at IS NULL AND expires_at > now() AND attempts < $2
+	`, challengeId, maxAttempts)
+	if err != nil {
+		logger.Errorf(ctx, "failed to increment two factor challenge attempts: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"challengeId": challengeId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_CHALLENGE_INVALID,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
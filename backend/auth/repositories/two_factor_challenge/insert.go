@@ -0,0 +1,50 @@
+package two_factor_challenge
+
+import (
+	"context"
+	"sen1or/letslive/auth/domains"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTwoFactorChallengeRepo) Insert(ctx context.Context, challenge domains.TwoFactorChallenge) *serviceresponse.Response[any] {
+	params := pgx.NamedArgs{
+		"user_id":    challenge.UserId,
+		"token_hash": challenge.TokenHash,
+		"expires_at": challenge.ExpiresAt,
+	}
+
+	result, err := r.dbConn.Exec(ctx, `
+		INSERT INTO two_factor_challenges (
+			user_id,
+			token_hash,
+			expires_at
+		) VALUES (
+			@user_id,
+			@token_hash,
+			@expires_at
+		)
+	`, params)
+	if err != nil {
+		logger.Errorf(ctx, "failed to insert two factor challenge: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package two_factor_challenge
+
+import (
+	"sen1or/letslive/auth/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresTwoFactorChallengeRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewTwoFactorChallengeRepository(conn *pgxpool.Pool) domains.TwoFactorChallengeRepository {
+	return &postgresTwoFactorChallengeRepo{
+		dbConn: conn,
+	}
+}
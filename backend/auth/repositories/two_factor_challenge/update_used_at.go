@@ -0,0 +1,39 @@
+package two_factor_challenge
+
+import (
+	"context"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTwoFactorChallengeRepo) UpdateUsedAt(ctx context.Context, challengeId uuid.UUID, usedAt time.Time) *serviceresponse.Response[any] {
+	// only an unused challenge can be redeemed, this guards against two requests racing with the same token
+	result, err := r.dbConn.Exec(ctx, `
+		UPDATE two_factor_challenges
+		SET used_at = $1
+		WHERE id = $2 AND used_at IS NULL
+	`, usedAt, challengeId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to update two factor challenge used at: %s", err)
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"challengeId": challengeId}},
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_CHALLENGE_INVALID,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
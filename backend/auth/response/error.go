@@ -22,6 +22,19 @@ const (
 	RES_ERR_DATABASE_ISSUE_CODE               = 20016
 	RES_ERR_INTERNAL_SERVER_CODE              = 20017
 	RES_ERR_FAILED_TO_SEND_VERIFICATION_CODE  = 20018
+	RES_ERR_TWO_FACTOR_ALREADY_ENABLED_CODE   = 20019
+	RES_ERR_TWO_FACTOR_NOT_ENABLED_CODE       = 20020
+	RES_ERR_TWO_FACTOR_CODE_INVALID_CODE      = 20021
+	RES_ERR_TWO_FACTOR_CHALLENGE_INVALID_CODE = 20022
+	RES_ERR_TOTP_CREDENTIAL_NOT_FOUND_CODE    = 20023
+	RES_ERR_AUTH_IDENTITY_NOT_FOUND_CODE      = 20024
+	RES_ERR_OIDC_PROVIDER_NOT_FOUND_CODE      = 20025
+	RES_ERR_OIDC_ACCOUNT_NOT_LINKED_CODE      = 20026
+	RES_ERR_OIDC_IDENTITY_ALREADY_LINKED_CODE = 20027
+	RES_ERR_OIDC_PROVIDER_ALREADY_LINKED_CODE = 20028
+	RES_ERR_OIDC_LAST_LOGIN_METHOD_CODE       = 20029
+	RES_ERR_OIDC_EMAIL_REQUIRED_CODE          = 20030
+	RES_ERR_OIDC_AUTHENTICATION_FAILED_CODE   = 20031
 )
 
 const (
@@ -44,6 +57,19 @@ const (
 	RES_ERR_DATABASE_ISSUE_KEY               = "res_err_database_issue"
 	RES_ERR_INTERNAL_SERVER_KEY              = "res_err_internal_server"
 	RES_ERR_FAILED_TO_SEND_VERIFICATION_KEY  = "res_err_failed_to_send_verification"
+	RES_ERR_TWO_FACTOR_ALREADY_ENABLED_KEY   = "res_err_two_factor_already_enabled"
+	RES_ERR_TWO_FACTOR_NOT_ENABLED_KEY       = "res_err_two_factor_not_enabled"
+	RES_ERR_TWO_FACTOR_CODE_INVALID_KEY      = "res_err_two_factor_code_invalid"
+	RES_ERR_TWO_FACTOR_CHALLENGE_INVALID_KEY = "res_err_two_factor_challenge_invalid"
+	RES_ERR_TOTP_CREDENTIAL_NOT_FOUND_KEY    = "res_err_totp_credential_not_found"
+	RES_ERR_AUTH_IDENTITY_NOT_FOUND_KEY      = "res_err_auth_identity_not_found"
+	RES_ERR_OIDC_PROVIDER_NOT_FOUND_KEY      = "res_err_oidc_provider_not_found"
+	RES_ERR_OIDC_ACCOUNT_NOT_LINKED_KEY      = "res_err_oidc_account_not_linked"
+	RES_ERR_OIDC_IDENTITY_ALREADY_LINKED_KEY = "res_err_oidc_identity_already_linked"
+	RES_ERR_OIDC_PROVIDER_ALREADY_LINKED_KEY = "res_err_oidc_provider_already_linked"
+	RES_ERR_OIDC_LAST_LOGIN_METHOD_KEY       = "res_err_oidc_last_login_method"
+	RES_ERR_OIDC_EMAIL_REQUIRED_KEY          = "res_err_oidc_email_required"
+	RES_ERR_OIDC_AUTHENTICATION_FAILED_KEY   = "res_err_oidc_authentication_failed"
 )
 
 var (
@@ -198,4 +224,108 @@ var (
 		Key:        RES_ERR_FAILED_TO_SEND_VERIFICATION_KEY,
 		Message:    "Failed to send email verification, please try again later.",
 	}
+
+	RES_ERR_TWO_FACTOR_ALREADY_ENABLED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_TWO_FACTOR_ALREADY_ENABLED_CODE,
+		Key:        RES_ERR_TWO_FACTOR_ALREADY_ENABLED_KEY,
+		Message:    "Two-factor authentication is already enabled.",
+	}
+
+	RES_ERR_TWO_FACTOR_NOT_ENABLED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_TWO_FACTOR_NOT_ENABLED_CODE,
+		Key:        RES_ERR_TWO_FACTOR_NOT_ENABLED_KEY,
+		Message:    "Two-factor authentication is not enabled.",
+	}
+
+	RES_ERR_TWO_FACTOR_CODE_INVALID = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnauthorized,
+		Code:       RES_ERR_TWO_FACTOR_CODE_INVALID_CODE,
+		Key:        RES_ERR_TWO_FACTOR_CODE_INVALID_KEY,
+		Message:    "The authentication code is invalid.",
+	}
+
+	RES_ERR_TWO_FACTOR_CHALLENGE_INVALID = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnauthorized,
+		Code:       RES_ERR_TWO_FACTOR_CHALLENGE_INVALID_CODE,
+		Key:        RES_ERR_TWO_FACTOR_CHALLENGE_INVALID_KEY,
+		Message:    "The login session has expired, please log in again.",
+	}
+
+	RES_ERR_TOTP_CREDENTIAL_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_TOTP_CREDENTIAL_NOT_FOUND_CODE,
+		Key:        RES_ERR_TOTP_CREDENTIAL_NOT_FOUND_KEY,
+		Message:    "No authenticator enrollment found, please start again.",
+	}
+
+	RES_ERR_AUTH_IDENTITY_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_AUTH_IDENTITY_NOT_FOUND_CODE,
+		Key:        RES_ERR_AUTH_IDENTITY_NOT_FOUND_KEY,
+		Message:    "Linked account not found.",
+	}
+
+	RES_ERR_OIDC_PROVIDER_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_OIDC_PROVIDER_NOT_FOUND_CODE,
+		Key:        RES_ERR_OIDC_PROVIDER_NOT_FOUND_KEY,
+		Message:    "Login provider not found.",
+	}
+
+	RES_ERR_OIDC_ACCOUNT_NOT_LINKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_OIDC_ACCOUNT_NOT_LINKED_CODE,
+		Key:        RES_ERR_OIDC_ACCOUNT_NOT_LINKED_KEY,
+		Message:    "An account with this email already exists, please log in and link the provider from your settings.",
+	}
+
+	RES_ERR_OIDC_IDENTITY_ALREADY_LINKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_OIDC_IDENTITY_ALREADY_LINKED_CODE,
+		Key:        RES_ERR_OIDC_IDENTITY_ALREADY_LINKED_KEY,
+		Message:    "This external account is already linked to another user.",
+	}
+
+	RES_ERR_OIDC_PROVIDER_ALREADY_LINKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_OIDC_PROVIDER_ALREADY_LINKED_CODE,
+		Key:        RES_ERR_OIDC_PROVIDER_ALREADY_LINKED_KEY,
+		Message:    "Another account of this provider is already linked.",
+	}
+
+	RES_ERR_OIDC_LAST_LOGIN_METHOD = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_OIDC_LAST_LOGIN_METHOD_CODE,
+		Key:        RES_ERR_OIDC_LAST_LOGIN_METHOD_KEY,
+		Message:    "Can not unlink the only way to log in, please set a password or link another provider first.",
+	}
+
+	RES_ERR_OIDC_EMAIL_REQUIRED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_OIDC_EMAIL_REQUIRED_CODE,
+		Key:        RES_ERR_OIDC_EMAIL_REQUIRED_KEY,
+		Message:    "The provider did not share a verified email address.",
+	}
+
+	RES_ERR_OIDC_AUTHENTICATION_FAILED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnauthorized,
+		Code:       RES_ERR_OIDC_AUTHENTICATION_FAILED_CODE,
+		Key:        RES_ERR_OIDC_AUTHENTICATION_FAILED_KEY,
+		Message:    "Failed to authenticate with the provider, please try again.",
+	}
 )
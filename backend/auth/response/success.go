@@ -5,6 +5,10 @@ const (
 	RES_SUCC_EMAIL_VERIFIED_CODE          = 10001
 	RES_SUCC_LOGIN_CODE                   = 10002
 	RES_SUCC_SIGN_UP_CODE                 = 10003
+	RES_SUCC_TWO_FACTOR_REQUIRED_CODE     = 10004
+	RES_SUCC_TOTP_ENROLLMENT_STARTED_CODE = 10005
+	RES_SUCC_TWO_FACTOR_ENABLED_CODE      = 10006
+	RES_SUCC_OK_CODE                      = 10007
 )
 
 const (
@@ -12,6 +16,10 @@ const (
 	RES_SUCC_EMAIL_VERIFIED_KEY          = "res_succ_email_verified"
 	RES_SUCC_LOGIN_KEY                   = "res_succ_login"
 	RES_SUCC_SIGN_UP_KEY                 = "res_succ_sign_up"
+	RES_SUCC_TWO_FACTOR_REQUIRED_KEY     = "res_succ_two_factor_required"
+	RES_SUCC_TOTP_ENROLLMENT_STARTED_KEY = "res_succ_totp_enrollment_started"
+	RES_SUCC_TWO_FACTOR_ENABLED_KEY      = "res_succ_two_factor_enabled"
+	RES_SUCC_OK_KEY                      = "res_succ_ok"
 )
 
 var (
@@ -46,4 +54,35 @@ var (
 		Key:        RES_SUCC_LOGIN_KEY,
 		Message:    "Sign up successfully!",
 	}
+
+	RES_SUCC_TWO_FACTOR_REQUIRED = ResponseTemplate{
+		Success:    true,
+		StatusCode: 200,
+		Code:       RES_SUCC_TWO_FACTOR_REQUIRED_CODE,
+		Key:        RES_SUCC_TWO_FACTOR_REQUIRED_KEY,
+		Message:    "Please enter the code from your authenticator app.",
+	}
+
+	RES_SUCC_TOTP_ENROLLMENT_STARTED = ResponseTemplate{
+		Success:    true,
+		StatusCode: 201,
+		Code:       RES_SUCC_TOTP_ENROLLMENT_STARTED_CODE,
+		Key:        RES_SUCC_TOTP_ENROLLMENT_STARTED_KEY,
+		Message:    "Scan the QR code with your authenticator app and confirm with a code.",
+	}
+
+	RES_SUCC_TWO_FACTOR_ENABLED = ResponseTemplate{
+		Success:    true,
+		StatusCode: 200,
+		Code:       RES_SUCC_TWO_FACTOR_ENABLED_CODE,
+		Key:        RES_SUCC_TWO_FACTOR_ENABLED_KEY,
+		Message:    "Two-factor authentication has been enabled, keep your recovery codes somewhere safe.",
+	}
+
+	RES_SUCC_OK = ResponseTemplate{
+		Success:    true,
+		StatusCode: 200,
+		Code:       RES_SUCC_OK_CODE,
+		Key:        RES_SUCC_OK_KEY,
+	}
 )
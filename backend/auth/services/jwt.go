@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"sen1or/letslive/auth/config"
 	"sen1or/letslive/auth/domains"
@@ -15,15 +17,35 @@ import (
 )
 
 type JWTService struct {
-	repo   domains.RefreshTokenRepository
-	config config.JWT
+	repo           domains.RefreshTokenRepository
+	config         config.JWT
+	accessKeySet   *KeySet
+	refreshKeySet  *KeySet
 }
 
-func NewJWTService(repo domains.RefreshTokenRepository, cfg config.JWT) *JWTService {
-	return &JWTService{
-		repo:   repo,
-		config: cfg,
+// access and refresh tokens never share a key, otherwise a refresh token would pass as an access token at the gateway
+func NewJWTService(repo domains.RefreshTokenRepository, cfg config.JWT) (*JWTService, error) {
+	accessKeySet, err := NewKeySet(cfg.AccessSigningKeys, os.Getenv("ACCESS_TOKEN_SECRET"), cfg.AcceptLegacyTokens)
+	if err != nil {
+		return nil, fmt.Errorf("access token keys: %w", err)
+	}
+
+	refreshKeySet, err := NewKeySet(cfg.RefreshSigningKeys, os.Getenv("REFRESH_TOKEN_SECRET"), cfg.AcceptLegacyTokens)
+	if err != nil {
+		return nil, fmt.Errorf("refresh token keys: %w", err)
 	}
+
+	return &JWTService{
+		repo:          repo,
+		config:        cfg,
+		accessKeySet:  accessKeySet,
+		refreshKeySet: refreshKeySet,
+	}, nil
+}
+
+// JWKS publishes the public keys used to verify access tokens
+func (c *JWTService) JWKS() types.JWKS {
+	return c.accessKeySet.JWKS()
 }
 
 // generate the refresh token with access token (for login and signup)
@@ -50,9 +72,7 @@ func (c *JWTService) GenerateTokenPair(ctx context.Context, userId string) (*typ
 // the process is called "refresh token"
 func (c *JWTService) RefreshToken(ctx context.Context, refreshToken string) (*types.AccessTokenInformation, *serviceresponse.Response[any]) {
 	myClaims := types.MyClaims{}
-	parsedToken, err := jwt.NewParser().ParseWithClaims(refreshToken, &myClaims, func(t *jwt.Token) (any, error) {
-		return []byte(os.Getenv("REFRESH_TOKEN_SECRET")), nil
-	})
+	parsedToken, err := c.refreshKeySet.Parse(refreshToken, &myClaims)
 
 	if err != nil {
 		logger.Errorf(ctx, "token parsing failed: %s", err)
@@ -84,6 +104,19 @@ func (c *JWTService) RefreshToken(ctx context.Context, refreshToken string) (*ty
 	}, nil
 }
 
+// ParseAccessToken verifies the signature and expiry of an access token and returns its claims
+func (c *JWTService) ParseAccessToken(accessToken string) (*types.MyClaims, error) {
+	myClaims := types.MyClaims{}
+	parsedToken, err := c.accessKeySet.Parse(accessToken, &myClaims)
+	if err != nil {
+		return nil, err
+	} else if !parsedToken.Valid {
+		return nil, errors.New("token not valid")
+	}
+
+	return &myClaims, nil
+}
+
 func (c *JWTService) generateRefreshToken(ctx context.Context, userId string) (string, *serviceresponse.Response[any]) {
 	refreshTokenExpiresDuration := time.Duration(c.config.RefreshTokenMaxAge) * time.Second
 	refreshTokenExpiresAt := time.Now().Add(refreshTokenExpiresDuration)
@@ -98,9 +131,7 @@ func (c *JWTService) generateRefreshToken(ctx context.Context, userId string) (s
 			Subject:   c.config.Subject,
 		},
 	}
-	unsignedRefreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, myClaims)
-
-	refreshToken, err := unsignedRefreshToken.SignedString([]byte(os.Getenv("REFRESH_TOKEN_SECRET")))
+	refreshToken, err := c.refreshKeySet.Sign(myClaims)
 	if err != nil {
 		return "", serviceresponse.NewResponseFromTemplate[any](
 			serviceresponse.RES_ERR_INTERNAL_SERVER,
@@ -138,9 +169,7 @@ func (c *JWTService) generateAccessToken(userId string) (string, *servicerespons
 			Subject:   c.config.Subject,
 		},
 	}
-	unsignedAccessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, myClaims)
-
-	accessToken, err := unsignedAccessToken.SignedString([]byte(os.Getenv("ACCESS_TOKEN_SECRET")))
+	accessToken, err := c.accessKeySet.Sign(myClaims)
 	if err != nil {
 		return "", serviceresponse.NewResponseFromTemplate[any](
 			serviceresponse.RES_ERR_INTERNAL_SERVER,
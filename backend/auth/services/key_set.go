@@ -0,0 +1,206 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sen1or/letslive/auth/config"
+	"sen1or/letslive/auth/types"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+}
+
+// KeySet signs and verifies one kind of token (access or refresh).
+// with asymmetric keys configured, tokens carry the kid of the signing key in their header,
+// otherwise it falls back to HS256 with the legacy shared secret.
+type KeySet struct {
+	active       *signingKey
+	keys         map[string]*signingKey
+	orderedKeys  []*signingKey // config order, keeps the published JWKS stable
+	legacySecret []byte
+	acceptLegacy bool
+}
+
+func NewKeySet(keyConfigs []config.SigningKey, legacySecret string, acceptLegacy bool) (*KeySet, error) {
+	keySet := &KeySet{
+		keys:         make(map[string]*signingKey, len(keyConfigs)),
+		legacySecret: []byte(legacySecret),
+		acceptLegacy: acceptLegacy,
+	}
+
+	for _, keyConfig := range keyConfigs {
+		if len(keyConfig.Kid) == 0 {
+			return nil, errors.New("signing key is missing a kid")
+		}
+
+		if _, existed := keySet.keys[keyConfig.Kid]; existed {
+			return nil, fmt.Errorf("duplicated signing key kid %s", keyConfig.Kid)
+		}
+
+		key, err := loadSigningKey(keyConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing key %s: %w", keyConfig.Kid, err)
+		}
+		keySet.keys[key.kid] = key
+		keySet.orderedKeys = append(keySet.orderedKeys, key)
+
+		if keyConfig.Active {
+			if keySet.active != nil {
+				return nil, fmt.Errorf("more than one active signing key: %s and %s", keySet.active.kid, key.kid)
+			}
+			keySet.active = key
+		}
+	}
+
+	if len(keySet.keys) > 0 && keySet.active == nil {
+		return nil, errors.New("no active signing key configured")
+	}
+
+	if keySet.active == nil && len(keySet.legacySecret) == 0 {
+		return nil, errors.New("neither signing keys nor a legacy secret configured")
+	}
+
+	return keySet, nil
+}
+
+func loadSigningKey(keyConfig config.SigningKey) (*signingKey, error) {
+	var pemBytes []byte
+	switch {
+	case len(keyConfig.PrivateKeyPath) > 0:
+		b, err := os.ReadFile(keyConfig.PrivateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		pemBytes = b
+	case len(keyConfig.PrivateKeyEnv) > 0:
+		pemBytes = []byte(os.Getenv(keyConfig.PrivateKeyEnv))
+	default:
+		return nil, errors.New("either private-key-path or private-key-env is required")
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		// "openssl genrsa" writes PKCS#1
+		rsaKey, rsaErr := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if rsaErr != nil {
+			return nil, err
+		}
+		parsedKey = rsaKey
+	}
+
+	switch privateKey := parsedKey.(type) {
+	case *rsa.PrivateKey:
+		if privateKey.N.BitLen() < 2048 {
+			return nil, errors.New("rsa keys must be at least 2048 bits")
+		}
+		return &signingKey{kid: keyConfig.Kid, method: jwt.SigningMethodRS256, privateKey: privateKey}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{kid: keyConfig.Kid, method: jwt.SigningMethodEdDSA, privateKey: privateKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsedKey)
+	}
+}
+
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if k.active == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(k.legacySecret)
+	}
+
+	token := jwt.NewWithClaims(k.active.method, claims)
+	token.Header["kid"] = k.active.kid
+	return token.SignedString(k.active.privateKey)
+}
+
+// Parse verifies the token with the key named by its kid header
+func (k *KeySet) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.NewParser(jwt.WithValidMethods(k.validMethods())).ParseWithClaims(tokenString, claims, k.keyFunc)
+}
+
+func (k *KeySet) validMethods() []string {
+	methods := []string{}
+	if k.legacyEnabled() {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	seen := map[string]bool{}
+	for _, key := range k.orderedKeys {
+		if !seen[key.method.Alg()] {
+			seen[key.method.Alg()] = true
+			methods = append(methods, key.method.Alg())
+		}
+	}
+
+	return methods
+}
+
+func (k *KeySet) legacyEnabled() bool {
+	return len(k.legacySecret) > 0 && (k.active == nil || k.acceptLegacy)
+}
+
+func (k *KeySet) keyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	if len(kid) == 0 {
+		if t.Method.Alg() == jwt.SigningMethodHS256.Alg() && k.legacyEnabled() {
+			return k.legacySecret, nil
+		}
+		return nil, errors.New("token is missing the kid header")
+	}
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %s", kid)
+	}
+
+	if key.method.Alg() != t.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for kid %s", t.Method.Alg(), kid)
+	}
+
+	return key.privateKey.Public(), nil
+}
+
+// JWKS returns the public part of every configured key, including the retired ones that still verify tokens
+func (k *KeySet) JWKS() types.JWKS {
+	jwks := types.JWKS{Keys: make([]types.JWK, 0, len(k.orderedKeys))}
+	for _, key := range k.orderedKeys {
+		jwk := types.JWK{
+			Kid: key.kid,
+			Use: "sig",
+			Alg: key.method.Alg(),
+		}
+
+		switch publicKey := key.privateKey.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		default:
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"sen1or/letslive/auth/config"
+	"sen1or/letslive/auth/types"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func setKeyEnv(t *testing.T, name string, key any) {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(name, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+}
+
+func testClaims() *types.MyClaims {
+	return &types.MyClaims{
+		UserId: "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setKeyEnv(t, "TEST_OLD_KEY", rsaKey)
+	setKeyEnv(t, "TEST_NEW_KEY", edKey)
+
+	before, err := NewKeySet([]config.SigningKey{
+		{Kid: "old", PrivateKeyEnv: "TEST_OLD_KEY", Active: true},
+	}, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldToken, err := before.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// after the rotation the old key only verifies
+	after, err := NewKeySet([]config.SigningKey{
+		{Kid: "old", PrivateKeyEnv: "TEST_OLD_KEY"},
+		{Kid: "new", PrivateKeyEnv: "TEST_NEW_KEY", Active: true},
+	}, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newToken, err := after.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tokenString := range []string{oldToken, newToken} {
+		if _, err := after.Parse(tokenString, &types.MyClaims{}); err != nil {
+			t.Fatalf("expected token to be valid after rotation: %s", err)
+		}
+	}
+
+	parsed, _ := after.Parse(newToken, &types.MyClaims{})
+	if parsed.Header["kid"] != "new" || parsed.Method.Alg() != "EdDSA" {
+		t.Fatalf("unexpected header %v", parsed.Header)
+	}
+
+	if _, err := before.Parse(newToken, &types.MyClaims{}); err == nil {
+		t.Fatal("expected token with unknown kid to be rejected")
+	}
+
+	jwks := after.JWKS()
+	if len(jwks.Keys) != 2 || jwks.Keys[0].Kty != "RSA" || jwks.Keys[1].Kty != "OKP" {
+		t.Fatalf("unexpected jwks %+v", jwks)
+	}
+}
+
+func TestKeySetLegacyTokens(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setKeyEnv(t, "TEST_KEY", edKey)
+
+	legacy, err := NewKeySet(nil, "secret", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyToken, err := legacy.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []config.SigningKey{{Kid: "k1", PrivateKeyEnv: "TEST_KEY", Active: true}}
+
+	strict, err := NewKeySet(keys, "secret", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strict.Parse(legacyToken, &types.MyClaims{}); err == nil {
+		t.Fatal("expected legacy token to be rejected")
+	}
+
+	migrating, err := NewKeySet(keys, "secret", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrating.Parse(legacyToken, &types.MyClaims{}); err != nil {
+		t.Fatalf("expected legacy token to be accepted while migrating: %s", err)
+	}
+}
+
+func TestNewKeySetValidation(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setKeyEnv(t, "TEST_KEY", edKey)
+
+	if _, err := NewKeySet([]config.SigningKey{{Kid: "k1", PrivateKeyEnv: "TEST_KEY"}}, "", false); err == nil {
+		t.Fatal("expected an error without an active key")
+	}
+	if _, err := NewKeySet([]config.SigningKey{
+		{Kid: "k1", PrivateKeyEnv: "TEST_KEY", Active: true},
+		{Kid: "k1", PrivateKeyEnv: "TEST_KEY"},
+	}, "", false); err == nil {
+		t.Fatal("expected an error for duplicated kids")
+	}
+	if _, err := NewKeySet(nil, "", false); err == nil {
+		t.Fatal("expected an error without any key")
+	}
+}
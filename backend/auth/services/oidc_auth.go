@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"sen1or/letslive/auth/domains"
+	"sen1or/letslive/auth/dto"
+	oidcgateway "sen1or/letslive/auth/gateway/oidc"
+	oidcgatewaydto "sen1or/letslive/auth/gateway/oidc/dto"
+	usergateway "sen1or/letslive/auth/gateway/user"
+	usergatewaydto "sen1or/letslive/auth/gateway/user/dto"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/auth/utils"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type OIDCAuthService struct {
+	providers     map[string]oidcgateway.Provider
+	providerNames []string // config order, used when listing the providers
+	authRepo      domains.AuthRepository
+	identityRepo  domains.AuthIdentityRepository
+	userGateway   usergateway.UserGateway
+}
+
+func NewOIDCAuthService(providers []oidcgateway.Provider, authRepo domains.AuthRepository, identityRepo domains.AuthIdentityRepository, userGateway usergateway.UserGateway) *OIDCAuthService {
+	s := &OIDCAuthService{
+		providers:    make(map[string]oidcgateway.Provider, len(providers)),
+		authRepo:     authRepo,
+		identityRepo: identityRepo,
+		userGateway:  userGateway,
+	}
+
+	for _, provider := range providers {
+		s.providers[provider.Name()] = provider
+		s.providerNames = append(s.providerNames, provider.Name())
+	}
+
+	return s
+}
+
+func (s OIDCAuthService) ListProviders() []dto.OIDCProviderResponseDTO {
+	providers := make([]dto.OIDCProviderResponseDTO, 0, len(s.providerNames))
+	for _, name := range s.providerNames {
+		providers = append(providers, dto.OIDCProviderResponseDTO{
+			Name:        name,
+			DisplayName: s.providers[name].DisplayName(),
+		})
+	}
+
+	return providers
+}
+
+// GenerateAuthCodeURL returns the url the user is redirected to, state, nonce and verifier are kept by the caller for the callback
+func (s OIDCAuthService) GenerateAuthCodeURL(ctx context.Context, providerName, state, nonce, verifier string) (string, *serviceresponse.Response[any]) {
+	provider, err := s.getProvider(providerName)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, urlErr := provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if urlErr != nil {
+		logger.Errorf(ctx, "failed to build authorization url of provider %s: %s", providerName, urlErr)
+		return "", serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return authURL, nil
+}
+
+// CallbackLogIn exchanges the authorization code and logs the user in, see logIn
+func (s OIDCAuthService) CallbackLogIn(ctx context.Context, providerName, code, verifier, nonce string) (*domains.Auth, *serviceresponse.Response[any]) {
+	provider, claims, err := s.exchange(ctx, providerName, code, verifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.logIn(ctx, provider, claims)
+}
+
+func (s OIDCAuthService) LogInWithIDToken(ctx context.Context, providerName string, req dto.OIDCMobileLogInRequestDTO) (*domains.Auth, *serviceresponse.Response[any]) {
+	if err := utils.Validator.Struct(&req); err != nil {
+		return nil, serviceresponse.NewResponseWithValidationErrors[any](nil, nil, err)
+	}
+
+	provider, err := s.getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, verifyErr := provider.VerifyIDToken(ctx, req.IDToken, req.Nonce)
+	if verifyErr != nil {
+		logger.Warnf(ctx, "failed to verify id token of provider %s: %s", providerName, verifyErr)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_OIDC_AUTHENTICATION_FAILED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.logIn(ctx, provider, claims)
+}
+
+// CallbackLink attaches the provider account to the already logged in user
+func (s OIDCAuthService) CallbackLink(ctx context.Context, userId uuid.UUID, providerName, code, verifier, nonce string) *serviceresponse.Response[any] {
+	provider, claims, err := s.exchange(ctx, providerName, code, verifier, nonce)
+	if err != nil {
+		return err
+	}
+
+	identity, err := s.identityRepo.GetByProviderSubject(ctx, provider.Name(), claims.Subject)
+	if err == nil {
+		// linking the same account twice is a no-op
+		if identity.UserId != userId {
+			return serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_OIDC_IDENTITY_ALREADY_LINKED,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		return nil
+	} else if err.Code != serviceresponse.RES_ERR_AUTH_IDENTITY_NOT_FOUND_CODE {
+		return err
+	}
+
+	_, err = s.identityRepo.Create(ctx, newAuthIdentity(userId, provider.Name(), claims))
+	return err
+}
+
+func (s OIDCAuthService) ListIdentities(ctx context.Context, userId uuid.UUID) ([]domains.AuthIdentity, *serviceresponse.Response[any]) {
+	return s.identityRepo.GetByUserID(ctx, userId)
+}
+
+// Unlink removes the provider from the user, unless it is the only way left to log in. A password, another
+// identity or a google sign up are the other ways
+func (s OIDCAuthService) Unlink(ctx context.Context, userId uuid.UUID, providerName string) *serviceresponse.Response[any] {
+	auth, err := s.authRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	identities, err := s.identityRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if len(auth.PasswordHash) == 0 && len(identities) <= 1 {
+		// users who signed up with google log in through the legacy google flow, which keeps no identity
+		user, err := s.userGateway.GetUserById(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if user.AuthProvider != usergatewaydto.ProviderGoogle {
+			return serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_OIDC_LAST_LOGIN_METHOD,
+				nil,
+				nil,
+				nil,
+			)
+		}
+	}
+
+	return s.identityRepo.Delete(ctx, userId, providerName)
+}
+
+// logIn finds the user linked to the provider account. on the first login it either links
+// an existing account with the same email (only for providers trusted to verify emails) or creates a new user
+func (s OIDCAuthService) logIn(ctx context.Context, provider oidcgateway.Provider, claims *oidcgatewaydto.OIDCClaims) (*domains.Auth, *serviceresponse.Response[any]) {
+	identity, err := s.identityRepo.GetByProviderSubject(ctx, provider.Name(), claims.Subject)
+	if err == nil {
+		if err := s.identityRepo.UpdateLastLoginAt(ctx, identity.Id, time.Now()); err != nil {
+			logger.Warnf(ctx, "failed to update last login of identity %s: %s", identity.Id, err.Message)
+		}
+		return s.authRepo.GetByUserID(ctx, identity.UserId)
+	} else if err.Code != serviceresponse.RES_ERR_AUTH_IDENTITY_NOT_FOUND_CODE {
+		return nil, err
+	}
+
+	if len(claims.Email) == 0 || !claims.EmailVerified {
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_OIDC_EMAIL_REQUIRED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	existedAuth, err := s.authRepo.GetByEmail(ctx, claims.Email)
+	if err == nil {
+		if !provider.TrustEmail() {
+			return nil, serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_OIDC_ACCOUNT_NOT_LINKED,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		if _, err := s.identityRepo.Create(ctx, newAuthIdentity(*existedAuth.UserId, provider.Name(), claims)); err != nil {
+			return nil, err
+		}
+		return existedAuth, nil
+	} else if err.Code != serviceresponse.RES_ERR_AUTH_NOT_FOUND_CODE {
+		return nil, err
+	}
+
+	createdUser, err := s.userGateway.CreateNewUser(ctx, usergatewaydto.CreateUserRequestDTO{
+		Email:        claims.Email,
+		AuthProvider: usergatewaydto.ProviderOIDC,
+	})
+	if err != nil {
+		logger.Errorf(ctx, "failed to create new user through gateway: %s", err.Message)
+		return nil, err
+	}
+
+	createdAuth, err := s.authRepo.Create(ctx, domains.Auth{
+		Email:  claims.Email,
+		UserId: &createdUser.Id,
+	})
+	if err != nil {
+		s.rollBackNewUser(ctx, createdUser.Id, false)
+		return nil, err
+	}
+
+	if _, err := s.identityRepo.Create(ctx, newAuthIdentity(createdUser.Id, provider.Name(), claims)); err != nil {
+		s.rollBackNewUser(ctx, createdUser.Id, true)
+		return nil, err
+	}
+
+	return createdAuth, nil
+}
+
+// rollBackNewUser removes the user created for a first login that could not be finished. Left behind, the user
+// and its auth would keep the email taken without the identity, and every later login would fail the same way
+func (s OIDCAuthService) rollBackNewUser(ctx context.Context, userId uuid.UUID, authCreated bool) {
+	if authCreated {
+		if err := s.authRepo.DeleteByUserID(ctx, userId); err != nil {
+			logger.Errorf(ctx, "failed to roll back the auth of user %s created for an oidc login, the user is orphaned: %s", userId, err.Message)
+			return
+		}
+	}
+
+	if err := s.userGateway.DeleteUser(ctx, userId); err != nil {
+		logger.Errorf(ctx, "failed to roll back user %s created for an oidc login, the user is orphaned: %s", userId, err.Message)
+	}
+}
+
+func (s OIDCAuthService) exchange(ctx context.Context, providerName, code, verifier, nonce string) (oidcgateway.Provider, *oidcgatewaydto.OIDCClaims, *serviceresponse.Response[any]) {
+	provider, err := s.getProvider(providerName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claims, exchangeErr := provider.Exchange(ctx, code, verifier, nonce)
+	if exchangeErr != nil {
+		logger.Warnf(ctx, "failed to authenticate with provider %s: %s", providerName, exchangeErr)
+		return nil, nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_OIDC_AUTHENTICATION_FAILED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return provider, claims, nil
+}
+
+func (s OIDCAuthService) getProvider(name string) (oidcgateway.Provider, *serviceresponse.Response[any]) {
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_OIDC_PROVIDER_NOT_FOUND,
+			nil,
+			nil,
+			&serviceresponse.ErrorDetails{serviceresponse.ErrorDetail{"provider": name}},
+		)
+	}
+
+	return provider, nil
+}
+
+func newAuthIdentity(userId uuid.UUID, provider string, claims *oidcgatewaydto.OIDCClaims) domains.AuthIdentity {
+	identity := domains.AuthIdentity{
+		UserId:   userId,
+		Provider: provider,
+		Subject:  claims.Subject,
+	}
+	if len(claims.Email) > 0 {
+		identity.Email = &claims.Email
+	}
+
+	return identity
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"sen1or/letslive/auth/config"
+	"sen1or/letslive/auth/domains"
+	"sen1or/letslive/auth/dto"
+	serviceresponse "sen1or/letslive/auth/response"
+	"sen1or/letslive/auth/utils"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type TwoFactorService struct {
+	totpRepo      domains.TOTPRepository
+	challengeRepo domains.TwoFactorChallengeRepository
+	authRepo      domains.AuthRepository
+	config        config.TwoFactor
+}
+
+func NewTwoFactorService(totpRepo domains.TOTPRepository, challengeRepo domains.TwoFactorChallengeRepository, authRepo domains.AuthRepository, cfg config.TwoFactor) *TwoFactorService {
+	return &TwoFactorService{
+		totpRepo:      totpRepo,
+		challengeRepo: challengeRepo,
+		authRepo:      authRepo,
+		config:        cfg,
+	}
+}
+
+func (s TwoFactorService) GetStatus(ctx context.Context, userId uuid.UUID) (*dto.TwoFactorStatusResponseDTO, *serviceresponse.Response[any]) {
+	enabled, err := s.IsEnabled(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &dto.TwoFactorStatusResponseDTO{Enabled: enabled}
+	if !enabled {
+		return status, nil
+	}
+
+	remaining, err := s.totpRepo.CountUnusedRecoveryCodes(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	status.RemainingRecoveryCodes = remaining
+
+	return status, nil
+}
+
+func (s TwoFactorService) IsEnabled(ctx context.Context, userId uuid.UUID) (bool, *serviceresponse.Response[any]) {
+	credential, err := s.totpRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		if err.Code == serviceresponse.RES_ERR_TOTP_CREDENTIAL_NOT_FOUND_CODE {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return credential.EnabledAt != nil, nil
+}
+
+// BeginEnrollment creates a new pending secret, calling it again before confirming replaces the previous one
+func (s TwoFactorService) BeginEnrollment(ctx context.Context, userId uuid.UUID) (*dto.TOTPEnrollmentResponseDTO, *serviceresponse.Response[any]) {
+	auth, err := s.authRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, genErr := utils.GenerateTOTPSecret()
+	if genErr != nil {
+		logger.Errorf(ctx, "failed to generate totp secret: %s", genErr)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	encryptedSecret, encErr := utils.EncryptTOTPSecret(secret)
+	if encErr != nil {
+		logger.Errorf(ctx, "failed to encrypt totp secret: %s", encErr)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := s.totpRepo.UpsertPending(ctx, userId, encryptedSecret); err != nil {
+		return nil, err
+	}
+
+	return &dto.TOTPEnrollmentResponseDTO{
+		Secret:          secret,
+		ProvisioningURI: utils.BuildTOTPProvisioningURI(s.config.Issuer, auth.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables 2FA once the user proves the authenticator app is set up,
+// the returned recovery codes are only shown this once
+func (s TwoFactorService) ConfirmEnrollment(ctx context.Context, userId uuid.UUID, req dto.ConfirmTOTPRequestDTO) (*dto.RecoveryCodesResponseDTO, *serviceresponse.Response[any]) {
+	if err := utils.Validator.Struct(&req); err != nil {
+		return nil, serviceresponse.NewResponseWithValidationErrors[any](nil, nil, err)
+	}
+
+	credential, err := s.totpRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.EnabledAt != nil {
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_ALREADY_ENABLED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	step, ok, verifyErr := s.verifyCode(ctx, credential, req.Code)
+	if verifyErr != nil {
+		return nil, verifyErr
+	} else if !ok {
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_CODE_INVALID,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	recoveryCodes, genErr := utils.GenerateRecoveryCodes(s.config.RecoveryCodeCount)
+	if genErr != nil {
+		logger.Errorf(ctx, "failed to generate recovery codes: %s", genErr)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	hashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		hashes = append(hashes, utils.HashToken(code))
+	}
+
+	if err := s.totpRepo.Enable(ctx, userId, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesResponseDTO{RecoveryCodes: recoveryCodes}, nil
+}
+
+// Disable requires the user to authenticate again: the password (if the account has one)
+// and a valid code from the authenticator app or a recovery code
+func (s TwoFactorService) Disable(ctx context.Context, userId uuid.UUID, req dto.DisableTOTPRequestDTO) *serviceresponse.Response[any] {
+	if err := utils.Validator.Struct(&req); err != nil {
+		return serviceresponse.NewResponseWithValidationErrors[any](nil, nil, err)
+	}
+
+	auth, err := s.authRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if len(auth.PasswordHash) > 0 {
+		if err := bcrypt.CompareHashAndPassword([]byte(auth.PasswordHash), []byte(req.Password)); err != nil {
+			return serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_PASSWORD_NOT_MATCH,
+				nil,
+				nil,
+				nil,
+			)
+		}
+	}
+
+	credential, err := s.totpRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if credential.EnabledAt == nil {
+		return serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_TWO_FACTOR_NOT_ENABLED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := s.consumeSecondFactor(ctx, credential, req.Code, req.RecoveryCode); err != nil {
+		return err
+	}
+
+	return s.totpRepo.Delete(ctx, userId)
+}
+
+// CreateLogInChallenge is called after the first login factor succeeded,
+// the client exchanges the returned token plus a code for the real token pair
+func (s TwoFactorService) CreateLogInChallenge(ctx context.Context, userId uuid.UUID) (*dto.TwoFactorChallengeResponseDTO, *serviceresponse.Response[any]) {
+	token, genErr := utils.GenerateOpaqueToken()
+	if genErr != nil {
+		logger.Errorf(ctx, "failed to generate two factor challenge token: %s", genErr)
+		return nil, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	challenge := domains.TwoFactorChallenge{
+		UserId:    userId,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().Add(time.Duration(s.config.ChallengeMaxAge) * time.Second),
+	}
+
+	if err := s.challengeRepo.Insert(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorChallengeResponseDTO{
+		ChallengeToken: token,
+		ExpiresIn:      s.config.ChallengeMaxAge,
+	}, nil
+}
+
+// VerifyLogInChallenge returns the user id of the challenge when the second factor is valid
+func (s TwoFactorService) VerifyLogInChallenge(ctx context.Context, req dto.VerifyTwoFactorLogInRequestDTO) (*uuid.UUID, *serviceresponse.Response[any]) {
+	if err := utils.Validator.Struct(&req); err != nil {
+		return nil, serviceresponse.NewResponseWithValidationErrors[any](nil, nil, err)
+	}
+
+	challenge, err := s.challengeRepo.GetByTokenHash(ctx, utils.HashToken(req.ChallengeToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// every attempt is counted before the code is checked, a successful one uses the challenge anyway
+	if err := s.challengeRepo.IncrementAttempts(ctx, challenge.Id, s.config.MaxAttempts); err != nil {
+		return nil, err
+	}
+
+	credential, err := s.totpRepo.GetByUserID(ctx, challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.consumeSecondFactor(ctx, credential, req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+
+	if err := s.challengeRepo.UpdateUsedAt(ctx, challenge.Id, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &challenge.UserId, nil
+}
+
+// consumeSecondFactor accepts either a totp code or a recovery code, both can only be used once
+func (s TwoFactorService) consumeSecondFactor(ctx context.Context, credential *domains.TOTPCredential, code, recoveryCode string) *serviceresponse.Response[any] {
+	if len(code) > 0 {
+		step, ok, err := s.verifyCode(ctx, credential, code)
+		if err != nil {
+			return err
+		} else if !ok {
+			return serviceresponse.NewResponseFromTemplate[any](
+				serviceresponse.RES_ERR_TWO_FACTOR_CODE_INVALID,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		return s.totpRepo.ConsumeStep(ctx, credential.UserId, step)
+	}
+
+	return s.totpRepo.ConsumeRecoveryCode(ctx, credential.UserId, utils.HashToken(utils.NormalizeRecoveryCode(recoveryCode)))
+}
+
+func (s TwoFactorService) verifyCode(ctx context.Context, credential *domains.TOTPCredential, code string) (int64, bool, *serviceresponse.Response[any]) {
+	secret, err := utils.DecryptTOTPSecret(credential.Secret)
+	if err != nil {
+		logger.Errorf(ctx, "failed to decrypt totp secret: %s", err)
+		return 0, false, serviceresponse.NewResponseFromTemplate[any](
+			serviceresponse.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	step, ok := utils.ValidateTOTPCode(secret, code, time.Now())
+	return step, ok, nil
+}
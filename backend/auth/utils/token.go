@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"strings"
+)
+
+// no 0/o, 1/l/i to keep recovery codes readable when written down
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateOpaqueToken returns a url safe random token, only its hash should be persisted
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken is used for high entropy values (opaque tokens, recovery codes), bcrypt is not needed there
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateRecoveryCodes returns codes formatted as "xxxxx-xxxxx"
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	codes := make([]string, 0, count)
+	for range count {
+		var sb strings.Builder
+		for i := range 10 {
+			if i == 5 {
+				sb.WriteByte('-')
+			}
+
+			n, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteByte(recoveryCodeAlphabet[n.Int64()])
+		}
+		codes = append(codes, sb.String())
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode makes the lookup tolerant to casing, spaces and missing dashes
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, " ", "")
+	code = strings.ReplaceAll(code, "-", "")
+	if len(code) == 10 {
+		code = code[:5] + "-" + code[5:]
+	}
+
+	return code
+}
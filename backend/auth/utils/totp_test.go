@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// secret "12345678901234567890" from RFC 6238 appendix B, encoded in base32
+const rfcTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateTOTPCode_RFCVectors(t *testing.T) {
+	// the RFC lists 8 digit codes, these are their last 6 digits
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, c := range cases {
+		step, ok := ValidateTOTPCode(rfcTestSecret, c.code, time.Unix(c.unix, 0))
+		if !ok {
+			t.Fatalf("expected code %s to be valid at %d", c.code, c.unix)
+		}
+		if step != c.unix/totpPeriod {
+			t.Fatalf("expected step %d, got %d", c.unix/totpPeriod, step)
+		}
+	}
+}
+
+func TestValidateTOTPCode_Skew(t *testing.T) {
+	at := time.Unix(1111111111, 0)
+
+	if _, ok := ValidateTOTPCode(rfcTestSecret, "050471", at.Add(totpPeriod*time.Second)); !ok {
+		t.Fatal("expected code from the previous period to be accepted")
+	}
+	if _, ok := ValidateTOTPCode(rfcTestSecret, "050471", at.Add(3*totpPeriod*time.Second)); ok {
+		t.Fatal("expected code outside of the allowed skew to be rejected")
+	}
+	if _, ok := ValidateTOTPCode(rfcTestSecret, "12345", at); ok {
+		t.Fatal("expected code with wrong length to be rejected")
+	}
+}
+
+func TestTOTPSecretEncryptionRoundTrip(t *testing.T) {
+	t.Setenv("TOTP_ENCRYPTION_KEY", "test-key")
+
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := EncryptTOTPSecret(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted == secret {
+		t.Fatal("expected secret to be encrypted")
+	}
+
+	decrypted, err := DecryptTOTPSecret(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != secret {
+		t.Fatalf("expected %s, got %s", secret, decrypted)
+	}
+
+	t.Setenv("TOTP_ENCRYPTION_KEY", "another-key")
+	if _, err := DecryptTOTPSecret(encrypted); err == nil {
+		t.Fatal("expected decryption with a different key to fail")
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, code := range codes {
+		if NormalizeRecoveryCode(code) != code {
+			t.Fatalf("expected generated code %s to already be normalized", code)
+		}
+	}
+
+	if got := NormalizeRecoveryCode(" ABCDE fghjk "); got != "abcde-fghjk" {
+		t.Fatalf("unexpected normalized code %s", got)
+	}
+}
@@ -0,0 +1,36 @@
+import { Request, Response } from 'express'
+import { AlertService } from '../services/alertService'
+import { RESPONSE_TEMPLATES, newResponseFromTemplate, Response as ServiceResponse } from '../types/api-response'
+
+function writeResponse(req: Request, res: Response, resData: ServiceResponse<any>) {
+    resData.requestId = req.requestId ?? ''
+    res.status(resData.statusCode).json(resData)
+}
+
+function isBoundedString(value: unknown, maxLength: number): value is string {
+    return typeof value === 'string' && value.length > 0 && value.length <= maxLength
+}
+
+// AlertHandler serves the internal routes other services use to raise on-stream alerts, they are not exposed through kong
+export class AlertHandler {
+    constructor(private alertService: AlertService) {}
+
+    postDonation = async (req: Request, res: Response) => {
+        const roomId = req.params.roomId
+        const { userId, username, amount, currencyCode, text } = req.body ?? {}
+        if (
+            !isBoundedString(roomId, 36) ||
+            !isBoundedString(userId, 36) ||
+            !isBoundedString(username, 50) ||
+            !isBoundedString(amount, 32) ||
+            !isBoundedString(currencyCode, 16) ||
+            (text !== undefined && text !== null && (typeof text !== 'string' || text.length > 500))
+        ) {
+            writeResponse(req, res, newResponseFromTemplate<void>(RESPONSE_TEMPLATES.RES_ERR_INVALID_INPUT))
+            return
+        }
+
+        await this.alertService.publishDonation(roomId, { userId, username, amount, currencyCode, text })
+        writeResponse(req, res, newResponseFromTemplate<void>(RESPONSE_TEMPLATES.RES_SUCC_OK))
+    }
+}
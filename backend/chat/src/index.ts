@@ -13,6 +13,8 @@ import { ConversationHandler } from './handlers/conversationHandler'
 import { DmMessageHandler } from './handlers/dmMessageHandler'
 import { ChatCommandHandler } from './handlers/chatCommandHandler'
 import { ChatCommandService } from './services/chatCommandService'
+import { AlertHandler } from './handlers/alertHandler'
+import { AlertService } from './services/alertService'
 import { authMiddleware, extractUserIdFromCookie } from './middlewares/auth'
 import esMain from 'es-main'
 import { createServer, Server } from 'http'
@@ -80,6 +82,11 @@ function CreateExpressServer() {
     app.patch('/v1/chat-commands/:id', authMiddleware, asyncHandler(chatCommandHandler.update))
     app.delete('/v1/chat-commands/:id', authMiddleware, asyncHandler(chatCommandHandler.delete))
 
+    // --- On-stream Alerts (internal, service-to-service) ---
+    const alertService = new AlertService(new Redis(6379, 'chat_pubsub'))
+    const alertHandler = new AlertHandler(alertService)
+    app.post('/v1/internal/rooms/:roomId/donations', asyncHandler(alertHandler.postDonation))
+
     // --- DM/Group Conversation Routes ---
     const conversationService = new ConversationService()
     const dmMessageService = new DmMessageService()
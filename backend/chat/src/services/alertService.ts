@@ -0,0 +1,28 @@
+import Redis from 'ioredis'
+import { ChatEventType, DonationEvent } from '../types/chat-event'
+
+export type DonationAlert = {
+    userId: string
+    username: string
+    amount: string
+    currencyCode: string
+    text?: string | null
+}
+
+// AlertService pushes alerts coming from other services into a room, the chat server forwards them like any room event
+export class AlertService {
+    constructor(private pub: Redis) {}
+
+    async publishDonation(room: string, alert: DonationAlert) {
+        const event: DonationEvent = {
+            type: ChatEventType.DONATION,
+            userId: alert.userId,
+            username: alert.username,
+            amount: alert.amount,
+            currencyCode: alert.currencyCode,
+            text: alert.text ?? null,
+            timestamp: Date.now()
+        }
+        await this.pub.publish(`room:${room}:events`, JSON.stringify(event))
+    }
+}
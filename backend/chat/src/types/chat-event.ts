@@ -4,7 +4,17 @@ export type ChatEvent = {
     userId: string | null
 }
 
+// a donation alert shown on stream, username is the donor's
+export type DonationEvent = ChatEvent & {
+    type: ChatEventType.DONATION
+    amount: string
+    currencyCode: string
+    text: string | null
+    timestamp: number
+}
+
 export enum ChatEventType {
     JOIN = 'join',
-    LEAVE = 'leave'
+    LEAVE = 'leave',
+    DONATION = 'donation'
 }
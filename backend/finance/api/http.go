@@ -9,8 +9,11 @@ import (
 	"sen1or/letslive/finance/handlers/deposit"
 	"sen1or/letslive/finance/handlers/general"
 	"sen1or/letslive/finance/handlers/payment"
+	payouthandler "sen1or/letslive/finance/handlers/payout"
 	purchasehandler "sen1or/letslive/finance/handlers/purchase"
 	shopitemhandler "sen1or/letslive/finance/handlers/shop_item"
+	subscriptionhandler "sen1or/letslive/finance/handlers/subscription"
+	tiphandler "sen1or/letslive/finance/handlers/tip"
 	"sen1or/letslive/finance/handlers/transaction"
 	"sen1or/letslive/finance/handlers/wallet"
 	"sen1or/letslive/shared/middlewares"
@@ -35,6 +38,10 @@ type APIServer struct {
 	depositHandler     *deposit.DepositHandler
 	shopItemHandler    *shopitemhandler.ShopItemHandler
 	purchaseHandler    *purchasehandler.PurchaseHandler
+
+	subscriptionHandler *subscriptionhandler.SubscriptionHandler
+	tipHandler          *tiphandler.TipHandler
+	payoutHandler       *payouthandler.PayoutHandler
 }
 
 func NewAPIServer(
@@ -45,6 +52,9 @@ func NewAPIServer(
 	depositHandler *deposit.DepositHandler,
 	shopItemHandler *shopitemhandler.ShopItemHandler,
 	purchaseHandler *purchasehandler.PurchaseHandler,
+	subscriptionHandler *subscriptionhandler.SubscriptionHandler,
+	tipHandler *tiphandler.TipHandler,
+	payoutHandler *payouthandler.PayoutHandler,
 	cfg *config.Config,
 	db *pgxpool.Pool,
 ) *APIServer {
@@ -60,6 +70,10 @@ func NewAPIServer(
 		depositHandler:     depositHandler,
 		shopItemHandler:    shopItemHandler,
 		purchaseHandler:    purchaseHandler,
+
+		subscriptionHandler: subscriptionHandler,
+		tipHandler:          tipHandler,
+		payoutHandler:       payoutHandler,
 	}
 }
 
@@ -74,6 +88,7 @@ func (a *APIServer) getHandler() http.Handler {
 	wrap("GET /v1/currencies", a.currencyHandler.GetCurrenciesPublicHandler)
 	wrap("GET /v1/shop/items", a.shopItemHandler.GetItemsPublicHandler)
 	wrap("GET /v1/shop/items/{id}", a.shopItemHandler.GetItemPublicHandler)
+	wrap("GET /v1/channels/{channelId}/subscription-tiers", a.subscriptionHandler.GetChannelTiersPublicHandler)
 
 	// Private routes (require JWT via Kong)
 	wrap("POST /v1/shop/purchase", a.purchaseHandler.CreatePurchasePrivateHandler)
@@ -83,9 +98,32 @@ func (a *APIServer) getHandler() http.Handler {
 	wrap("GET /v1/payments", a.paymentHandler.GetPaymentsPrivateHandler)
 	wrap("GET /v1/payments/{paymentId}", a.paymentHandler.GetPaymentByIdPrivateHandler)
 	wrap("POST /v1/deposits", a.depositHandler.InitiateDepositPrivateHandler)
+	wrap("POST /v1/subscription-tiers", a.subscriptionHandler.CreateTierPrivateHandler)
+	wrap("PATCH /v1/subscription-tiers/{tierId}", a.subscriptionHandler.UpdateTierPrivateHandler)
+	wrap("DELETE /v1/subscription-tiers/{tierId}", a.subscriptionHandler.DeleteTierPrivateHandler)
+	wrap("POST /v1/subscriptions", a.subscriptionHandler.SubscribePrivateHandler)
+	wrap("GET /v1/subscriptions", a.subscriptionHandler.GetSubscriptionsPrivateHandler)
+	wrap("DELETE /v1/subscriptions/{subscriptionId}", a.subscriptionHandler.CancelSubscriptionPrivateHandler)
+	wrap("GET /v1/subscribers", a.subscriptionHandler.GetSubscribersPrivateHandler)
+	wrap("POST /v1/tips", a.tipHandler.SendTipPrivateHandler)
+	wrap("GET /v1/tips/received", a.tipHandler.GetReceivedTipsPrivateHandler)
+	wrap("GET /v1/tips/sent", a.tipHandler.GetSentTipsPrivateHandler)
+	wrap("GET /v1/payout-account", a.payoutHandler.GetPayoutAccountPrivateHandler)
+	wrap("PUT /v1/payout-account", a.payoutHandler.SetPayoutAccountPrivateHandler)
+	wrap("POST /v1/payouts", a.payoutHandler.RequestPayoutPrivateHandler)
+	wrap("GET /v1/payouts", a.payoutHandler.GetPayoutsPrivateHandler)
+	wrap("DELETE /v1/payouts/{payoutId}", a.payoutHandler.CancelPayoutPrivateHandler)
+
+	// Internal routes (service-to-service, not exposed through Kong)
+	wrap("POST /v1/internal/subscriptions/badges", a.subscriptionHandler.GetSubscriberBadgesInternalHandler)
+	wrap("GET /v1/internal/payouts", a.payoutHandler.GetPayoutsInternalHandler)
+	wrap("POST /v1/internal/payouts/{payoutId}/approve", a.payoutHandler.ApprovePayoutInternalHandler)
+	wrap("POST /v1/internal/payouts/{payoutId}/reject", a.payoutHandler.RejectPayoutInternalHandler)
+	wrap("PUT /v1/internal/payout-accounts/{userId}/kyc", a.payoutHandler.SetKYCStatusInternalHandler)
 
 	// Public webhook (signature-verified inside handler, no JWT)
 	wrap("POST /v1/deposits/webhook/stripe", a.depositHandler.HandleStripeWebhookPublicHandler)
+	wrap("POST /v1/payouts/webhook/{provider}", a.payoutHandler.HandleWebhookPublicHandler)
 
 	// Health check
 	wrap("GET /v1/health", a.generalHandler.RouteServiceHealth)
@@ -13,25 +13,37 @@ import (
 	gatewaypayment "sen1or/letslive/finance/gateway/payment"
 	mockgateway "sen1or/letslive/finance/gateway/payment/mock"
 	stripegateway "sen1or/letslive/finance/gateway/payment/stripe"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	mockpayoutgateway "sen1or/letslive/finance/gateway/payout/mock"
 	userservicehttp "sen1or/letslive/finance/gateway/userservice/http"
 	currencyHandler "sen1or/letslive/finance/handlers/currency"
 	depositHandler "sen1or/letslive/finance/handlers/deposit"
 	paymentHandler "sen1or/letslive/finance/handlers/payment"
+	payouthandler "sen1or/letslive/finance/handlers/payout"
 	purchaseHandler "sen1or/letslive/finance/handlers/purchase"
 	shopitemhandler "sen1or/letslive/finance/handlers/shop_item"
+	subscriptionhandler "sen1or/letslive/finance/handlers/subscription"
+	tiphandler "sen1or/letslive/finance/handlers/tip"
 	transactionHandler "sen1or/letslive/finance/handlers/transaction"
 	walletHandler "sen1or/letslive/finance/handlers/wallet"
+	"sen1or/letslive/finance/publisher"
 	"sen1or/letslive/finance/repositories"
 	currencyService "sen1or/letslive/finance/services/currency"
 	depositService "sen1or/letslive/finance/services/deposit"
 	paymentService "sen1or/letslive/finance/services/payment"
+	payoutservice "sen1or/letslive/finance/services/payout"
 	purchaseService "sen1or/letslive/finance/services/purchase"
 	shopitemservice "sen1or/letslive/finance/services/shop_item"
+	subscriptionservice "sen1or/letslive/finance/services/subscription"
+	tipservice "sen1or/letslive/finance/services/tip"
 	transactionService "sen1or/letslive/finance/services/transaction"
 	walletService "sen1or/letslive/finance/services/wallet"
 
 	sharedconfig "sen1or/letslive/shared/config"
 	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/eventbus/natsbus"
 	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/shared/pkg/tracer"
 	sharedutils "sen1or/letslive/shared/utils"
@@ -78,7 +90,13 @@ func main() {
 	dbConn := sharedutils.ConnectDB(ctx, config.Database.ConnectionString)
 	defer dbConn.Close()
 
-	server := SetupServer(ctx, dbConn, registry, config)
+	producer := setupEventProducer(ctx, config.EventBus)
+	if producer != nil {
+		defer producer.Close()
+	}
+
+	server, subscriptionSvc := SetupServer(ctx, dbConn, registry, producer, config)
+	go subscriptionSvc.RunRenewals(ctx)
 	go func() {
 		logger.Infof(ctx, "starting server on %s:%d...", config.Service.Hostname, config.Service.APIPort)
 		server.ListenAndServe(ctx, false)
@@ -121,13 +139,46 @@ func main() {
 	logger.Infof(shutdownCtx, "service shut down complete.")
 }
 
-func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) *api.APIServer {
+// setupEventProducer returns nil when no event bus is configured or reachable, the service then runs without publishing
+func setupEventProducer(ctx context.Context, cfg cfg.EventBus) eventbus.Producer {
+	if cfg.URL == "" {
+		logger.Warnf(ctx, "eventBus.url is not set, events will not be published")
+		return nil
+	}
+
+	admin, err := natsbus.NewAdmin(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to connect to the event bus, events will not be published: %v", err)
+		return nil
+	}
+	defer admin.Close()
+
+	if err := admin.EnsureTopics(ctx, events.DefaultTopics()); err != nil {
+		logger.Errorf(ctx, "failed to ensure event bus topics: %v", err)
+	}
+
+	producer, err := natsbus.NewProducer(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the event producer, events will not be published: %v", err)
+		return nil
+	}
+
+	return producer
+}
+
+func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, producer eventbus.Producer, cfg *cfg.Config) (*api.APIServer, *subscriptionservice.SubscriptionService) {
 	// ctx and registry are consumed by storage/gateway constructors added in later PRs (Stripe gateway, etc.)
 	var accountRepo = repositories.NewAccountRepository(dbConn)
 	var currencyRepo = repositories.NewCurrencyRepository(dbConn)
 	var transactionRepo = repositories.NewTransactionRepository(dbConn)
 	var paymentRepo = repositories.NewPaymentRepository(dbConn)
 	var shopItemRepo = repositories.NewShopItemRepository(dbConn)
+	var subscriptionTierRepo = repositories.NewSubscriptionTierRepository(dbConn)
+	var subscriptionRepo = repositories.NewSubscriptionRepository(dbConn)
+	var feeRuleRepo = repositories.NewFeeRuleRepository(dbConn)
+	var tipRepo = repositories.NewTipRepository(dbConn)
+	var payoutAccountRepo = repositories.NewPayoutAccountRepository(dbConn)
+	var payoutRepo = repositories.NewPayoutRepository(dbConn)
 
 	var gateways = []gatewaypayment.PaymentGateway{
 		stripegateway.NewStripeGateway(cfg.Stripe.APIKey, cfg.Stripe.WebhookSecret, cfg.Stripe.SuccessURL, cfg.Stripe.CancelURL, cfg.Stripe.FiatCurrencyCode),
@@ -136,6 +187,11 @@ func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.R
 	if configProfile == "dev" {
 		gateways = append(gateways, mockgateway.NewMockGateway())
 	}
+	var payoutGateways = []gatewaypayout.PayoutGateway{}
+	// the mock payout gateway settles transfers from unsigned webhooks; dev profile only
+	if configProfile == "dev" {
+		payoutGateways = append(payoutGateways, mockpayoutgateway.NewMockGateway())
+	}
 
 	var wSvc = walletService.NewWalletService(accountRepo, currencyRepo)
 	var cSvc = currencyService.NewCurrencyService(currencyRepo)
@@ -147,6 +203,9 @@ func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.R
 
 	var userGateway = userservicehttp.NewUserServiceGateway(registry)
 	var purchaseSvc = purchaseService.NewPurchaseService(accountRepo, currencyRepo, transactionRepo, shopItemRepo, userGateway)
+	var subscriptionSvc = subscriptionservice.NewSubscriptionService(accountRepo, currencyRepo, transactionRepo, feeRuleRepo, subscriptionTierRepo, subscriptionRepo, cfg.Subscription)
+	var tipSvc = tipservice.NewTipService(accountRepo, currencyRepo, transactionRepo, feeRuleRepo, tipRepo, userGateway, publisher.NewEventPublisher(producer))
+	var payoutSvc = payoutservice.NewPayoutService(accountRepo, currencyRepo, transactionRepo, payoutAccountRepo, payoutRepo, payoutGateways, cfg.Payout)
 
 	var wHandler = walletHandler.NewWalletHandler(wSvc)
 	var cHandler = currencyHandler.NewCurrencyHandler(cSvc)
@@ -155,6 +214,9 @@ func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.R
 	var dHandler = depositHandler.NewDepositHandler(dSvc)
 	var siHandler = shopitemhandler.NewShopItemHandler(shopItemSvc)
 	var puchaseHandler = purchaseHandler.NewPurchaseHandler(purchaseSvc)
+	var subHandler = subscriptionhandler.NewSubscriptionHandler(subscriptionSvc)
+	var tipHandler = tiphandler.NewTipHandler(tipSvc)
+	var payoutHandler = payouthandler.NewPayoutHandler(payoutSvc)
 
-	return api.NewAPIServer(wHandler, cHandler, tHandler, pHandler, dHandler, siHandler, puchaseHandler, cfg, dbConn)
+	return api.NewAPIServer(wHandler, cHandler, tHandler, pHandler, dHandler, siHandler, puchaseHandler, subHandler, tipHandler, payoutHandler, cfg, dbConn), subscriptionSvc
 }
@@ -45,12 +45,36 @@ type Stripe struct {
 	WebhookSecret    string
 }
 
+// Subscription controls how channel subscriptions are charged and renewed.
+type Subscription struct {
+	MaxTiersPerChannel int `yaml:"maxTiersPerChannel"`
+	GracePeriod        int `yaml:"gracePeriod"`      // in seconds, how long a failed renewal is retried after the period ended
+	RetryInterval      int `yaml:"retryInterval"`    // in seconds, between the renewal attempts of a past due subscription
+	RenewalInterval    int `yaml:"renewalInterval"`  // in seconds, how often due renewals are picked up
+	RenewalBatchSize   int `yaml:"renewalBatchSize"` // renewals handled per run
+}
+
+// Payout controls how creators withdraw their balance.
+type Payout struct {
+	MinAmounts         map[string]int64 `yaml:"minAmounts"`         // by currency code, in minor units; currencies not listed cannot be withdrawn
+	RequireVerifiedKYC bool             `yaml:"requireVerifiedKyc"` // only payout accounts whose kyc status is verified may withdraw
+}
+
+// EventBus is the NATS server the events of the service are published to, nothing is published when URL is empty
+type EventBus struct {
+	URL string `yaml:"url"`
+}
+
 type Config struct {
 	Service  `yaml:"service"`
 	Database `yaml:"database"`
 	Tracer   `yaml:"tracer"`
 	Deposit  `yaml:"deposit"`
 	Stripe   `yaml:"stripe"`
+	EventBus `yaml:"eventBus"`
+
+	Subscription `yaml:"subscription"`
+	Payout       `yaml:"payout"`
 }
 
 // TracerConfig interface implementation
@@ -78,6 +102,28 @@ func PostProcess(config *Config) error {
 	config.Stripe.APIKey = os.Getenv("FINANCE_STRIPE_API_KEY")
 	config.Stripe.WebhookSecret = os.Getenv("FINANCE_STRIPE_WEBHOOK_SECRET")
 
+	if config.Subscription.MaxTiersPerChannel <= 0 {
+		config.Subscription.MaxTiersPerChannel = 3
+	}
+	if config.Subscription.GracePeriod <= 0 {
+		config.Subscription.GracePeriod = 3 * 86400
+	}
+	if config.Subscription.RetryInterval <= 0 {
+		config.Subscription.RetryInterval = 86400
+	}
+	if config.Subscription.RenewalInterval <= 0 {
+		config.Subscription.RenewalInterval = 60
+	}
+	if config.Subscription.RenewalBatchSize <= 0 {
+		config.Subscription.RenewalBatchSize = 50
+	}
+
+	for code, minAmount := range config.Payout.MinAmounts {
+		if minAmount <= 0 {
+			return fmt.Errorf("payout.minAmounts.%s must be positive", code)
+		}
+	}
+
 	if config.Stripe.FiatCurrencyCode == "" {
 		return fmt.Errorf("stripe.fiatCurrencyCode must be set in config (e.g. \"usd\")")
 	}
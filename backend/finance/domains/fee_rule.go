@@ -0,0 +1,76 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/finance/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type FeeRoundingMode string
+
+const (
+	FeeRoundingUp       FeeRoundingMode = "up"
+	FeeRoundingDown     FeeRoundingMode = "down"
+	FeeRoundingHalfUp   FeeRoundingMode = "half_up"
+	FeeRoundingHalfEven FeeRoundingMode = "half_even"
+)
+
+// FeeRule is one version of the platform fee on a transaction type in a currency, rules are never
+// changed once created, a new fee is a new version
+type FeeRule struct {
+	Id              uuid.UUID       `json:"id" db:"id"`
+	TransactionType TransactionType `json:"transactionType" db:"transaction_type"`
+	CurrencyCode    string          `json:"currencyCode" db:"currency_code"`
+	Version         int             `json:"version" db:"version"`
+	BasisPoints     int64           `json:"basisPoints" db:"basis_points"` // 100 --> 1%
+	MinFee          int64           `json:"-" db:"min_fee"`                // minor units of CurrencyCode
+	RoundingMode    FeeRoundingMode `json:"roundingMode" db:"rounding_mode"`
+	EffectiveFrom   time.Time       `json:"effectiveFrom" db:"effective_from"`
+	CreatedAt       time.Time       `json:"createdAt" db:"created_at"`
+}
+
+const basisPointsPerUnit = 10000
+
+// Split splits the amount into the creator's share and the platform fee of the rule, rounded with the
+// rule's rounding mode and raised to its minimum fee. A nil rule, when none is in force, takes no fee
+func (rule *FeeRule) Split(amount int64) (creatorAmount int64, fee int64) {
+	if rule == nil {
+		return amount, 0
+	}
+
+	fee = roundedFee(amount, rule.BasisPoints, rule.RoundingMode)
+	fee = max(fee, rule.MinFee)
+	fee = min(fee, amount)
+	return amount - fee, fee
+}
+
+// roundedFee computes amount * basisPoints / 10000, splitting the amount so the product does not overflow
+func roundedFee(amount int64, basisPoints int64, mode FeeRoundingMode) int64 {
+	whole := amount/basisPointsPerUnit*basisPoints + amount%basisPointsPerUnit*basisPoints/basisPointsPerUnit
+	remainder := amount % basisPointsPerUnit * basisPoints % basisPointsPerUnit
+
+	switch mode {
+	case FeeRoundingUp:
+		if remainder > 0 {
+			whole++
+		}
+	case FeeRoundingHalfUp:
+		if remainder*2 >= basisPointsPerUnit {
+			whole++
+		}
+	case FeeRoundingHalfEven:
+		if remainder*2 > basisPointsPerUnit || (remainder*2 == basisPointsPerUnit && whole%2 == 1) {
+			whole++
+		}
+	}
+	return whole
+}
+
+type FeeRuleRepository interface {
+	// GetInForce returns the highest version of the rule whose effective_from has passed, nil when there is none
+	GetInForce(ctx context.Context, transactionType TransactionType, currencyCode string) (*FeeRule, *response.Response[any])
+	// GetById returns nil when there is no such rule
+	GetById(ctx context.Context, id uuid.UUID) (*FeeRule, *response.Response[any])
+}
@@ -0,0 +1,43 @@
+package domains
+
+import "testing"
+
+func TestFeeRuleSplit(t *testing.T) {
+	rule := func(basisPoints int64, minFee int64, mode FeeRoundingMode) *FeeRule {
+		return &FeeRule{BasisPoints: basisPoints, MinFee: minFee, RoundingMode: mode}
+	}
+
+	tests := []struct {
+		name      string
+		amount    int64
+		rule      *FeeRule
+		wantShare int64
+		wantFee   int64
+	}{
+		{name: "no rule in force", amount: 1000, rule: nil, wantShare: 1000, wantFee: 0},
+		{name: "exact fee", amount: 1000, rule: rule(500, 0, FeeRoundingDown), wantShare: 950, wantFee: 50},
+		{name: "zero basis points", amount: 1000, rule: rule(0, 0, FeeRoundingUp), wantShare: 1000, wantFee: 0},
+		{name: "down drops the fraction", amount: 1010, rule: rule(500, 0, FeeRoundingDown), wantShare: 960, wantFee: 50},
+		{name: "up rounds any fraction up", amount: 1001, rule: rule(500, 0, FeeRoundingUp), wantShare: 950, wantFee: 51},
+		{name: "half up below half", amount: 1001, rule: rule(500, 0, FeeRoundingHalfUp), wantShare: 951, wantFee: 50},
+		{name: "half up on half", amount: 1010, rule: rule(500, 0, FeeRoundingHalfUp), wantShare: 959, wantFee: 51},
+		{name: "half even on half to even", amount: 1010, rule: rule(500, 0, FeeRoundingHalfEven), wantShare: 960, wantFee: 50},
+		{name: "half even on half from odd", amount: 1030, rule: rule(500, 0, FeeRoundingHalfEven), wantShare: 978, wantFee: 52},
+		{name: "half even above half", amount: 1011, rule: rule(500, 0, FeeRoundingHalfEven), wantShare: 960, wantFee: 51},
+		{name: "minimum fee", amount: 10, rule: rule(500, 5, FeeRoundingDown), wantShare: 5, wantFee: 5},
+		{name: "minimum fee capped at the amount", amount: 3, rule: rule(500, 5, FeeRoundingDown), wantShare: 0, wantFee: 3},
+		{name: "large amount does not overflow", amount: 1 << 62, rule: rule(9999, 0, FeeRoundingUp), wantShare: 461168601842738, wantFee: 4611224849825545166},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			share, fee := tt.rule.Split(tt.amount)
+			if share != tt.wantShare || fee != tt.wantFee {
+				t.Fatalf("got share %d fee %d, want share %d fee %d", share, fee, tt.wantShare, tt.wantFee)
+			}
+			if share+fee != tt.amount {
+				t.Fatalf("share %d and fee %d do not add up to %d", share, fee, tt.amount)
+			}
+		})
+	}
+}
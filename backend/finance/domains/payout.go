@@ -0,0 +1,89 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/finance/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type KYCStatus string
+
+const (
+	KYCStatusPending  KYCStatus = "pending"
+	KYCStatusVerified KYCStatus = "verified"
+	KYCStatusRejected KYCStatus = "rejected"
+)
+
+// PayoutAccount is where the payouts of a creator are sent, Destination is the creator's account at the provider
+type PayoutAccount struct {
+	OwnerId     uuid.UUID       `json:"ownerId" db:"owner_id"`
+	Provider    PaymentProvider `json:"provider" db:"provider"`
+	Destination string          `json:"destination" db:"destination"`
+	KYCStatus   KYCStatus       `json:"kycStatus" db:"kyc_status"`
+	CreatedAt   time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+type PayoutStatus string
+
+const (
+	// PayoutStatusPending is a payout whose amount is held in escrow, waiting for a review
+	PayoutStatusPending PayoutStatus = "pending"
+	// PayoutStatusApproved is a payout being sent to the provider
+	PayoutStatusApproved PayoutStatus = "approved"
+	// PayoutStatusProcessing is a payout the provider accepted, waiting for its webhook
+	PayoutStatusProcessing PayoutStatus = "processing"
+	PayoutStatusCompleted  PayoutStatus = "completed"
+	PayoutStatusFailed     PayoutStatus = "failed"
+	PayoutStatusRejected   PayoutStatus = "rejected"
+	PayoutStatusCancelled  PayoutStatus = "cancelled"
+)
+
+// IsReversed tells whether the held amount of a payout in this status goes back to the creator's wallet
+func (s PayoutStatus) IsReversed() bool {
+	return s == PayoutStatusFailed || s == PayoutStatusRejected || s == PayoutStatusCancelled
+}
+
+type Payout struct {
+	Id                    uuid.UUID       `json:"id" db:"id"`
+	OwnerId               uuid.UUID       `json:"ownerId" db:"owner_id"`
+	CurrencyCode          string          `json:"currencyCode" db:"currency_code"`
+	Amount                int64           `json:"-" db:"amount"`
+	Status                PayoutStatus    `json:"status" db:"status"`
+	Provider              PaymentProvider `json:"provider" db:"provider"`
+	Destination           string          `json:"destination" db:"destination"`
+	ProviderRef           *string         `json:"providerReference" db:"provider_ref"`
+	HoldTransactionId     uuid.UUID       `json:"holdTransactionId" db:"hold_transaction_id"`
+	ReversalTransactionId *uuid.UUID      `json:"reversalTransactionId" db:"reversal_transaction_id"`
+	FailureReason         *string         `json:"failureReason" db:"failure_reason"`
+	ReviewedBy            *uuid.UUID      `json:"reviewedBy" db:"reviewed_by"`
+	ReviewedAt            *time.Time      `json:"reviewedAt" db:"reviewed_at"`
+	CreatedAt             time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt             time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+type PayoutAccountRepository interface {
+	// GetByOwner returns nil when the user has no payout account
+	GetByOwner(ctx context.Context, ownerId uuid.UUID) (*PayoutAccount, *response.Response[any])
+	// Upsert saves the provider and destination of the account, a rejected account goes back to pending
+	Upsert(ctx context.Context, account PayoutAccount) (*PayoutAccount, *response.Response[any])
+	// SetKYCStatus fails with RES_ERR_PAYOUT_ACCOUNT_REQUIRED when the user has no payout account
+	SetKYCStatus(ctx context.Context, ownerId uuid.UUID, status KYCStatus) (*PayoutAccount, *response.Response[any])
+}
+
+type PayoutRepository interface {
+	// Create fails with RES_ERR_PAYOUT_IN_PROGRESS when the owner already has a payout in flight
+	Create(ctx context.Context, payout Payout) (*Payout, *response.Response[any])
+	GetById(ctx context.Context, id uuid.UUID) (*Payout, *response.Response[any])
+	GetByProviderRef(ctx context.Context, provider PaymentProvider, providerRef string) (*Payout, *response.Response[any])
+	// ListByOwner and ListByStatus only return the payouts whose amount was held, the latest first
+	ListByOwner(ctx context.Context, ownerId uuid.UUID, page int, limit int) ([]Payout, int, *response.Response[any])
+	ListByStatus(ctx context.Context, status PayoutStatus, page int, limit int) ([]Payout, int, *response.Response[any])
+	// Transition saves the status, provider reference, failure reason and review of the payout when its
+	// current status is one of from, and fails with RES_ERR_PAYOUT_INVALID_STATUS otherwise
+	Transition(ctx context.Context, payout Payout, from []PayoutStatus) (*Payout, *response.Response[any])
+	// SetReversal links the transaction that moved the held amount back to the wallet
+	SetReversal(ctx context.Context, id uuid.UUID, transactionId uuid.UUID) *response.Response[any]
+}
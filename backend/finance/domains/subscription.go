@@ -0,0 +1,88 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/finance/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SubscriptionTier struct {
+	Id           uuid.UUID `json:"id" db:"id"`
+	ChannelId    uuid.UUID `json:"channelId" db:"channel_id"`
+	Name         string    `json:"name" db:"name"`
+	Description  *string   `json:"description" db:"description"`
+	Price        int64     `json:"-" db:"price"` // minor units of CurrencyCode, charged every period
+	CurrencyCode string    `json:"currencyCode" db:"currency_code"`
+	IsActive     bool      `json:"isActive" db:"is_active"`
+	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type SubscriptionStatus string
+
+const (
+	// SubscriptionStatusPending is a subscription whose first period is being charged
+	SubscriptionStatusPending SubscriptionStatus = "pending"
+	SubscriptionStatusActive  SubscriptionStatus = "active"
+	// SubscriptionStatusPastDue is a subscription whose renewal failed, it is retried until its grace period ends
+	SubscriptionStatusPastDue   SubscriptionStatus = "past_due"
+	SubscriptionStatusCancelled SubscriptionStatus = "cancelled"
+	SubscriptionStatusExpired   SubscriptionStatus = "expired"
+)
+
+type ChannelSubscription struct {
+	Id                 uuid.UUID          `json:"id" db:"id"`
+	SubscriberId       uuid.UUID          `json:"subscriberId" db:"subscriber_id"`
+	ChannelId          uuid.UUID          `json:"channelId" db:"channel_id"`
+	TierId             uuid.UUID          `json:"tierId" db:"tier_id"`
+	Status             SubscriptionStatus `json:"status" db:"status"`
+	Months             int                `json:"months" db:"months"`
+	BillingAnchor      time.Time          `json:"-" db:"billing_anchor"` // start of the first period, the periods end on its day of the month
+	CurrentPeriodStart time.Time          `json:"currentPeriodStart" db:"current_period_start"`
+	CurrentPeriodEnd   time.Time          `json:"currentPeriodEnd" db:"current_period_end"`
+	CancelAtPeriodEnd  bool               `json:"cancelAtPeriodEnd" db:"cancel_at_period_end"`
+	FailedAttempts     int                `json:"failedAttempts" db:"failed_attempts"`
+	GraceUntil         *time.Time         `json:"graceUntil" db:"grace_until"`
+	NextAttemptAt      time.Time          `json:"-" db:"next_attempt_at"`
+	CreatedAt          time.Time          `json:"createdAt" db:"created_at"`
+	UpdatedAt          time.Time          `json:"updatedAt" db:"updated_at"`
+}
+
+// SubscriberBadge is what other services show next to a subscriber of a channel
+type SubscriberBadge struct {
+	UserId   uuid.UUID          `json:"userId" db:"subscriber_id"`
+	TierId   uuid.UUID          `json:"tierId" db:"tier_id"`
+	TierName string             `json:"tierName" db:"tier_name"`
+	Months   int                `json:"months" db:"months"`
+	Status   SubscriptionStatus `json:"status" db:"status"`
+}
+
+type SubscriptionTierRepository interface {
+	Create(ctx context.Context, tier SubscriptionTier) (*SubscriptionTier, *response.Response[any])
+	// Update saves the name, description, price and activity of the tier
+	Update(ctx context.Context, tier SubscriptionTier) (*SubscriptionTier, *response.Response[any])
+	GetById(ctx context.Context, id uuid.UUID) (*SubscriptionTier, *response.Response[any])
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]SubscriptionTier, *response.Response[any])
+	ListActiveByChannel(ctx context.Context, channelId uuid.UUID) ([]SubscriptionTier, *response.Response[any])
+}
+
+type SubscriptionRepository interface {
+	// Create fails with RES_ERR_ALREADY_SUBSCRIBED when the subscriber already has a running subscription to the channel
+	Create(ctx context.Context, subscription ChannelSubscription) (*ChannelSubscription, *response.Response[any])
+	// Update saves the state of the subscription, every column but the ids and created_at. It returns false,
+	// saving nothing, when the subscription was changed since it was read (its updated_at moved)
+	Update(ctx context.Context, subscription ChannelSubscription) (bool, *response.Response[any])
+	// Cancel stops the renewals of an active subscription at the end of its period and cancels a past due one
+	// right away, it returns nil when the subscription is pending or already over
+	Cancel(ctx context.Context, id uuid.UUID) (*ChannelSubscription, *response.Response[any])
+	GetById(ctx context.Context, id uuid.UUID) (*ChannelSubscription, *response.Response[any])
+	ListBySubscriber(ctx context.Context, subscriberId uuid.UUID, page int, limit int) ([]ChannelSubscription, int, *response.Response[any])
+	// ListRunningByChannel lists the active and past due subscriptions to the channel, the oldest first
+	ListRunningByChannel(ctx context.Context, channelId uuid.UUID, page int, limit int) ([]ChannelSubscription, int, *response.Response[any])
+	// ClaimDue returns the running subscriptions due for a renewal attempt, hiding them from other replicas for the lease
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]ChannelSubscription, *response.Response[any])
+	// GetBadges returns the badges of the users running a subscription to the channel
+	GetBadges(ctx context.Context, channelId uuid.UUID, userIds []uuid.UUID) ([]SubscriberBadge, *response.Response[any])
+}
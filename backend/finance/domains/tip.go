@@ -0,0 +1,36 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/finance/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Tip is a donation from a user to a creator, it only counts once its transaction is completed
+type Tip struct {
+	Id            uuid.UUID  `json:"id" db:"id"`
+	TransactionId uuid.UUID  `json:"transactionId" db:"transaction_id"`
+	SenderId      uuid.UUID  `json:"senderId" db:"sender_id"`
+	RecipientId   uuid.UUID  `json:"recipientId" db:"recipient_id"`
+	CurrencyCode  string     `json:"currencyCode" db:"currency_code"`
+	Amount        int64      `json:"-" db:"amount"`
+	CreatorAmount int64      `json:"-" db:"creator_amount"`
+	FeeAmount     int64      `json:"-" db:"fee_amount"`
+	FeeRuleId     *uuid.UUID `json:"feeRuleId" db:"fee_rule_id"`
+	Message       *string    `json:"message" db:"message"`
+	CreatedAt     time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// TipNotifier tells the other services about the tips sent
+type TipNotifier interface {
+	NotifyTipSent(ctx context.Context, tip Tip, precision int)
+}
+
+type TipRepository interface {
+	Create(ctx context.Context, tip Tip) (*Tip, *response.Response[any])
+	// ListByRecipient and ListBySender only return the tips whose transaction is completed
+	ListByRecipient(ctx context.Context, recipientId uuid.UUID, page int, limit int) ([]Tip, int, *response.Response[any])
+	ListBySender(ctx context.Context, senderId uuid.UUID, page int, limit int) ([]Tip, int, *response.Response[any])
+}
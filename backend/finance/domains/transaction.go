@@ -19,6 +19,9 @@ const (
 	TransactionTypeRefund     TransactionType = "refund"
 	TransactionTypeFee        TransactionType = "fee"
 	TransactionTypeAdjustment TransactionType = "adjustment"
+
+	TransactionTypeSubscription TransactionType = "subscription"
+	TransactionTypePayout       TransactionType = "payout"
 )
 
 type ProcessStatus string
@@ -61,6 +64,8 @@ type LedgerEntryDraft struct {
 type TransactionRepository interface {
 	Create(ctx context.Context, tx Transaction) (*Transaction, *response.Response[any])
 	GetById(ctx context.Context, id uuid.UUID) (*Transaction, *response.Response[any])
+	// GetByReference fails with RES_ERR_TRANSACTION_NOT_FOUND when no transaction has the reference
+	GetByReference(ctx context.Context, reference string) (*Transaction, *response.Response[any])
 	GetEntriesForAccount(ctx context.Context, transactionId uuid.UUID, accountId uuid.UUID) ([]LedgerEntry, *response.Response[any])
 	ListByActor(ctx context.Context, actorId uuid.UUID, page int, limit int) ([]Transaction, int, *response.Response[any])
 	// UpdateStatus performs a status-only transition; the DB trigger rejects
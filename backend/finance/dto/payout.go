@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SetPayoutAccountRequestDTO struct {
+	Provider    string `json:"provider" validate:"required"`
+	Destination string `json:"destination" validate:"required,max=64"` // the creator's account at the provider
+}
+
+type RequestPayoutRequestDTO struct {
+	Amount       string `json:"amount" validate:"required"` // decimal amount of CurrencyCode
+	CurrencyCode string `json:"currencyCode" validate:"required"`
+}
+
+type ReviewPayoutRequestDTO struct {
+	ReviewerId uuid.UUID `json:"reviewerId" validate:"required"`
+	Reason     *string   `json:"reason" validate:"omitempty,max=500"`
+}
+
+type SetKYCStatusRequestDTO struct {
+	Status domains.KYCStatus `json:"status" validate:"required,oneof=pending verified rejected"`
+}
+
+type PayoutResponse struct {
+	domains.Payout
+	Amount string `json:"amount"`
+}
+
+func NewPayoutResponse(p domains.Payout, precision int) PayoutResponse {
+	return PayoutResponse{
+		Payout: p,
+		Amount: FormatAmount(p.Amount, precision),
+	}
+}
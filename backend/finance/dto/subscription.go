@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type CreateSubscriptionTierRequestDTO struct {
+	Name         string  `json:"name" validate:"required,max=50"`
+	Description  *string `json:"description" validate:"omitempty,max=500"`
+	Price        string  `json:"price" validate:"required"` // decimal amount of CurrencyCode charged every month
+	CurrencyCode string  `json:"currencyCode" validate:"required"`
+}
+
+// UpdateSubscriptionTierRequestDTO changes the tier, a new price is charged from the next renewal
+type UpdateSubscriptionTierRequestDTO struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=50"`
+	Description *string `json:"description" validate:"omitempty,max=500"`
+	Price       *string `json:"price"`
+}
+
+type SubscribeRequestDTO struct {
+	TierId uuid.UUID `json:"tierId" validate:"required"`
+}
+
+type GetSubscriberBadgesRequestDTO struct {
+	ChannelId uuid.UUID   `json:"channelId" validate:"required"`
+	UserIds   []uuid.UUID `json:"userIds" validate:"max=1000"`
+}
+
+type SubscriptionTierResponse struct {
+	domains.SubscriptionTier
+	Price string `json:"price"`
+}
+
+type SubscriptionResponse struct {
+	domains.ChannelSubscription
+	Tier *SubscriptionTierResponse `json:"tier"`
+}
+
+func NewSubscriptionTierResponse(t domains.SubscriptionTier, precision int) SubscriptionTierResponse {
+	return SubscriptionTierResponse{
+		SubscriptionTier: t,
+		Price:            FormatAmount(t.Price, precision),
+	}
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SendTipRequestDTO struct {
+	RecipientId  uuid.UUID `json:"recipientId" validate:"required"`
+	Amount       string    `json:"amount" validate:"required"` // decimal amount of CurrencyCode, the platform fee is taken from it
+	CurrencyCode string    `json:"currencyCode" validate:"required"`
+	Message      *string   `json:"message" validate:"omitempty,max=200"`
+}
+
+type TipResponse struct {
+	domains.Tip
+	Amount        string `json:"amount"`
+	CreatorAmount string `json:"creatorAmount"`
+	FeeAmount     string `json:"feeAmount"`
+}
+
+func NewTipResponse(t domains.Tip, precision int) TipResponse {
+	return TipResponse{
+		Tip:           t,
+		Amount:        FormatAmount(t.Amount, precision),
+		CreatorAmount: FormatAmount(t.CreatorAmount, precision),
+		FeeAmount:     FormatAmount(t.FeeAmount, precision),
+	}
+}
@@ -0,0 +1,75 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sen1or/letslive/finance/domains"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	"strings"
+)
+
+const (
+	maxDestinationLength = 64
+
+	// DeclinedDestination is a destination the mock declines transfers to, to exercise the reversal flow
+	DeclinedDestination = "mock_declined"
+)
+
+// MockGateway accepts every transfer except the ones to DeclinedDestination and
+// settles them from unsigned payloads of the form
+// {"type":"completed|failed","providerRef":"...","reason":"..."}. Used for
+// local development before a real payout provider is wired.
+type MockGateway struct{}
+
+func NewMockGateway() *MockGateway {
+	return &MockGateway{}
+}
+
+func (g *MockGateway) Provider() domains.PaymentProvider {
+	return domains.PaymentProvider("mock")
+}
+
+func (g *MockGateway) ValidateDestination(destination string) error {
+	if len(destination) == 0 || len(destination) > maxDestinationLength || strings.ContainsAny(destination, " \t\n") {
+		return errors.New("mock payout: invalid destination")
+	}
+	return nil
+}
+
+func (g *MockGateway) CreateTransfer(ctx context.Context, idempotencyKey string, amount int64, currencyCode string, destination string) (*gatewaypayout.Transfer, error) {
+	providerRef := "mock_payout_" + idempotencyKey
+	if destination == DeclinedDestination {
+		return &gatewaypayout.Transfer{
+			ProviderRef:   providerRef,
+			Status:        gatewaypayout.TransferStatusFailed,
+			FailureReason: fmt.Sprintf("mock payout: %d %s declined by the destination", amount, currencyCode),
+		}, nil
+	}
+	return &gatewaypayout.Transfer{
+		ProviderRef: providerRef,
+		Status:      gatewaypayout.TransferStatusProcessing,
+	}, nil
+}
+
+func (g *MockGateway) VerifyWebhook(payload []byte, header http.Header) (*gatewaypayout.WebhookEvent, error) {
+	_ = header
+	var body struct {
+		Type        string `json:"type"`
+		ProviderRef string `json:"providerRef"`
+		Reason      string `json:"reason"`
+	}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("mock payout webhook: invalid payload: %w", err)
+	}
+
+	switch body.Type {
+	case string(gatewaypayout.WebhookEventCompleted):
+		return &gatewaypayout.WebhookEvent{Type: gatewaypayout.WebhookEventCompleted, ProviderRef: body.ProviderRef}, nil
+	case string(gatewaypayout.WebhookEventFailed):
+		return &gatewaypayout.WebhookEvent{Type: gatewaypayout.WebhookEventFailed, ProviderRef: body.ProviderRef, FailureReason: body.Reason}, nil
+	}
+	return nil, errors.New("mock payout webhook: unknown event type")
+}
@@ -0,0 +1,76 @@
+package mock
+
+import (
+	"context"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	"testing"
+)
+
+func TestMockValidateDestination(t *testing.T) {
+	g := NewMockGateway()
+	if err := g.ValidateDestination("acct_123"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, destination := range []string{"", "has space", string(make([]byte, maxDestinationLength+1))} {
+		if err := g.ValidateDestination(destination); err == nil {
+			t.Errorf("expected error for destination %q", destination)
+		}
+	}
+}
+
+func TestMockCreateTransfer(t *testing.T) {
+	g := NewMockGateway()
+	transfer, err := g.CreateTransfer(context.Background(), "key-123", 5000, "SPARK", "acct_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transfer.ProviderRef != "mock_payout_key-123" {
+		t.Errorf("provider ref = %q, want 'mock_payout_key-123'", transfer.ProviderRef)
+	}
+	if transfer.Status != gatewaypayout.TransferStatusProcessing {
+		t.Errorf("status = %q, want processing", transfer.Status)
+	}
+}
+
+func TestMockCreateTransferDeclined(t *testing.T) {
+	g := NewMockGateway()
+	transfer, err := g.CreateTransfer(context.Background(), "key-123", 5000, "SPARK", DeclinedDestination)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transfer.Status != gatewaypayout.TransferStatusFailed {
+		t.Errorf("status = %q, want failed", transfer.Status)
+	}
+	if transfer.FailureReason == "" {
+		t.Errorf("expected a failure reason")
+	}
+}
+
+func TestMockVerifyWebhook(t *testing.T) {
+	g := NewMockGateway()
+	event, err := g.VerifyWebhook([]byte(`{"type":"completed","providerRef":"mock_payout_abc"}`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Type != gatewaypayout.WebhookEventCompleted || event.ProviderRef != "mock_payout_abc" {
+		t.Errorf("event = %+v, want completed for 'mock_payout_abc'", event)
+	}
+
+	event, err = g.VerifyWebhook([]byte(`{"type":"failed","providerRef":"mock_payout_abc","reason":"closed account"}`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Type != gatewaypayout.WebhookEventFailed || event.FailureReason != "closed account" {
+		t.Errorf("event = %+v, want failed with reason 'closed account'", event)
+	}
+}
+
+func TestMockVerifyWebhookRejects(t *testing.T) {
+	g := NewMockGateway()
+	if _, err := g.VerifyWebhook([]byte(`not json`), nil); err == nil {
+		t.Errorf("expected error for invalid JSON")
+	}
+	if _, err := g.VerifyWebhook([]byte(`{"type":"unknown"}`), nil); err == nil {
+		t.Errorf("expected error for unknown event type")
+	}
+}
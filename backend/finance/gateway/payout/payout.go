@@ -0,0 +1,50 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/finance/domains"
+)
+
+// TransferStatus abstracts the provider-specific state of a transfer.
+type TransferStatus string
+
+const (
+	// TransferStatusProcessing is a transfer the provider accepted, its outcome comes with a webhook
+	TransferStatusProcessing TransferStatus = "processing"
+	TransferStatusCompleted  TransferStatus = "completed"
+	TransferStatusFailed     TransferStatus = "failed"
+)
+
+// Transfer is the payout-gateway-agnostic result of sending a payout.
+type Transfer struct {
+	ProviderRef   string
+	Status        TransferStatus
+	FailureReason string
+}
+
+// WebhookEventType abstracts the provider-specific event names.
+type WebhookEventType string
+
+const (
+	WebhookEventCompleted WebhookEventType = "completed"
+	WebhookEventFailed    WebhookEventType = "failed"
+	WebhookEventIgnored   WebhookEventType = "ignored"
+)
+
+type WebhookEvent struct {
+	Type          WebhookEventType
+	ProviderRef   string
+	FailureReason string
+}
+
+// PayoutGateway abstracts the provider money is withdrawn to (Stripe Connect, PayPal Payouts, mock).
+type PayoutGateway interface {
+	Provider() domains.PaymentProvider
+	// ValidateDestination checks the format of the creator's account at the provider
+	ValidateDestination(destination string) error
+	// CreateTransfer sends the amount to the destination, the provider must not send twice for the same idempotencyKey
+	CreateTransfer(ctx context.Context, idempotencyKey string, amount int64, currencyCode string, destination string) (*Transfer, error)
+	// VerifyWebhook checks the signature the provider put in the headers of its webhook
+	VerifyWebhook(payload []byte, header http.Header) (*WebhookEvent, error)
+}
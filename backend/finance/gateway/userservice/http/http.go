@@ -135,3 +135,92 @@ func (g *userServiceHTTPGateway) CreateGift(ctx context.Context, senderID, recip
 
 	return result.Data.GiftId, nil
 }
+
+type getUserPublicInfoResponse struct {
+	Data *userservice.UserPublicInfo `json:"data"`
+}
+
+func (g *userServiceHTTPGateway) GetUserPublicInfo(ctx context.Context, userID string) (*userservice.UserPublicInfo, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get user service address: %v", err)
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	url := fmt.Sprintf("http://%s/v1/user/%s", addr, userID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create GetUserPublicInfo request: %v", err)
+		return nil, fmt.Errorf("failed to create request")
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call user service GetUserPublicInfo: %v", err)
+		return nil, fmt.Errorf("failed to call user service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("user service returned status %d on GetUserPublicInfo", resp.StatusCode)
+	}
+
+	var result getUserPublicInfoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || result.Data == nil {
+		logger.Errorf(ctx, "failed to decode GetUserPublicInfo response: %v", err)
+		return nil, fmt.Errorf("failed to decode user service response")
+	}
+
+	return result.Data, nil
+}
+
+type isBlockedResponse struct {
+	Data *struct {
+		Blocked bool `json:"blocked"`
+	} `json:"data"`
+}
+
+func (g *userServiceHTTPGateway) IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get user service address: %v", err)
+		return false, fmt.Errorf("user service unavailable")
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/users/%s/blocks/%s", addr, blockerID, blockedID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create IsBlocked request: %v", err)
+		return false, fmt.Errorf("failed to create request")
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call user service IsBlocked: %v", err)
+		return false, fmt.Errorf("failed to call user service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return false, fmt.Errorf("user service returned status %d on IsBlocked", resp.StatusCode)
+	}
+
+	var result isBlockedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || result.Data == nil {
+		logger.Errorf(ctx, "failed to decode IsBlocked response: %v", err)
+		return false, fmt.Errorf("failed to decode user service response")
+	}
+
+	return result.Data.Blocked, nil
+}
@@ -2,7 +2,16 @@ package userservice
 
 import "context"
 
+type UserPublicInfo struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+}
+
 type UserServiceGateway interface {
 	AddInventory(ctx context.Context, userID, shopItemID string, quantity int64) error
 	CreateGift(ctx context.Context, senderID, recipientID, shopItemID string, quantity int64, message *string) (giftID string, err error)
+	// GetUserPublicInfo returns nil without an error when the user does not exist
+	GetUserPublicInfo(ctx context.Context, userID string) (*UserPublicInfo, error)
+	// IsBlocked is true when the blocker blocked the other user
+	IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error)
 }
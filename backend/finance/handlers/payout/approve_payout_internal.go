@@ -0,0 +1,47 @@
+package payouthandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	financeutils "sen1or/letslive/finance/utils"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *PayoutHandler) ApprovePayoutInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	payoutId, err := uuid.FromString(r.PathValue("payoutId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	var req dto.ReviewPayoutRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	if err := financeutils.Validator.Struct(req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseWithValidationErrors[any](nil, nil, err))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "approve_payout_internal_handler.payout_service.approve")
+	payout, serviceErr := h.payoutService.Approve(ctx, payoutId, req.ReviewerId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, payout, nil, nil))
+}
@@ -0,0 +1,40 @@
+package payouthandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *PayoutHandler) CancelPayoutPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	payoutId, err := uuid.FromString(r.PathValue("payoutId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "cancel_payout_private_handler.payout_service.cancel")
+	payout, serviceErr := h.payoutService.Cancel(ctx, *userId, payoutId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, payout, nil, nil))
+}
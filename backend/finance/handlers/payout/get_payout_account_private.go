@@ -0,0 +1,33 @@
+package payouthandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+// GetPayoutAccountPrivateHandler returns null data when the user has not set up a payout account
+func (h *PayoutHandler) GetPayoutAccountPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_payout_account_private_handler.payout_service.get_account")
+	account, serviceErr := h.payoutService.GetAccount(ctx, *userId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, account, nil, nil))
+}
@@ -0,0 +1,50 @@
+package payouthandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+// GetPayoutsInternalHandler lists the payouts in the status query, the pending ones waiting for a review
+// by default
+func (h *PayoutHandler) GetPayoutsInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	status := domains.PayoutStatus(r.URL.Query().Get("status"))
+	if status == "" {
+		status = domains.PayoutStatusPending
+	}
+	switch status {
+	case domains.PayoutStatusPending, domains.PayoutStatusApproved, domains.PayoutStatusProcessing,
+		domains.PayoutStatusCompleted, domains.PayoutStatusFailed, domains.PayoutStatusRejected,
+		domains.PayoutStatusCancelled:
+	default:
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	page, limit := utils.GetPageAndLimitQuery(r)
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_payouts_internal_handler.payout_service.list_by_status")
+	payouts, total, serviceErr := h.payoutService.ListByStatus(ctx, status, page, limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	meta := &response.Meta{
+		Page:     page,
+		PageSize: limit,
+		Total:    total,
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &payouts, meta, nil))
+}
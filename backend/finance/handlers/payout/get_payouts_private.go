@@ -0,0 +1,40 @@
+package payouthandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *PayoutHandler) GetPayoutsPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	page, limit := utils.GetPageAndLimitQuery(r)
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_payouts_private_handler.payout_service.list_for_owner")
+	payouts, total, serviceErr := h.payoutService.ListForOwner(ctx, *userId, page, limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	meta := &response.Meta{
+		Page:     page,
+		PageSize: limit,
+		Total:    total,
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &payouts, meta, nil))
+}
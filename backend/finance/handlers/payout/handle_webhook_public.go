@@ -0,0 +1,35 @@
+package payouthandler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sen1or/letslive/finance/domains"
+	response "sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+// HandleWebhookPublicHandler accepts the transfer updates of the payout provider in the path. The handler
+// has no JWT requirement; signature verification happens inside the service via the provider's gateway.
+func (h *PayoutHandler) HandleWebhookPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+	provider := domains.PaymentProvider(r.PathValue("provider"))
+
+	ctx, span := tracer.MyTracer.Start(ctx, "handle_webhook_public_handler.payout_service.handle_webhook")
+	serviceErr := h.payoutService.HandleWebhook(ctx, provider, payload, r.Header)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_SUCC_OK, nil, nil, nil))
+}
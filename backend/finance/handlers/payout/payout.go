@@ -0,0 +1,15 @@
+package payouthandler
+
+import (
+	"sen1or/letslive/finance/handlers/basehandler"
+	payoutservice "sen1or/letslive/finance/services/payout"
+)
+
+type PayoutHandler struct {
+	basehandler.BaseHandler
+	payoutService *payoutservice.PayoutService
+}
+
+func NewPayoutHandler(payoutService *payoutservice.PayoutService) *PayoutHandler {
+	return &PayoutHandler{payoutService: payoutService}
+}
@@ -0,0 +1,46 @@
+package payouthandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	financeutils "sen1or/letslive/finance/utils"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *PayoutHandler) RequestPayoutPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	var req dto.RequestPayoutRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	if err := financeutils.Validator.Struct(req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseWithValidationErrors[any](nil, nil, err))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "request_payout_private_handler.payout_service.request_payout")
+	payout, serviceErr := h.payoutService.RequestPayout(ctx, *userId, req)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, payout, nil, nil))
+}
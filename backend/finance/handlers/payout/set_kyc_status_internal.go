@@ -0,0 +1,47 @@
+package payouthandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	financeutils "sen1or/letslive/finance/utils"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *PayoutHandler) SetKYCStatusInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, err := uuid.FromString(r.PathValue("userId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	var req dto.SetKYCStatusRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	if err := financeutils.Validator.Struct(req); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseWithValidationErrors[any](nil, nil, err))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "set_kyc_status_internal_handler.payout_service.set_kyc_status")
+	account, serviceErr := h.payoutService.SetKYCStatus(ctx, userId, req)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, account, nil, nil))
+}
@@ -0,0 +1,40 @@
+package subscriptionhandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *SubscriptionHandler) CancelSubscriptionPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	subscriberId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	subscriptionId, err := uuid.FromString(r.PathValue("subscriptionId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "cancel_subscription_private_handler.subscription_service.cancel")
+	subscription, serviceErr := h.subscriptionService.Cancel(ctx, *subscriberId, subscriptionId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, subscription, nil, nil))
+}
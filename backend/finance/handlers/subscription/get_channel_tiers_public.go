@@ -0,0 +1,33 @@
+package subscriptionhandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *SubscriptionHandler) GetChannelTiersPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	channelId, err := uuid.FromString(r.PathValue("channelId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_channel_tiers_public_handler.subscription_service.list_channel_tiers")
+	tiers, serviceErr := h.subscriptionService.ListChannelTiers(ctx, channelId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &tiers, nil, nil))
+}
@@ -0,0 +1,40 @@
+package subscriptionhandler
+
+import (
+	"context"
+	"net/http"
+
+	"sen1or/letslive/finance/handlers/utils"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *SubscriptionHandler) GetSubscribersPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	channelId, errResp := utils.GetUserIdFromCookie(r)
+	if errResp != nil {
+		h.WriteResponse(w, ctx, errResp)
+		return
+	}
+
+	page, limit := utils.GetPageAndLimitQuery(r)
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_subscribers_private_handler.subscription_service.list_subscribers")
+	subscriptions, total, serviceErr := h.subscriptionService.ListSubscribers(ctx, *channelId, page, limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	meta := &response.Meta{
+		Page:     page,
+		PageSize: limit,
+		Total:    total,
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &subscriptions, meta, nil))
+}
@@ -0,0 +1,15 @@
+package subscriptionhandler
+
+import (
+	"sen1or/letslive/finance/handlers/basehandler"
+	subscriptionservice "sen1or/letslive/finance/services/subscription"
+)
+
+type SubscriptionHandler struct {
+	basehandler.BaseHandler
+	subscriptionService *subscriptionservice.SubscriptionService
+}
+
+func NewSubscriptionHandler(subscriptionService *subscriptionservice.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{subscriptionService: subscriptionService}
+}
@@ -0,0 +1,15 @@
+package tiphandler
+
+import (
+	"sen1or/letslive/finance/handlers/basehandler"
+	tipservice "sen1or/letslive/finance/services/tip"
+)
+
+type TipHandler struct {
+	basehandler.BaseHandler
+	tipService *tipservice.TipService
+}
+
+func NewTipHandler(tipService *tipservice.TipService) *TipHandler {
+	return &TipHandler{tipService: tipService}
+}
@@ -0,0 +1,69 @@
+-- +goose Up
+
+ALTER TYPE transactions_type_enum ADD VALUE IF NOT EXISTS 'subscription';
+
+-- The platform's share of subscription charges is credited to this fee account.
+INSERT INTO "accounts" ("id", "type", "owner_id", "status")
+VALUES ('00000000-0000-0000-0000-000000000002', 'fee', NULL, 'active')
+ON CONFLICT ("id") DO NOTHING;
+
+-- Monthly tiers a creator offers on their channel. price is in minor units of
+-- currency_code; changing it applies from the next renewal. A deactivated tier
+-- takes no new subscribers and its subscriptions end with their current period.
+CREATE TABLE "subscription_tiers" (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "channel_id" UUID NOT NULL, -- the creator's user id, got from user service
+  "name" TEXT NOT NULL,
+  "description" TEXT NULL,
+  "price" BIGINT NOT NULL CHECK (price > 0),
+  "currency_code" TEXT NOT NULL REFERENCES currencies(code),
+  "is_active" BOOLEAN NOT NULL DEFAULT TRUE,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX "idx_subscription_tiers_channel_id" ON subscription_tiers(channel_id);
+
+CREATE TYPE subscription_status_enum AS ENUM ('pending', 'active', 'past_due', 'cancelled', 'expired');
+
+-- months counts the paid periods. A renewal that fails moves the subscription to
+-- past_due until grace_until, retrying at next_attempt_at; the renewal job also
+-- claims rows by pushing next_attempt_at forward. Every period ends on the day of
+-- the month of billing_anchor, or on the last day of a shorter month.
+CREATE TABLE "channel_subscriptions" (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "subscriber_id" UUID NOT NULL,
+  "channel_id" UUID NOT NULL,
+  "tier_id" UUID NOT NULL REFERENCES subscription_tiers(id),
+  "status" subscription_status_enum NOT NULL,
+  "months" INTEGER NOT NULL DEFAULT 0,
+  "billing_anchor" TIMESTAMPTZ NOT NULL,
+  "current_period_start" TIMESTAMPTZ NOT NULL,
+  "current_period_end" TIMESTAMPTZ NOT NULL,
+  "cancel_at_period_end" BOOLEAN NOT NULL DEFAULT FALSE,
+  "failed_attempts" INTEGER NOT NULL DEFAULT 0,
+  "grace_until" TIMESTAMPTZ NULL,
+  "next_attempt_at" TIMESTAMPTZ NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+-- One running subscription per subscriber and channel.
+CREATE UNIQUE INDEX "uq_channel_subscriptions_running"
+  ON channel_subscriptions(subscriber_id, channel_id)
+  WHERE status IN ('pending', 'active', 'past_due');
+
+CREATE INDEX "idx_channel_subscriptions_channel_id" ON channel_subscriptions(channel_id, status);
+CREATE INDEX "idx_channel_subscriptions_next_attempt_at"
+  ON channel_subscriptions(next_attempt_at)
+  WHERE status IN ('pending', 'active', 'past_due');
+
+-- +goose Down
+
+DROP TABLE IF EXISTS channel_subscriptions;
+DROP TYPE IF EXISTS subscription_status_enum;
+DROP TABLE IF EXISTS subscription_tiers;
+
+DELETE FROM "accounts" WHERE "id" = '00000000-0000-0000-0000-000000000002';
+
+-- Note: Postgres cannot remove an enum value; 'subscription' stays on down-migration.
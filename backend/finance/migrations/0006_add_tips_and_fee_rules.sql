@@ -0,0 +1,62 @@
+-- +goose Up
+
+CREATE TYPE fee_rules_rounding_mode_enum AS ENUM('up', 'down', 'half_up', 'half_even');
+
+-- Versioned platform fees per transaction type and currency. A rule is never
+-- changed: a new fee is a new version, in force from its effective_from. The
+-- rule in force is the highest version whose effective_from has passed, the
+-- transactions record the rule they were charged with.
+CREATE TABLE "fee_rules" (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "transaction_type" transactions_type_enum NOT NULL,
+  "currency_code" TEXT NOT NULL REFERENCES currencies(code),
+  "version" INTEGER NOT NULL CHECK (version > 0),
+  "basis_points" INTEGER NOT NULL CHECK (basis_points BETWEEN 0 AND 10000), -- 100 --> 1%
+  "min_fee" BIGINT NOT NULL DEFAULT 0 CHECK (min_fee >= 0), -- minor units of currency_code
+  "rounding_mode" fee_rules_rounding_mode_enum NOT NULL,
+  "effective_from" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  UNIQUE ("transaction_type", "currency_code", "version")
+);
+
+CREATE TRIGGER fee_rules_trigger_no_update
+BEFORE UPDATE ON fee_rules
+FOR EACH STATEMENT EXECUTE PROCEDURE block_update();
+
+CREATE TRIGGER fee_rules_trigger_no_delete
+BEFORE DELETE ON fee_rules
+FOR EACH STATEMENT EXECUTE PROCEDURE block_delete();
+
+INSERT INTO "fee_rules" ("transaction_type", "currency_code", "version", "basis_points", "min_fee", "rounding_mode")
+VALUES
+  ('donate', 'SPARK', 1, 500, 0, 'half_up'),
+  ('donate', 'FLARE', 1, 500, 0, 'half_up'),
+  -- subscription fees are rounded down, in favour of the creator
+  ('subscription', 'SPARK', 1, 500, 0, 'down'),
+  ('subscription', 'FLARE', 1, 500, 0, 'down');
+
+-- A tip is recorded before its donate transaction is completed and only counts
+-- once that transaction is; amount = creator_amount + fee_amount.
+CREATE TABLE "tips" (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "transaction_id" UUID NOT NULL UNIQUE REFERENCES transactions(id),
+  "sender_id" UUID NOT NULL,
+  "recipient_id" UUID NOT NULL,
+  "currency_code" TEXT NOT NULL REFERENCES currencies(code),
+  "amount" BIGINT NOT NULL CHECK (amount > 0),
+  "creator_amount" BIGINT NOT NULL CHECK (creator_amount > 0),
+  "fee_amount" BIGINT NOT NULL CHECK (fee_amount >= 0),
+  "fee_rule_id" UUID NULL REFERENCES fee_rules(id),
+  "message" TEXT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  CHECK (amount = creator_amount + fee_amount)
+);
+
+CREATE INDEX "idx_tips_recipient_id" ON tips(recipient_id, created_at DESC);
+CREATE INDEX "idx_tips_sender_id" ON tips(sender_id, created_at DESC);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS tips;
+DROP TABLE IF EXISTS fee_rules;
+DROP TYPE IF EXISTS fee_rules_rounding_mode_enum;
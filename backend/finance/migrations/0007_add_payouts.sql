@@ -0,0 +1,65 @@
+-- +goose Up
+
+ALTER TYPE transactions_type_enum ADD VALUE IF NOT EXISTS 'payout';
+
+-- Placeholder until a KYC provider is integrated: an account is pending once
+-- the creator submits it, and is verified or rejected through an internal route.
+CREATE TYPE payout_kyc_status_enum AS ENUM ('pending', 'verified', 'rejected');
+
+-- Where a creator's payouts are sent. destination is the creator's account
+-- handle at the provider; in-flight payouts keep the destination they were
+-- requested with.
+CREATE TABLE "payout_accounts" (
+  "owner_id" UUID PRIMARY KEY,
+  "provider" TEXT NOT NULL,
+  "destination" TEXT NOT NULL,
+  "kyc_status" payout_kyc_status_enum NOT NULL DEFAULT 'pending',
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TYPE payout_status_enum AS ENUM ('pending', 'approved', 'processing', 'completed', 'failed', 'rejected', 'cancelled');
+
+-- amount is in minor units of currency_code. It is moved from the creator's
+-- wallet to the escrow account by hold_transaction_id when the payout is
+-- requested; the escrow account mirrors the money outside the platform, so a
+-- completed payout needs no further entry. A failed, rejected or cancelled
+-- payout is moved back to the wallet by reversal_transaction_id.
+CREATE TABLE "payouts" (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "owner_id" UUID NOT NULL,
+  "currency_code" TEXT NOT NULL REFERENCES currencies(code),
+  "amount" BIGINT NOT NULL CHECK (amount > 0),
+  "status" payout_status_enum NOT NULL,
+  "provider" TEXT NOT NULL,
+  "destination" TEXT NOT NULL,
+  "provider_ref" TEXT NULL,
+  "hold_transaction_id" UUID NOT NULL UNIQUE REFERENCES transactions(id),
+  "reversal_transaction_id" UUID NULL UNIQUE REFERENCES transactions(id),
+  "failure_reason" TEXT NULL,
+  "reviewed_by" UUID NULL,
+  "reviewed_at" TIMESTAMPTZ NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+-- One payout in flight per creator.
+CREATE UNIQUE INDEX "uq_payouts_in_flight"
+  ON payouts(owner_id)
+  WHERE status IN ('pending', 'approved', 'processing');
+
+CREATE UNIQUE INDEX "uq_payouts_provider_ref"
+  ON payouts(provider, provider_ref)
+  WHERE provider_ref IS NOT NULL;
+
+CREATE INDEX "idx_payouts_owner_id" ON payouts(owner_id, created_at DESC);
+CREATE INDEX "idx_payouts_status" ON payouts(status, created_at);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS payouts;
+DROP TYPE IF EXISTS payout_status_enum;
+DROP TABLE IF EXISTS payout_accounts;
+DROP TYPE IF EXISTS payout_kyc_status_enum;
+
+-- Note: Postgres cannot remove an enum value; 'payout' stays on down-migration.
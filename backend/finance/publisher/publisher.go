@@ -0,0 +1,56 @@
+package publisher
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/logger"
+)
+
+const eventSource = "finance"
+
+// EventPublisher publishes the events of the finance service, a failed publish is only logged
+// since the ledger is the source of truth and the events only feed notifications and alerts
+type EventPublisher struct {
+	producer eventbus.Producer
+}
+
+// NewEventPublisher returns a publisher dropping every event when producer is nil,
+// which is the case when no event bus is configured
+func NewEventPublisher(producer eventbus.Producer) *EventPublisher {
+	return &EventPublisher{
+		producer: producer,
+	}
+}
+
+var _ domains.TipNotifier = (*EventPublisher)(nil)
+
+func (p *EventPublisher) NotifyTipSent(ctx context.Context, tip domains.Tip, precision int) {
+	p.publish(ctx, events.TopicFinance, tip.RecipientId.String(), events.DonationSent, events.DonationSentEvent{
+		TipId:         tip.Id,
+		TransactionId: tip.TransactionId,
+		SenderId:      tip.SenderId,
+		ReceiverId:    tip.RecipientId,
+		Amount:        dto.FormatAmount(tip.Amount, precision),
+		CurrencyCode:  tip.CurrencyCode,
+		Message:       tip.Message,
+	})
+}
+
+func (p *EventPublisher) publish(ctx context.Context, topic string, key string, eventType string, data any) {
+	if p.producer == nil {
+		return
+	}
+
+	event, err := eventbus.NewEvent(eventType, eventSource, data)
+	if err != nil {
+		logger.Errorf(ctx, "failed to build event %s: %v", eventType, err)
+		return
+	}
+
+	if err := p.producer.Publish(ctx, topic, key, event); err != nil {
+		logger.Warnf(ctx, "failed to publish event %s: %v", eventType, err)
+	}
+}
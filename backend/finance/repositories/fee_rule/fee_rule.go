@@ -0,0 +1,17 @@
+package feerule
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresFeeRuleRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewFeeRuleRepository(conn *pgxpool.Pool) domains.FeeRuleRepository {
+	return &postgresFeeRuleRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,45 @@
+package feerule
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresFeeRuleRepo) GetById(ctx context.Context, id uuid.UUID) (*domains.FeeRule, *response.Response[any]) {
+	query := `
+        select id, transaction_type, currency_code, version, basis_points, min_fee, rounding_mode, effective_from, created_at
+        from fee_rules
+        where id = $1
+    `
+	rows, err := r.dbConn.Query(ctx, query, id)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getfeerulebyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	rule, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.FeeRule])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [getfeerulebyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &rule, nil
+}
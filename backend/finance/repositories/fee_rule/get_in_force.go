@@ -0,0 +1,46 @@
+package feerule
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresFeeRuleRepo) GetInForce(ctx context.Context, transactionType domains.TransactionType, currencyCode string) (*domains.FeeRule, *response.Response[any]) {
+	query := `
+        select id, transaction_type, currency_code, version, basis_points, min_fee, rounding_mode, effective_from, created_at
+        from fee_rules
+        where transaction_type = $1 and currency_code = $2 and effective_from <= now()
+        order by version desc
+        limit 1
+    `
+	rows, err := r.dbConn.Query(ctx, query, transactionType, currencyCode)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getfeeruleinforce: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	rule, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.FeeRule])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [getfeeruleinforce: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &rule, nil
+}
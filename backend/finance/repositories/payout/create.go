@@ -0,0 +1,52 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r postgresPayoutRepo) Create(ctx context.Context, p domains.Payout) (*domains.Payout, *response.Response[any]) {
+	query := `
+        insert into payouts (id, owner_id, currency_code, amount, status, provider, destination, hold_transaction_id)
+        values ($1, $2, $3, $4, $5, $6, $7, $8)
+        returning ` + payoutColumns
+	rows, err := r.dbConn.Query(ctx, query, p.Id, p.OwnerId, p.CurrencyCode, p.Amount, p.Status, p.Provider,
+		p.Destination, p.HoldTransactionId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Payout])
+	if err != nil {
+		// uq_payouts_in_flight: the owner already has a payout pending, approved or processing
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_PAYOUT_IN_PROGRESS,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [createpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &created, nil
+}
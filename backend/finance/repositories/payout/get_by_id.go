@@ -0,0 +1,50 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutRepo) GetById(ctx context.Context, id uuid.UUID) (*domains.Payout, *response.Response[any]) {
+	query := `
+        select ` + payoutColumns + `
+        from payouts
+        where id = $1
+    `
+	rows, err := r.dbConn.Query(ctx, query, id)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getpayoutbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	payout, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Payout])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_PAYOUT_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getpayoutbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &payout, nil
+}
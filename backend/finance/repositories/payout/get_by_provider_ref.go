@@ -0,0 +1,49 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutRepo) GetByProviderRef(ctx context.Context, provider domains.PaymentProvider, providerRef string) (*domains.Payout, *response.Response[any]) {
+	query := `
+        select ` + payoutColumns + `
+        from payouts
+        where provider = $1 and provider_ref = $2
+    `
+	rows, err := r.dbConn.Query(ctx, query, provider, providerRef)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getpayoutbyproviderref: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	payout, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Payout])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_PAYOUT_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getpayoutbyproviderref: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &payout, nil
+}
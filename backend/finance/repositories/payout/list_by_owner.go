@@ -0,0 +1,64 @@
+package payout
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutRepo) ListByOwner(ctx context.Context, ownerId uuid.UUID, page int, limit int) ([]domains.Payout, int, *response.Response[any]) {
+	countQuery := `
+        select count(*)
+        from payouts p
+        join transactions tx on tx.id = p.hold_transaction_id
+        where p.owner_id = $1 and tx.status = 'completed'
+    `
+	var total int
+	if err := r.dbConn.QueryRow(ctx, countQuery, ownerId).Scan(&total); err != nil {
+		logger.Errorf(ctx, "db count error [listpayoutsbyowner: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	query := `
+        select p.id, p.owner_id, p.currency_code, p.amount, p.status, p.provider, p.destination, p.provider_ref,
+            p.hold_transaction_id, p.reversal_transaction_id, p.failure_reason, p.reviewed_by, p.reviewed_at,
+            p.created_at, p.updated_at
+        from payouts p
+        join transactions tx on tx.id = p.hold_transaction_id
+        where p.owner_id = $1 and tx.status = 'completed'
+        order by p.created_at desc
+        limit $2 offset $3
+    `
+	offset := page * limit
+	rows, err := r.dbConn.Query(ctx, query, ownerId, limit, offset)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [listpayoutsbyowner: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	payouts, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.Payout])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [listpayoutsbyowner: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return payouts, total, nil
+}
@@ -0,0 +1,20 @@
+package payout
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const payoutColumns = `id, owner_id, currency_code, amount, status, provider, destination, provider_ref, hold_transaction_id,
+    reversal_transaction_id, failure_reason, reviewed_by, reviewed_at, created_at, updated_at`
+
+type postgresPayoutRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewPayoutRepository(conn *pgxpool.Pool) domains.PayoutRepository {
+	return &postgresPayoutRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,36 @@
+package payout
+
+import (
+	"context"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r postgresPayoutRepo) SetReversal(ctx context.Context, id uuid.UUID, transactionId uuid.UUID) *response.Response[any] {
+	query := `
+        update payouts
+        set reversal_transaction_id = $2, updated_at = current_timestamp
+        where id = $1
+    `
+	cmd, err := r.dbConn.Exec(ctx, query, id, transactionId)
+	if err != nil {
+		logger.Errorf(ctx, "db update error [setpayoutreversal: %v]", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if cmd.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_PAYOUT_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
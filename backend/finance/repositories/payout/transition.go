@@ -0,0 +1,55 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutRepo) Transition(ctx context.Context, p domains.Payout, from []domains.PayoutStatus) (*domains.Payout, *response.Response[any]) {
+	query := `
+        update payouts
+        set status = $2, provider_ref = $3, failure_reason = $4, reviewed_by = $5, reviewed_at = $6,
+            updated_at = current_timestamp
+        where id = $1 and status = any($7::payout_status_enum[])
+        returning ` + payoutColumns
+	fromStatuses := make([]string, len(from))
+	for i, status := range from {
+		fromStatuses[i] = string(status)
+	}
+	rows, err := r.dbConn.Query(ctx, query, p.Id, p.Status, p.ProviderRef, p.FailureReason, p.ReviewedBy, p.ReviewedAt, fromStatuses)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [transitionpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	updated, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Payout])
+	if err != nil {
+		// another request moved the payout out of the expected statuses first
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_PAYOUT_INVALID_STATUS,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [transitionpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &updated, nil
+}
@@ -0,0 +1,45 @@
+package payoutaccount
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutAccountRepo) GetByOwner(ctx context.Context, ownerId uuid.UUID) (*domains.PayoutAccount, *response.Response[any]) {
+	query := `
+        select ` + payoutAccountColumns + `
+        from payout_accounts
+        where owner_id = $1
+    `
+	rows, err := r.dbConn.Query(ctx, query, ownerId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getpayoutaccountbyowner: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	account, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.PayoutAccount])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [getpayoutaccountbyowner: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &account, nil
+}
@@ -0,0 +1,19 @@
+package payoutaccount
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const payoutAccountColumns = `owner_id, provider, destination, kyc_status, created_at, updated_at`
+
+type postgresPayoutAccountRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewPayoutAccountRepository(conn *pgxpool.Pool) domains.PayoutAccountRepository {
+	return &postgresPayoutAccountRepo{
+		dbConn: conn,
+	}
+}
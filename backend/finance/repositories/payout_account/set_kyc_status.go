@@ -0,0 +1,50 @@
+package payoutaccount
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutAccountRepo) SetKYCStatus(ctx context.Context, ownerId uuid.UUID, status domains.KYCStatus) (*domains.PayoutAccount, *response.Response[any]) {
+	query := `
+        update payout_accounts
+        set kyc_status = $2, updated_at = current_timestamp
+        where owner_id = $1
+        returning ` + payoutAccountColumns
+	rows, err := r.dbConn.Query(ctx, query, ownerId, status)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [setpayoutkycstatus: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	account, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.PayoutAccount])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_PAYOUT_ACCOUNT_REQUIRED,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [setpayoutkycstatus: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &account, nil
+}
@@ -0,0 +1,44 @@
+package payoutaccount
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresPayoutAccountRepo) Upsert(ctx context.Context, account domains.PayoutAccount) (*domains.PayoutAccount, *response.Response[any]) {
+	query := `
+        insert into payout_accounts (owner_id, provider, destination)
+        values ($1, $2, $3)
+        on conflict (owner_id) do update
+        set provider = excluded.provider,
+            destination = excluded.destination,
+            kyc_status = case when payout_accounts.kyc_status = 'rejected' then 'pending' else payout_accounts.kyc_status end,
+            updated_at = current_timestamp
+        returning ` + payoutAccountColumns
+	rows, err := r.dbConn.Query(ctx, query, account.OwnerId, account.Provider, account.Destination)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [upsertpayoutaccount: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	saved, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.PayoutAccount])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [upsertpayoutaccount: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &saved, nil
+}
@@ -4,8 +4,14 @@ import (
 	"sen1or/letslive/finance/domains"
 	accountrepo "sen1or/letslive/finance/repositories/account"
 	currencyrepo "sen1or/letslive/finance/repositories/currency"
+	feerulerepo "sen1or/letslive/finance/repositories/fee_rule"
 	paymentrepo "sen1or/letslive/finance/repositories/payment"
+	payoutrepo "sen1or/letslive/finance/repositories/payout"
+	payoutaccountrepo "sen1or/letslive/finance/repositories/payout_account"
 	shopitemrepo "sen1or/letslive/finance/repositories/shop_item"
+	subscriptionrepo "sen1or/letslive/finance/repositories/subscription"
+	subscriptiontierrepo "sen1or/letslive/finance/repositories/subscription_tier"
+	tiprepo "sen1or/letslive/finance/repositories/tip"
 	transactionrepo "sen1or/letslive/finance/repositories/transaction"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -30,3 +36,27 @@ func NewPaymentRepository(conn *pgxpool.Pool) domains.PaymentRepository {
 func NewShopItemRepository(conn *pgxpool.Pool) domains.ShopItemRepository {
 	return shopitemrepo.NewShopItemRepository(conn)
 }
+
+func NewSubscriptionTierRepository(conn *pgxpool.Pool) domains.SubscriptionTierRepository {
+	return subscriptiontierrepo.NewSubscriptionTierRepository(conn)
+}
+
+func NewSubscriptionRepository(conn *pgxpool.Pool) domains.SubscriptionRepository {
+	return subscriptionrepo.NewSubscriptionRepository(conn)
+}
+
+func NewFeeRuleRepository(conn *pgxpool.Pool) domains.FeeRuleRepository {
+	return feerulerepo.NewFeeRuleRepository(conn)
+}
+
+func NewTipRepository(conn *pgxpool.Pool) domains.TipRepository {
+	return tiprepo.NewTipRepository(conn)
+}
+
+func NewPayoutAccountRepository(conn *pgxpool.Pool) domains.PayoutAccountRepository {
+	return payoutaccountrepo.NewPayoutAccountRepository(conn)
+}
+
+func NewPayoutRepository(conn *pgxpool.Pool) domains.PayoutRepository {
+	return payoutrepo.NewPayoutRepository(conn)
+}
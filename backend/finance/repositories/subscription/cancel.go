@@ -0,0 +1,49 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionRepo) Cancel(ctx context.Context, id uuid.UUID) (*domains.ChannelSubscription, *response.Response[any]) {
+	// only the cancel columns are written, so a renewal running at the same time keeps its period and lease
+	query := `
+        update channel_subscriptions
+        set cancel_at_period_end = cancel_at_period_end or status = 'active',
+            status = case when status = 'past_due' then 'cancelled'::subscription_status_enum else status end,
+            updated_at = current_timestamp
+        where id = $1 and status in ('active', 'past_due')
+        returning ` + subscriptionColumns
+	rows, err := r.dbConn.Query(ctx, query, id)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [cancelsubscription: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	subscription, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.ChannelSubscription])
+	if err != nil {
+		// pending or already over, there is nothing to cancel
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [cancelsubscription: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &subscription, nil
+}
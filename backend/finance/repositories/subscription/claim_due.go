@@ -0,0 +1,55 @@
+package subscription
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ClaimDue pushes next_attempt_at of the claimed rows past the lease, so a replica that crashes
+// mid-renewal leaves them to be retried once the lease is over
+func (r postgresSubscriptionRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domains.ChannelSubscription, *response.Response[any]) {
+	query := `
+        with due as (
+            select id
+            from channel_subscriptions
+            where status in ('pending', 'active', 'past_due') and next_attempt_at <= current_timestamp
+            order by next_attempt_at
+            limit $1
+            for update skip locked
+        )
+        update channel_subscriptions s
+        set next_attempt_at = current_timestamp + make_interval(secs => $2), updated_at = current_timestamp
+        from due
+        where s.id = due.id
+        returning s.id, s.subscriber_id, s.channel_id, s.tier_id, s.status, s.months, s.billing_anchor, s.current_period_start,
+            s.current_period_end, s.cancel_at_period_end, s.failed_attempts, s.grace_until, s.next_attempt_at,
+            s.created_at, s.updated_at
+    `
+	rows, err := r.dbConn.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [claimduesubscriptions: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	subscriptions, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.ChannelSubscription])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [claimduesubscriptions: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return subscriptions, nil
+}
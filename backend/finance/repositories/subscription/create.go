@@ -0,0 +1,53 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r postgresSubscriptionRepo) Create(ctx context.Context, s domains.ChannelSubscription) (*domains.ChannelSubscription, *response.Response[any]) {
+	query := `
+        insert into channel_subscriptions (subscriber_id, channel_id, tier_id, status, months, billing_anchor,
+            current_period_start, current_period_end, next_attempt_at)
+        values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        returning ` + subscriptionColumns
+	rows, err := r.dbConn.Query(ctx, query, s.SubscriberId, s.ChannelId, s.TierId, s.Status, s.Months, s.BillingAnchor,
+		s.CurrentPeriodStart, s.CurrentPeriodEnd, s.NextAttemptAt)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createsubscription: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.ChannelSubscription])
+	if err != nil {
+		// uq_channel_subscriptions_running: the subscriber already runs a subscription to the channel
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_ALREADY_SUBSCRIBED,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [createsubscription: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &created, nil
+}
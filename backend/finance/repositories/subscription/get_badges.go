@@ -0,0 +1,42 @@
+package subscription
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionRepo) GetBadges(ctx context.Context, channelId uuid.UUID, userIds []uuid.UUID) ([]domains.SubscriberBadge, *response.Response[any]) {
+	query := `
+        select s.subscriber_id, s.tier_id, t.name as tier_name, s.months, s.status
+        from channel_subscriptions s
+        join subscription_tiers t on t.id = s.tier_id
+        where s.channel_id = $1 and s.subscriber_id = any($2::uuid[]) and s.status in ('active', 'past_due')
+    `
+	rows, err := r.dbConn.Query(ctx, query, channelId, userIds)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getsubscriberbadges: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	badges, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.SubscriberBadge])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getsubscriberbadges: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return badges, nil
+}
@@ -0,0 +1,46 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionRepo) GetById(ctx context.Context, id uuid.UUID) (*domains.ChannelSubscription, *response.Response[any]) {
+	query := `select ` + subscriptionColumns + ` from channel_subscriptions where id = $1`
+	rows, err := r.dbConn.Query(ctx, query, id)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getsubscriptionbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	subscription, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.ChannelSubscription])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_SUBSCRIPTION_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getsubscriptionbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &subscription, nil
+}
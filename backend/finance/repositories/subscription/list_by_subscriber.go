@@ -0,0 +1,56 @@
+package subscription
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionRepo) ListBySubscriber(ctx context.Context, subscriberId uuid.UUID, page int, limit int) ([]domains.ChannelSubscription, int, *response.Response[any]) {
+	countQuery := `select count(*) from channel_subscriptions where subscriber_id = $1`
+	var total int
+	if err := r.dbConn.QueryRow(ctx, countQuery, subscriberId).Scan(&total); err != nil {
+		logger.Errorf(ctx, "db count error [listsubscriptionsbysubscriber: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	query := `
+        select ` + subscriptionColumns + `
+        from channel_subscriptions
+        where subscriber_id = $1
+        order by created_at desc
+        limit $2 offset $3
+    `
+	offset := page * limit
+	rows, err := r.dbConn.Query(ctx, query, subscriberId, limit, offset)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [listsubscriptionsbysubscriber: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	subscriptions, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.ChannelSubscription])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [listsubscriptionsbysubscriber: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return subscriptions, total, nil
+}
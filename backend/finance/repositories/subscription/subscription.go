@@ -0,0 +1,20 @@
+package subscription
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const subscriptionColumns = `id, subscriber_id, channel_id, tier_id, status, months, billing_anchor, current_period_start, current_period_end,
+        cancel_at_period_end, failed_attempts, grace_until, next_attempt_at, created_at, updated_at`
+
+type postgresSubscriptionRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewSubscriptionRepository(conn *pgxpool.Pool) domains.SubscriptionRepository {
+	return &postgresSubscriptionRepo{
+		dbConn: conn,
+	}
+}
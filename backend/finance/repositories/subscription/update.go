@@ -0,0 +1,31 @@
+package subscription
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+)
+
+func (r postgresSubscriptionRepo) Update(ctx context.Context, s domains.ChannelSubscription) (bool, *response.Response[any]) {
+	// updated_at is the version of the row, a copy read before a cancel or another save is not written back
+	query := `
+        update channel_subscriptions
+        set tier_id = $2, status = $3, months = $4, current_period_start = $5, current_period_end = $6,
+            cancel_at_period_end = $7, failed_attempts = $8, grace_until = $9, next_attempt_at = $10,
+            updated_at = current_timestamp
+        where id = $1 and updated_at = $11
+    `
+	cmd, err := r.dbConn.Exec(ctx, query, s.Id, s.TierId, s.Status, s.Months, s.CurrentPeriodStart, s.CurrentPeriodEnd,
+		s.CancelAtPeriodEnd, s.FailedAttempts, s.GraceUntil, s.NextAttemptAt, s.UpdatedAt)
+	if err != nil {
+		logger.Errorf(ctx, "db update error [updatesubscription: %v]", err)
+		return false, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return cmd.RowsAffected() > 0, nil
+}
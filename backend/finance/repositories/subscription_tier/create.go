@@ -0,0 +1,39 @@
+package subscriptiontier
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionTierRepo) Create(ctx context.Context, tier domains.SubscriptionTier) (*domains.SubscriptionTier, *response.Response[any]) {
+	query := `
+        insert into subscription_tiers (channel_id, name, description, price, currency_code)
+        values ($1, $2, $3, $4, $5)
+        returning ` + tierColumns
+	rows, err := r.dbConn.Query(ctx, query, tier.ChannelId, tier.Name, tier.Description, tier.Price, tier.CurrencyCode)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createsubscriptiontier: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.SubscriptionTier])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [createsubscriptiontier: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &created, nil
+}
@@ -0,0 +1,46 @@
+package subscriptiontier
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionTierRepo) GetById(ctx context.Context, id uuid.UUID) (*domains.SubscriptionTier, *response.Response[any]) {
+	query := `select ` + tierColumns + ` from subscription_tiers where id = $1`
+	rows, err := r.dbConn.Query(ctx, query, id)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getsubscriptiontierbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	tier, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.SubscriptionTier])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getsubscriptiontierbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &tier, nil
+}
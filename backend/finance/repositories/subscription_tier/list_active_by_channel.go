@@ -0,0 +1,42 @@
+package subscriptiontier
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionTierRepo) ListActiveByChannel(ctx context.Context, channelId uuid.UUID) ([]domains.SubscriptionTier, *response.Response[any]) {
+	query := `
+        select ` + tierColumns + `
+        from subscription_tiers
+        where channel_id = $1 and is_active = true
+        order by price asc, created_at asc
+    `
+	rows, err := r.dbConn.Query(ctx, query, channelId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [listactivesubscriptiontiers: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	tiers, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.SubscriptionTier])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [listactivesubscriptiontiers: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return tiers, nil
+}
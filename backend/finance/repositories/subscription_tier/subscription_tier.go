@@ -0,0 +1,19 @@
+package subscriptiontier
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tierColumns = `id, channel_id, name, description, price, currency_code, is_active, created_at, updated_at`
+
+type postgresSubscriptionTierRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewSubscriptionTierRepository(conn *pgxpool.Pool) domains.SubscriptionTierRepository {
+	return &postgresSubscriptionTierRepo{
+		dbConn: conn,
+	}
+}
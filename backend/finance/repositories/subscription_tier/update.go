@@ -0,0 +1,49 @@
+package subscriptiontier
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresSubscriptionTierRepo) Update(ctx context.Context, tier domains.SubscriptionTier) (*domains.SubscriptionTier, *response.Response[any]) {
+	query := `
+        update subscription_tiers
+        set name = $2, description = $3, price = $4, is_active = $5, updated_at = current_timestamp
+        where id = $1
+        returning ` + tierColumns
+	rows, err := r.dbConn.Query(ctx, query, tier.Id, tier.Name, tier.Description, tier.Price, tier.IsActive)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [updatesubscriptiontier: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	updated, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.SubscriptionTier])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [updatesubscriptiontier: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &updated, nil
+}
@@ -0,0 +1,39 @@
+package tip
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresTipRepo) Create(ctx context.Context, tip domains.Tip) (*domains.Tip, *response.Response[any]) {
+	query := `
+        insert into tips (transaction_id, sender_id, recipient_id, currency_code, amount, creator_amount, fee_amount, fee_rule_id, message)
+        values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        returning ` + tipColumns
+	rows, err := r.dbConn.Query(ctx, query, tip.TransactionId, tip.SenderId, tip.RecipientId, tip.CurrencyCode, tip.Amount, tip.CreatorAmount, tip.FeeAmount, tip.FeeRuleId, tip.Message)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createtip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Tip])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [createtip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &created, nil
+}
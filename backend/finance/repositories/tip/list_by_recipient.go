@@ -0,0 +1,63 @@
+package tip
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresTipRepo) ListByRecipient(ctx context.Context, recipientId uuid.UUID, page int, limit int) ([]domains.Tip, int, *response.Response[any]) {
+	countQuery := `
+        select count(*)
+        from tips t
+        join transactions tx on tx.id = t.transaction_id
+        where t.recipient_id = $1 and tx.status = 'completed'
+    `
+	var total int
+	if err := r.dbConn.QueryRow(ctx, countQuery, recipientId).Scan(&total); err != nil {
+		logger.Errorf(ctx, "db count error [listtipsbyrecipient: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	query := `
+        select t.id, t.transaction_id, t.sender_id, t.recipient_id, t.currency_code, t.amount, t.creator_amount,
+            t.fee_amount, t.fee_rule_id, t.message, t.created_at
+        from tips t
+        join transactions tx on tx.id = t.transaction_id
+        where t.recipient_id = $1 and tx.status = 'completed'
+        order by t.created_at desc
+        limit $2 offset $3
+    `
+	offset := page * limit
+	rows, err := r.dbConn.Query(ctx, query, recipientId, limit, offset)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [listtipsbyrecipient: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	tips, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.Tip])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [listtipsbyrecipient: %v]", err)
+		return nil, 0, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return tips, total, nil
+}
@@ -0,0 +1,19 @@
+package tip
+
+import (
+	"sen1or/letslive/finance/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tipColumns = `id, transaction_id, sender_id, recipient_id, currency_code, amount, creator_amount, fee_amount, fee_rule_id, message, created_at`
+
+type postgresTipRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewTipRepository(conn *pgxpool.Pool) domains.TipRepository {
+	return &postgresTipRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresTransactionRepo) GetByReference(ctx context.Context, reference string) (*domains.Transaction, *response.Response[any]) {
+	query := `
+        select id, type, reference, status, actor_id, metadata, created_at
+        from transactions
+        where reference = $1
+    `
+	rows, err := r.dbConn.Query(ctx, query, reference)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [gettransactionbyreference: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	tx, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.Transaction])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_TRANSACTION_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [gettransactionbyreference: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &tx, nil
+}
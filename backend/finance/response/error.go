@@ -14,7 +14,7 @@ const (
 	RES_ERR_DATABASE_ISSUE_CODE  = 20016
 	RES_ERR_INTERNAL_SERVER_CODE = 20017
 
-	// Finance domain (60000-60011)
+	// Finance domain (60000-60023)
 	RES_ERR_ACCOUNT_NOT_FOUND_CODE      = 60000
 	RES_ERR_ACCOUNT_FROZEN_CODE         = 60001
 	RES_ERR_INSUFFICIENT_BALANCE_CODE   = 60002
@@ -27,6 +27,21 @@ const (
 	RES_ERR_SHOP_ITEM_NOT_FOUND_CODE    = 60009
 	RES_ERR_USER_SERVICE_ERROR_CODE     = 60010
 	RES_ERR_TRANSACTION_NOT_FOUND_CODE  = 60011
+
+	RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND_CODE = 60012
+	RES_ERR_SUBSCRIPTION_TIER_LIMIT_CODE     = 60013
+	RES_ERR_SUBSCRIPTION_NOT_FOUND_CODE      = 60014
+	RES_ERR_ALREADY_SUBSCRIBED_CODE          = 60015
+
+	RES_ERR_TIP_RECIPIENT_NOT_FOUND_CODE = 60016
+	RES_ERR_TIP_BLOCKED_CODE             = 60017
+
+	RES_ERR_PAYOUT_NOT_FOUND_CODE        = 60018
+	RES_ERR_PAYOUT_BELOW_MINIMUM_CODE    = 60019
+	RES_ERR_PAYOUT_ACCOUNT_REQUIRED_CODE = 60020
+	RES_ERR_PAYOUT_KYC_NOT_VERIFIED_CODE = 60021
+	RES_ERR_PAYOUT_IN_PROGRESS_CODE      = 60022
+	RES_ERR_PAYOUT_INVALID_STATUS_CODE   = 60023
 )
 
 // Error keys
@@ -52,6 +67,21 @@ const (
 	RES_ERR_SHOP_ITEM_NOT_FOUND_KEY    = "res_err_shop_item_not_found"
 	RES_ERR_USER_SERVICE_ERROR_KEY     = "res_err_user_service_error"
 	RES_ERR_TRANSACTION_NOT_FOUND_KEY  = "res_err_transaction_not_found"
+
+	RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND_KEY = "res_err_subscription_tier_not_found"
+	RES_ERR_SUBSCRIPTION_TIER_LIMIT_KEY     = "res_err_subscription_tier_limit"
+	RES_ERR_SUBSCRIPTION_NOT_FOUND_KEY      = "res_err_subscription_not_found"
+	RES_ERR_ALREADY_SUBSCRIBED_KEY          = "res_err_already_subscribed"
+
+	RES_ERR_TIP_RECIPIENT_NOT_FOUND_KEY = "res_err_tip_recipient_not_found"
+	RES_ERR_TIP_BLOCKED_KEY             = "res_err_tip_blocked"
+
+	RES_ERR_PAYOUT_NOT_FOUND_KEY        = "res_err_payout_not_found"
+	RES_ERR_PAYOUT_BELOW_MINIMUM_KEY    = "res_err_payout_below_minimum"
+	RES_ERR_PAYOUT_ACCOUNT_REQUIRED_KEY = "res_err_payout_account_required"
+	RES_ERR_PAYOUT_KYC_NOT_VERIFIED_KEY = "res_err_payout_kyc_not_verified"
+	RES_ERR_PAYOUT_IN_PROGRESS_KEY      = "res_err_payout_in_progress"
+	RES_ERR_PAYOUT_INVALID_STATUS_KEY   = "res_err_payout_invalid_status"
 )
 
 // Error templates
@@ -215,4 +245,100 @@ var (
 		Key:        RES_ERR_TRANSACTION_NOT_FOUND_KEY,
 		Message:    "Transaction not found.",
 	}
+
+	RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND_CODE,
+		Key:        RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND_KEY,
+		Message:    "Subscription tier not found or unavailable.",
+	}
+
+	RES_ERR_SUBSCRIPTION_TIER_LIMIT = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_SUBSCRIPTION_TIER_LIMIT_CODE,
+		Key:        RES_ERR_SUBSCRIPTION_TIER_LIMIT_KEY,
+		Message:    "Channel already has the maximum number of subscription tiers.",
+	}
+
+	RES_ERR_SUBSCRIPTION_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_SUBSCRIPTION_NOT_FOUND_CODE,
+		Key:        RES_ERR_SUBSCRIPTION_NOT_FOUND_KEY,
+		Message:    "Subscription not found.",
+	}
+
+	RES_ERR_ALREADY_SUBSCRIBED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_ALREADY_SUBSCRIBED_CODE,
+		Key:        RES_ERR_ALREADY_SUBSCRIBED_KEY,
+		Message:    "Already subscribed to this channel.",
+	}
+
+	RES_ERR_TIP_RECIPIENT_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_TIP_RECIPIENT_NOT_FOUND_CODE,
+		Key:        RES_ERR_TIP_RECIPIENT_NOT_FOUND_KEY,
+		Message:    "Tip recipient not found.",
+	}
+
+	RES_ERR_TIP_BLOCKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusForbidden,
+		Code:       RES_ERR_TIP_BLOCKED_CODE,
+		Key:        RES_ERR_TIP_BLOCKED_KEY,
+		Message:    "You cannot tip this user.",
+	}
+
+	RES_ERR_PAYOUT_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_PAYOUT_NOT_FOUND_CODE,
+		Key:        RES_ERR_PAYOUT_NOT_FOUND_KEY,
+		Message:    "Payout not found.",
+	}
+
+	RES_ERR_PAYOUT_BELOW_MINIMUM = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_PAYOUT_BELOW_MINIMUM_CODE,
+		Key:        RES_ERR_PAYOUT_BELOW_MINIMUM_KEY,
+		Message:    "The amount is below the minimum payout.",
+	}
+
+	RES_ERR_PAYOUT_ACCOUNT_REQUIRED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_PAYOUT_ACCOUNT_REQUIRED_CODE,
+		Key:        RES_ERR_PAYOUT_ACCOUNT_REQUIRED_KEY,
+		Message:    "Set up a payout account first.",
+	}
+
+	RES_ERR_PAYOUT_KYC_NOT_VERIFIED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusForbidden,
+		Code:       RES_ERR_PAYOUT_KYC_NOT_VERIFIED_CODE,
+		Key:        RES_ERR_PAYOUT_KYC_NOT_VERIFIED_KEY,
+		Message:    "Your payout account must be verified before withdrawing.",
+	}
+
+	RES_ERR_PAYOUT_IN_PROGRESS = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_PAYOUT_IN_PROGRESS_CODE,
+		Key:        RES_ERR_PAYOUT_IN_PROGRESS_KEY,
+		Message:    "A payout is already in progress.",
+	}
+
+	RES_ERR_PAYOUT_INVALID_STATUS = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_PAYOUT_INVALID_STATUS_CODE,
+		Key:        RES_ERR_PAYOUT_INVALID_STATUS_KEY,
+		Message:    "The payout cannot be changed in its current status.",
+	}
 )
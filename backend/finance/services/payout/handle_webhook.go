@@ -0,0 +1,54 @@
+package payoutservice
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/finance/domains"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+)
+
+// HandleWebhook verifies the provider signature, then completes or fails the payout idempotently. A failed
+// payout gives the held amount back, a redelivered failure resumes an interrupted reversal
+func (s *PayoutService) HandleWebhook(ctx context.Context, providerName domains.PaymentProvider, payload []byte, header http.Header) *response.Response[any] {
+	gateway, ok := s.gateways[providerName]
+	if !ok {
+		return response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	event, err := gateway.VerifyWebhook(payload, header)
+	if err != nil {
+		logger.Errorf(ctx, "webhook verify failed [handlepayoutwebhook: %v]", err)
+		return response.NewResponseFromTemplate[any](response.RES_ERR_UNAUTHORIZED, nil, nil, nil)
+	}
+	if event.Type == gatewaypayout.WebhookEventIgnored {
+		return nil
+	}
+
+	payout, errResp := s.payoutRepo.GetByProviderRef(ctx, providerName, event.ProviderRef)
+	if errResp != nil {
+		return errResp
+	}
+	inFlight := []domains.PayoutStatus{domains.PayoutStatusApproved, domains.PayoutStatusProcessing}
+
+	switch event.Type {
+	case gatewaypayout.WebhookEventCompleted:
+		if payout.Status == domains.PayoutStatusCompleted {
+			return nil
+		}
+		// the held amount already sits in escrow, which mirrors the money sent out, so no entry is needed
+		payout.Status = domains.PayoutStatusCompleted
+		_, errResp := s.payoutRepo.Transition(ctx, *payout, inFlight)
+		return errResp
+
+	case gatewaypayout.WebhookEventFailed:
+		if payout.Status == domains.PayoutStatusFailed {
+			return s.reverse(ctx, payout)
+		}
+		_, errResp := s.fail(ctx, *payout, inFlight, event.FailureReason)
+		return errResp
+	}
+
+	return nil
+}
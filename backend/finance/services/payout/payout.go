@@ -0,0 +1,204 @@
+package payoutservice
+
+import (
+	"context"
+	"fmt"
+	"sen1or/letslive/finance/config"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type PayoutService struct {
+	accountRepo       domains.AccountRepository
+	currencyRepo      domains.CurrencyRepository
+	transactionRepo   domains.TransactionRepository
+	payoutAccountRepo domains.PayoutAccountRepository
+	payoutRepo        domains.PayoutRepository
+	gateways          map[domains.PaymentProvider]gatewaypayout.PayoutGateway
+	config            config.Payout
+}
+
+func NewPayoutService(
+	accountRepo domains.AccountRepository,
+	currencyRepo domains.CurrencyRepository,
+	transactionRepo domains.TransactionRepository,
+	payoutAccountRepo domains.PayoutAccountRepository,
+	payoutRepo domains.PayoutRepository,
+	gateways []gatewaypayout.PayoutGateway,
+	cfg config.Payout,
+) *PayoutService {
+	indexed := make(map[domains.PaymentProvider]gatewaypayout.PayoutGateway, len(gateways))
+	for _, g := range gateways {
+		indexed[g.Provider()] = g
+	}
+	return &PayoutService{
+		accountRepo:       accountRepo,
+		currencyRepo:      currencyRepo,
+		transactionRepo:   transactionRepo,
+		payoutAccountRepo: payoutAccountRepo,
+		payoutRepo:        payoutRepo,
+		gateways:          indexed,
+		config:            cfg,
+	}
+}
+
+// GetAccount returns nil when the user has not set up a payout account
+func (s *PayoutService) GetAccount(ctx context.Context, ownerId uuid.UUID) (*domains.PayoutAccount, *response.Response[any]) {
+	return s.payoutAccountRepo.GetByOwner(ctx, ownerId)
+}
+
+// SetAccount saves where the payouts of the user are sent, the account waits for its kyc review once submitted
+func (s *PayoutService) SetAccount(ctx context.Context, ownerId uuid.UUID, req dto.SetPayoutAccountRequestDTO) (*domains.PayoutAccount, *response.Response[any]) {
+	gateway, ok := s.gateways[domains.PaymentProvider(req.Provider)]
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+	if err := gateway.ValidateDestination(req.Destination); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	return s.payoutAccountRepo.Upsert(ctx, domains.PayoutAccount{
+		OwnerId:     ownerId,
+		Provider:    gateway.Provider(),
+		Destination: req.Destination,
+	})
+}
+
+// SetKYCStatus records the outcome of the kyc review of a payout account, it stands in for a kyc provider
+func (s *PayoutService) SetKYCStatus(ctx context.Context, ownerId uuid.UUID, req dto.SetKYCStatusRequestDTO) (*domains.PayoutAccount, *response.Response[any]) {
+	return s.payoutAccountRepo.SetKYCStatus(ctx, ownerId, req.Status)
+}
+
+func (s *PayoutService) ListForOwner(ctx context.Context, ownerId uuid.UUID, page int, limit int) ([]dto.PayoutResponse, int, *response.Response[any]) {
+	payouts, total, errResp := s.payoutRepo.ListByOwner(ctx, ownerId, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	responses, errResp := s.toResponses(ctx, payouts)
+	return responses, total, errResp
+}
+
+// ListByStatus lists the payouts to review, or any other status, for the internal routes
+func (s *PayoutService) ListByStatus(ctx context.Context, status domains.PayoutStatus, page int, limit int) ([]dto.PayoutResponse, int, *response.Response[any]) {
+	payouts, total, errResp := s.payoutRepo.ListByStatus(ctx, status, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	responses, errResp := s.toResponses(ctx, payouts)
+	return responses, total, errResp
+}
+
+func (s *PayoutService) toResponses(ctx context.Context, payouts []domains.Payout) ([]dto.PayoutResponse, *response.Response[any]) {
+	currencies, errResp := s.currencyRepo.List(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+	precisions := make(map[string]int, len(currencies))
+	for _, c := range currencies {
+		precisions[c.Code] = c.Precision
+	}
+
+	responses := make([]dto.PayoutResponse, len(payouts))
+	for i, p := range payouts {
+		responses[i] = dto.NewPayoutResponse(p, precisions[p.CurrencyCode])
+	}
+	return responses, nil
+}
+
+func (s *PayoutService) toResponse(ctx context.Context, payout domains.Payout) (*dto.PayoutResponse, *response.Response[any]) {
+	currency, errResp := s.currencyRepo.GetByCode(ctx, payout.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+	out := dto.NewPayoutResponse(payout, currency.Precision)
+	return &out, nil
+}
+
+// reverse moves the held amount of a failed, rejected or cancelled payout back to the owner's wallet. It is
+// idempotent, the next call resumes a reversal that was interrupted
+func (s *PayoutService) reverse(ctx context.Context, payout *domains.Payout) *response.Response[any] {
+	if payout.ReversalTransactionId != nil {
+		return nil
+	}
+
+	// a payout whose hold did not complete has nothing in escrow to give back
+	hold, errResp := s.transactionRepo.GetById(ctx, payout.HoldTransactionId)
+	if errResp != nil {
+		return errResp
+	}
+	if hold.Status != domains.ProcessStatusCompleted {
+		return nil
+	}
+
+	reference := fmt.Sprintf("payout-%s-reversal", payout.Id)
+	tx, errResp := s.transactionRepo.GetByReference(ctx, reference)
+	if errResp != nil {
+		if errResp.Code != response.RES_ERR_TRANSACTION_NOT_FOUND_CODE {
+			return errResp
+		}
+		tx, errResp = s.transactionRepo.Create(ctx, domains.Transaction{
+			Type:      domains.TransactionTypeRefund,
+			Reference: &reference,
+			Status:    domains.ProcessStatusCreated,
+			ActorId:   &payout.OwnerId,
+		})
+		if errResp != nil {
+			return errResp
+		}
+	}
+
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, payout.OwnerId)
+	if errResp != nil {
+		return errResp
+	}
+	escrow, errResp := s.accountRepo.GetEscrow(ctx)
+	if errResp != nil {
+		return errResp
+	}
+
+	entries := []domains.LedgerEntryDraft{
+		{AccountId: escrow.Id, CurrencyCode: payout.CurrencyCode, Amount: -payout.Amount},
+		{AccountId: wallet.Id, CurrencyCode: payout.CurrencyCode, Amount: payout.Amount},
+	}
+	if completeErr := s.transactionRepo.CompleteWithEntries(ctx, tx.Id, entries); completeErr != nil {
+		logger.Errorf(ctx, "failed to reverse payout %s, the amount stays held [reversepayout]", payout.Id)
+		return completeErr
+	}
+
+	if errResp := s.payoutRepo.SetReversal(ctx, payout.Id, tx.Id); errResp != nil {
+		return errResp
+	}
+	payout.ReversalTransactionId = &tx.Id
+	return nil
+}
+
+// fail moves the payout from one of the from statuses to failed and gives the held amount back
+func (s *PayoutService) fail(ctx context.Context, payout domains.Payout, from []domains.PayoutStatus, reason string) (*domains.Payout, *response.Response[any]) {
+	if reason == "" {
+		reason = "the payout provider failed the transfer"
+	}
+	payout.Status = domains.PayoutStatusFailed
+	payout.FailureReason = &reason
+
+	failed, errResp := s.payoutRepo.Transition(ctx, payout, from)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if errResp := s.reverse(ctx, failed); errResp != nil {
+		return nil, errResp
+	}
+	return failed, nil
+}
+
+// failTransaction best-effort marks a transaction failed so it does not dangle in
+// 'created' forever; the caller is already on an error path, so only log on failure.
+func (s *PayoutService) failTransaction(ctx context.Context, id uuid.UUID) {
+	if errResp := s.transactionRepo.UpdateStatus(ctx, id, domains.ProcessStatusFailed); errResp != nil {
+		logger.Errorf(ctx, "failed to mark transaction %s as failed [failtransaction]", id)
+	}
+}
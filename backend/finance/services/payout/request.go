@@ -0,0 +1,138 @@
+package payoutservice
+
+import (
+	"context"
+	"fmt"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// RequestPayout holds the amount in escrow until the payout is reviewed: the creator's wallet is debited and
+// the escrow account credited in one zero-sum payout transaction
+func (s *PayoutService) RequestPayout(ctx context.Context, ownerId uuid.UUID, req dto.RequestPayoutRequestDTO) (*dto.PayoutResponse, *response.Response[any]) {
+	currency, errResp := s.currencyRepo.GetByCode(ctx, req.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+	minAmount, ok := s.config.MinAmounts[currency.Code]
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_UNSUPPORTED_CURRENCY, nil, nil, nil)
+	}
+	amount, err := dto.ParseAmount(req.Amount, currency.Precision)
+	if err != nil || amount <= 0 {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_AMOUNT, nil, nil, nil)
+	}
+	if amount < minAmount {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_BELOW_MINIMUM, nil, nil, nil)
+	}
+
+	account, errResp := s.payoutAccountRepo.GetByOwner(ctx, ownerId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if account == nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_ACCOUNT_REQUIRED, nil, nil, nil)
+	}
+	// an account of a provider that is no longer offered has to be set up again
+	if _, ok := s.gateways[account.Provider]; !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_ACCOUNT_REQUIRED, nil, nil, nil)
+	}
+	if s.config.RequireVerifiedKYC && account.KYCStatus != domains.KYCStatusVerified {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_KYC_NOT_VERIFIED, nil, nil, nil)
+	}
+
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, ownerId)
+	if errResp != nil {
+		if errResp.Code == response.RES_ERR_ACCOUNT_NOT_FOUND_CODE {
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INSUFFICIENT_BALANCE, nil, nil, nil)
+		}
+		return nil, errResp
+	}
+	if wallet.Status == domains.AccountStatusFrozen {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_ACCOUNT_FROZEN, nil, nil, nil)
+	}
+	escrow, errResp := s.accountRepo.GetEscrow(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	payoutId, err := uuid.NewV4()
+	if err != nil {
+		logger.Errorf(ctx, "uuid generation failed [requestpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+	reference := fmt.Sprintf("payout-%s-hold", payoutId)
+	tx, errResp := s.transactionRepo.Create(ctx, domains.Transaction{
+		Type:      domains.TransactionTypePayout,
+		Reference: &reference,
+		Status:    domains.ProcessStatusCreated,
+		ActorId:   &ownerId,
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// the payout is recorded first so a second request of the owner is turned down before anything is held,
+	// it is only listed once the hold completed
+	payout, errResp := s.payoutRepo.Create(ctx, domains.Payout{
+		Id:                payoutId,
+		OwnerId:           ownerId,
+		CurrencyCode:      currency.Code,
+		Amount:            amount,
+		Status:            domains.PayoutStatusPending,
+		Provider:          account.Provider,
+		Destination:       account.Destination,
+		HoldTransactionId: tx.Id,
+	})
+	if errResp != nil {
+		s.failTransaction(ctx, tx.Id)
+		return nil, errResp
+	}
+
+	entries := []domains.LedgerEntryDraft{
+		{AccountId: wallet.Id, CurrencyCode: currency.Code, Amount: -amount},
+		{AccountId: escrow.Id, CurrencyCode: currency.Code, Amount: amount},
+	}
+	if completeErr := s.transactionRepo.CompleteWithEntries(ctx, tx.Id, entries); completeErr != nil {
+		s.failTransaction(ctx, tx.Id)
+		// nothing was held, so there is nothing to reverse
+		reason := "the amount could not be held"
+		payout.Status = domains.PayoutStatusFailed
+		payout.FailureReason = &reason
+		if _, errResp := s.payoutRepo.Transition(ctx, *payout, []domains.PayoutStatus{domains.PayoutStatusPending}); errResp != nil {
+			logger.Errorf(ctx, "failed to mark payout %s as failed [requestpayout]", payout.Id)
+		}
+		return nil, completeErr
+	}
+
+	return s.toResponse(ctx, *payout)
+}
+
+// Cancel withdraws a payout of the owner that was not reviewed yet and gives the held amount back
+func (s *PayoutService) Cancel(ctx context.Context, ownerId uuid.UUID, payoutId uuid.UUID) (*dto.PayoutResponse, *response.Response[any]) {
+	payout, errResp := s.payoutRepo.GetById(ctx, payoutId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if payout.OwnerId != ownerId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_NOT_FOUND, nil, nil, nil)
+	}
+
+	// cancelling again resumes an interrupted reversal
+	if payout.Status != domains.PayoutStatusCancelled {
+		payout.Status = domains.PayoutStatusCancelled
+		payout, errResp = s.payoutRepo.Transition(ctx, *payout, []domains.PayoutStatus{domains.PayoutStatusPending})
+		if errResp != nil {
+			return nil, errResp
+		}
+	}
+	if errResp := s.reverse(ctx, payout); errResp != nil {
+		return nil, errResp
+	}
+
+	return s.toResponse(ctx, *payout)
+}
@@ -0,0 +1,96 @@
+package payoutservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	gatewaypayout "sen1or/letslive/finance/gateway/payout"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Approve accepts a pending payout and sends it to the provider. Approving a payout again retries a transfer
+// the provider could not be reached for
+func (s *PayoutService) Approve(ctx context.Context, payoutId uuid.UUID, reviewerId uuid.UUID) (*dto.PayoutResponse, *response.Response[any]) {
+	payout, errResp := s.payoutRepo.GetById(ctx, payoutId)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	switch payout.Status {
+	case domains.PayoutStatusPending:
+		now := time.Now()
+		payout.Status = domains.PayoutStatusApproved
+		payout.ReviewedBy = &reviewerId
+		payout.ReviewedAt = &now
+		payout, errResp = s.payoutRepo.Transition(ctx, *payout, []domains.PayoutStatus{domains.PayoutStatusPending})
+		if errResp != nil {
+			return nil, errResp
+		}
+	case domains.PayoutStatusApproved:
+	default:
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYOUT_INVALID_STATUS, nil, nil, nil)
+	}
+
+	payout, errResp = s.send(ctx, *payout)
+	if errResp != nil {
+		return nil, errResp
+	}
+	return s.toResponse(ctx, *payout)
+}
+
+// Reject turns down a pending payout and gives the held amount back. Rejecting a payout again resumes an
+// interrupted reversal
+func (s *PayoutService) Reject(ctx context.Context, payoutId uuid.UUID, req dto.ReviewPayoutRequestDTO) (*dto.PayoutResponse, *response.Response[any]) {
+	payout, errResp := s.payoutRepo.GetById(ctx, payoutId)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if payout.Status != domains.PayoutStatusRejected {
+		now := time.Now()
+		payout.Status = domains.PayoutStatusRejected
+		payout.FailureReason = req.Reason
+		payout.ReviewedBy = &req.ReviewerId
+		payout.ReviewedAt = &now
+		payout, errResp = s.payoutRepo.Transition(ctx, *payout, []domains.PayoutStatus{domains.PayoutStatusPending})
+		if errResp != nil {
+			return nil, errResp
+		}
+	}
+	if errResp := s.reverse(ctx, payout); errResp != nil {
+		return nil, errResp
+	}
+
+	return s.toResponse(ctx, *payout)
+}
+
+// send creates the transfer of an approved payout, the payout id is the idempotency key so a retried
+// approval never pays twice
+func (s *PayoutService) send(ctx context.Context, payout domains.Payout) (*domains.Payout, *response.Response[any]) {
+	gateway, ok := s.gateways[payout.Provider]
+	if !ok {
+		return s.fail(ctx, payout, []domains.PayoutStatus{domains.PayoutStatusApproved}, "the payout provider is no longer supported")
+	}
+
+	transfer, err := gateway.CreateTransfer(ctx, payout.Id.String(), payout.Amount, payout.CurrencyCode, payout.Destination)
+	if err != nil {
+		// the payout stays approved, approving it again retries the transfer
+		logger.Errorf(ctx, "payout transfer failed [sendpayout: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_PAYMENT_FAILED, nil, nil, nil)
+	}
+
+	payout.ProviderRef = &transfer.ProviderRef
+	switch transfer.Status {
+	case gatewaypayout.TransferStatusFailed:
+		return s.fail(ctx, payout, []domains.PayoutStatus{domains.PayoutStatusApproved}, transfer.FailureReason)
+	case gatewaypayout.TransferStatusCompleted:
+		payout.Status = domains.PayoutStatusCompleted
+	default:
+		payout.Status = domains.PayoutStatusProcessing
+	}
+	return s.payoutRepo.Transition(ctx, payout, []domains.PayoutStatus{domains.PayoutStatusApproved})
+}
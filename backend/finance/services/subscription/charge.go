@@ -0,0 +1,167 @@
+package subscriptionservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type chargeMetadata struct {
+	SubscriptionId uuid.UUID  `json:"subscriptionId"`
+	ChannelId      uuid.UUID  `json:"channelId"`
+	TierId         uuid.UUID  `json:"tierId"`
+	Period         int        `json:"period"`
+	FeeRuleId      *uuid.UUID `json:"feeRuleId,omitempty"`
+	FeeRuleVersion int        `json:"feeRuleVersion,omitempty"`
+}
+
+// chargeReference is the idempotency key of a charge attempt. It only changes once the attempt
+// is recorded on the subscription, so an attempt that crashed before that finds its transaction again
+func chargeReference(subscription domains.ChannelSubscription) string {
+	return fmt.Sprintf("subscription-%s-%d-%d", subscription.Id, subscription.Months+1, subscription.FailedAttempts)
+}
+
+// chargePeriod charges the subscriber the tier price for the next period of the subscription, in one
+// zero-sum transaction that credits the creator's wallet and the platform fee account. The fee comes from
+// the subscription fee rule in force when the charge is first attempted, and is recorded in its metadata
+func (s *SubscriptionService) chargePeriod(ctx context.Context, subscription domains.ChannelSubscription, tier domains.SubscriptionTier) *response.Response[any] {
+	var rule *domains.FeeRule
+	reference := chargeReference(subscription)
+	tx, errResp := s.transactionRepo.GetByReference(ctx, reference)
+	if errResp != nil {
+		if errResp.Code != response.RES_ERR_TRANSACTION_NOT_FOUND_CODE {
+			return errResp
+		}
+
+		rule, errResp = s.feeRuleRepo.GetInForce(ctx, domains.TransactionTypeSubscription, tier.CurrencyCode)
+		if errResp != nil {
+			return errResp
+		}
+		metadata := chargeMetadata{
+			SubscriptionId: subscription.Id,
+			ChannelId:      subscription.ChannelId,
+			TierId:         tier.Id,
+			Period:         subscription.Months + 1,
+		}
+		if rule != nil {
+			metadata.FeeRuleId = &rule.Id
+			metadata.FeeRuleVersion = rule.Version
+		}
+		metadataBytes, err := json.Marshal(metadata)
+		if err != nil {
+			logger.Errorf(ctx, "metadata encoding failed [chargesubscription: %v]", err)
+			return response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+		}
+		metadataStr := string(metadataBytes)
+
+		tx, errResp = s.transactionRepo.Create(ctx, domains.Transaction{
+			Type:      domains.TransactionTypeSubscription,
+			Reference: &reference,
+			Status:    domains.ProcessStatusCreated,
+			ActorId:   &subscription.SubscriberId,
+			Metadata:  &metadataStr,
+		})
+		if errResp != nil {
+			return errResp
+		}
+	} else {
+		if tx.Status == domains.ProcessStatusCompleted {
+			return nil
+		}
+		// a charge resumed after a crash keeps the fee rule it was created with
+		rule, errResp = s.recordedFeeRule(ctx, *tx)
+		if errResp != nil {
+			return errResp
+		}
+	}
+
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, subscription.SubscriberId)
+	if errResp != nil {
+		return s.declineCharge(ctx, tx.Id, errResp)
+	}
+	if wallet.Status == domains.AccountStatusFrozen {
+		return s.declineCharge(ctx, tx.Id, response.NewResponseFromTemplate[any](response.RES_ERR_ACCOUNT_FROZEN, nil, nil, nil))
+	}
+
+	creatorWallet, errResp := s.getOrCreateWallet(ctx, subscription.ChannelId)
+	if errResp != nil {
+		return errResp
+	}
+	feeAccount, errResp := s.accountRepo.GetFee(ctx)
+	if errResp != nil {
+		return errResp
+	}
+
+	creatorShare, platformFee := rule.Split(tier.Price)
+	entries := []domains.LedgerEntryDraft{
+		{AccountId: wallet.Id, CurrencyCode: tier.CurrencyCode, Amount: -tier.Price},
+	}
+	if creatorShare > 0 {
+		entries = append(entries, domains.LedgerEntryDraft{AccountId: creatorWallet.Id, CurrencyCode: tier.CurrencyCode, Amount: creatorShare})
+	}
+	if platformFee > 0 {
+		entries = append(entries, domains.LedgerEntryDraft{AccountId: feeAccount.Id, CurrencyCode: tier.CurrencyCode, Amount: platformFee})
+	}
+
+	if completeErr := s.transactionRepo.CompleteWithEntries(ctx, tx.Id, entries); completeErr != nil {
+		return s.declineCharge(ctx, tx.Id, completeErr)
+	}
+	return nil
+}
+
+// declineCharge fails the transaction of a charge the subscriber could not pay. Other errors leave it
+// to be completed by the next attempt with the same reference
+func (s *SubscriptionService) declineCharge(ctx context.Context, transactionId uuid.UUID, errResp *response.Response[any]) *response.Response[any] {
+	if !isChargeDeclined(errResp) {
+		return errResp
+	}
+
+	if updateErr := s.transactionRepo.UpdateStatus(ctx, transactionId, domains.ProcessStatusFailed); updateErr != nil {
+		logger.Errorf(ctx, "failed to mark declined subscription charge %s as failed [chargesubscription]", transactionId)
+	}
+	return errResp
+}
+
+// isChargeDeclined tells a charge the subscriber could not pay from an error worth retrying soon
+func isChargeDeclined(errResp *response.Response[any]) bool {
+	switch errResp.Code {
+	case response.RES_ERR_INSUFFICIENT_BALANCE_CODE,
+		response.RES_ERR_ACCOUNT_FROZEN_CODE,
+		response.RES_ERR_ACCOUNT_NOT_FOUND_CODE,
+		response.RES_ERR_TRANSACTION_FAILED_CODE:
+		return true
+	}
+	return false
+}
+
+func (s *SubscriptionService) getOrCreateWallet(ctx context.Context, ownerId uuid.UUID) (*domains.Account, *response.Response[any]) {
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, ownerId)
+	if errResp != nil {
+		if errResp.Code != response.RES_ERR_ACCOUNT_NOT_FOUND_CODE {
+			return nil, errResp
+		}
+		return s.accountRepo.CreateUserWallet(ctx, ownerId)
+	}
+	return wallet, nil
+}
+
+// recordedFeeRule returns the fee rule recorded in the metadata of a charge, nil when it was charged without a fee
+func (s *SubscriptionService) recordedFeeRule(ctx context.Context, tx domains.Transaction) (*domains.FeeRule, *response.Response[any]) {
+	if tx.Metadata == nil {
+		return nil, nil
+	}
+	var metadata chargeMetadata
+	if err := json.Unmarshal([]byte(*tx.Metadata), &metadata); err != nil {
+		logger.Errorf(ctx, "metadata decoding failed [chargesubscription: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+	if metadata.FeeRuleId == nil {
+		return nil, nil
+	}
+	return s.feeRuleRepo.GetById(ctx, *metadata.FeeRuleId)
+}
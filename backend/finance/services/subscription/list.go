@@ -0,0 +1,47 @@
+package subscriptionservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// ListForSubscriber returns the subscriptions of the user, the latest first
+func (s *SubscriptionService) ListForSubscriber(ctx context.Context, subscriberId uuid.UUID, page int, limit int) ([]dto.SubscriptionResponse, int, *response.Response[any]) {
+	subscriptions, total, errResp := s.subscriptionRepo.ListBySubscriber(ctx, subscriberId, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+
+	out, errResp := s.toResponses(ctx, subscriptions)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	return out, total, nil
+}
+
+// ListSubscribers returns the running subscriptions to the creator's channel, the oldest first
+func (s *SubscriptionService) ListSubscribers(ctx context.Context, channelId uuid.UUID, page int, limit int) ([]dto.SubscriptionResponse, int, *response.Response[any]) {
+	subscriptions, total, errResp := s.subscriptionRepo.ListRunningByChannel(ctx, channelId, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+
+	out, errResp := s.toResponses(ctx, subscriptions)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	return out, total, nil
+}
+
+// GetBadges returns the badges of the users subscribed to the channel, users without one are left out.
+// A past due subscriber keeps the badge during the grace period
+func (s *SubscriptionService) GetBadges(ctx context.Context, req dto.GetSubscriberBadgesRequestDTO) ([]domains.SubscriberBadge, *response.Response[any]) {
+	if len(req.UserIds) == 0 {
+		return []domains.SubscriberBadge{}, nil
+	}
+	return s.subscriptionRepo.GetBadges(ctx, req.ChannelId, req.UserIds)
+}
@@ -0,0 +1,206 @@
+package subscriptionservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+)
+
+// how long a claimed subscription is hidden from other replicas, a crashed renewal is retried after it
+const renewalLease = 5 * time.Minute
+
+// how many times a paid period is recorded again on a subscription changed during its renewal
+const maxSaveAttempts = 3
+
+// RunRenewals charges the subscriptions whose period ended until the context is cancelled
+func (s *SubscriptionService) RunRenewals(ctx context.Context) {
+	interval := time.Duration(s.config.RenewalInterval) * time.Second
+	logger.Infof(ctx, "subscription renewals started, running every %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.renewDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "subscription renewals stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SubscriptionService) renewDue(ctx context.Context) {
+	subscriptions, errResp := s.subscriptionRepo.ClaimDue(ctx, s.config.RenewalBatchSize, renewalLease)
+	if errResp != nil {
+		logger.Errorf(ctx, "failed to claim due subscriptions: %s", errResp.Message)
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if subscription.Status == domains.SubscriptionStatusPending {
+			s.settlePending(ctx, subscription)
+			continue
+		}
+		s.renew(ctx, subscription)
+	}
+}
+
+// renew ends a subscription that was cancelled or whose tier was removed, otherwise charges its next period.
+// A declined charge makes it past due, other failures are retried once the lease is over
+func (s *SubscriptionService) renew(ctx context.Context, subscription domains.ChannelSubscription) {
+	if subscription.CancelAtPeriodEnd {
+		subscription.Status = domains.SubscriptionStatusCancelled
+		s.save(ctx, subscription)
+		return
+	}
+
+	tier, errResp := s.tierRepo.GetById(ctx, subscription.TierId)
+	if errResp != nil {
+		logger.Warnf(ctx, "failed to get tier %s to renew subscription %s: %s", subscription.TierId, subscription.Id, errResp.Message)
+		return
+	}
+	if !tier.IsActive {
+		subscription.Status = domains.SubscriptionStatusExpired
+		s.save(ctx, subscription)
+		return
+	}
+
+	if chargeErr := s.chargePeriod(ctx, subscription, *tier); chargeErr != nil {
+		if !isChargeDeclined(chargeErr) {
+			logger.Warnf(ctx, "failed to charge subscription %s, retrying later: %s", subscription.Id, chargeErr.Message)
+			return
+		}
+
+		failRenewal(&subscription, time.Now(), time.Duration(s.config.GracePeriod)*time.Second, time.Duration(s.config.RetryInterval)*time.Second)
+		logger.Infof(ctx, "renewal of subscription %s declined (%s), now %s", subscription.Id, chargeErr.Key, subscription.Status)
+		s.save(ctx, subscription)
+		return
+	}
+
+	s.savePaid(ctx, subscription)
+}
+
+// settlePending activates a subscription left pending by a crashed subscribe request when its first charge
+// went through, otherwise expires it, the subscriber is never charged later than the request
+func (s *SubscriptionService) settlePending(ctx context.Context, subscription domains.ChannelSubscription) {
+	tx, errResp := s.transactionRepo.GetByReference(ctx, chargeReference(subscription))
+	if errResp != nil && errResp.Code != response.RES_ERR_TRANSACTION_NOT_FOUND_CODE {
+		logger.Warnf(ctx, "failed to get the first charge of subscription %s: %s", subscription.Id, errResp.Message)
+		return
+	}
+
+	if tx != nil && tx.Status == domains.ProcessStatusCompleted {
+		s.savePaid(ctx, subscription)
+		return
+	}
+
+	if tx != nil {
+		if updateErr := s.transactionRepo.UpdateStatus(ctx, tx.Id, domains.ProcessStatusCancelled); updateErr != nil {
+			logger.Warnf(ctx, "failed to cancel the first charge %s of subscription %s", tx.Id, subscription.Id)
+			return
+		}
+	}
+	subscription.Status = domains.SubscriptionStatusExpired
+	s.save(ctx, subscription)
+}
+
+// save writes the outcome of a renewal attempt. When the subscription was cancelled meanwhile nothing is
+// written, the next attempt starts over from the cancelled subscription once the lease is over
+func (s *SubscriptionService) save(ctx context.Context, subscription domains.ChannelSubscription) {
+	saved, errResp := s.subscriptionRepo.Update(ctx, subscription)
+	if errResp != nil {
+		logger.Errorf(ctx, "failed to save subscription %s, retrying once the lease is over: %s", subscription.Id, errResp.Message)
+	} else if !saved {
+		logger.Infof(ctx, "subscription %s changed during its renewal, retrying once the lease is over", subscription.Id)
+	}
+}
+
+// savePaid records the period just charged. The charge cannot be undone, so when the subscription was
+// cancelled meanwhile the period is recorded on the fresh copy and the cancel applies at its end
+func (s *SubscriptionService) savePaid(ctx context.Context, subscription domains.ChannelSubscription) {
+	months := subscription.Months
+	for attempt := 0; attempt < maxSaveAttempts; attempt++ {
+		cancelled := subscription.Status == domains.SubscriptionStatusCancelled
+		paidPeriod(&subscription)
+		if cancelled {
+			subscription.CancelAtPeriodEnd = true
+		}
+
+		saved, errResp := s.subscriptionRepo.Update(ctx, subscription)
+		if errResp != nil {
+			logger.Errorf(ctx, "failed to save paid subscription %s, retrying once the lease is over: %s", subscription.Id, errResp.Message)
+			return
+		}
+		if saved {
+			return
+		}
+
+		fresh, errResp := s.subscriptionRepo.GetById(ctx, subscription.Id)
+		if errResp != nil {
+			logger.Errorf(ctx, "failed to reload paid subscription %s, retrying once the lease is over: %s", subscription.Id, errResp.Message)
+			return
+		}
+		if fresh.Months != months {
+			// another attempt already recorded the period
+			return
+		}
+		subscription = *fresh
+	}
+	logger.Errorf(ctx, "paid subscription %s kept changing, retrying once the lease is over", subscription.Id)
+}
+
+// paidPeriod records a paid period. A renewal starts where the last period ended, even when it was paid
+// late during the grace period, so the billing date of a subscription never moves
+func paidPeriod(subscription *domains.ChannelSubscription) {
+	if subscription.Months > 0 {
+		subscription.CurrentPeriodStart = subscription.CurrentPeriodEnd
+	}
+	subscription.Months++
+	subscription.CurrentPeriodEnd = periodEnd(subscription.BillingAnchor, subscription.Months)
+	subscription.Status = domains.SubscriptionStatusActive
+	subscription.FailedAttempts = 0
+	subscription.GraceUntil = nil
+	subscription.NextAttemptAt = subscription.CurrentPeriodEnd
+}
+
+// periodEnd returns the end of the period paid months after the anchor. It falls on the anchor's day of the month,
+// or on the last day of a shorter month, and is always computed from the anchor so a short month does not move
+// the later ones: a subscription started on January 31st renews on February 28th, then on March 31st
+func periodEnd(anchor time.Time, months int) time.Time {
+	anchor = anchor.UTC()
+	year, month, day := anchor.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), min(day, lastDay),
+		anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), time.UTC)
+}
+
+// failRenewal records a declined renewal: the subscription is past due and retried until the grace period
+// after its period ended is over, the last attempt being made when it ends
+func failRenewal(subscription *domains.ChannelSubscription, now time.Time, gracePeriod time.Duration, retryInterval time.Duration) {
+	subscription.FailedAttempts++
+	if subscription.GraceUntil == nil {
+		graceUntil := subscription.CurrentPeriodEnd.Add(gracePeriod)
+		subscription.GraceUntil = &graceUntil
+	}
+
+	if !now.Before(*subscription.GraceUntil) {
+		subscription.Status = domains.SubscriptionStatusExpired
+		return
+	}
+
+	subscription.Status = domains.SubscriptionStatusPastDue
+	subscription.NextAttemptAt = now.Add(retryInterval)
+	if subscription.NextAttemptAt.After(*subscription.GraceUntil) {
+		subscription.NextAttemptAt = *subscription.GraceUntil
+	}
+}
@@ -0,0 +1,95 @@
+package subscriptionservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Subscribe starts a monthly subscription to the channel of the tier, charging its first period right away.
+// The subscription is pending while that charge runs; the renewal job settles the ones left pending by a crash
+func (s *SubscriptionService) Subscribe(ctx context.Context, subscriberId uuid.UUID, req dto.SubscribeRequestDTO) (*dto.SubscriptionResponse, *response.Response[any]) {
+	tier, errResp := s.tierRepo.GetById(ctx, req.TierId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if !tier.IsActive {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND, nil, nil, nil)
+	}
+	if tier.ChannelId == subscriberId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	currency, errResp := s.currencyRepo.GetByCode(ctx, tier.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	now := time.Now()
+	subscription, errResp := s.subscriptionRepo.Create(ctx, domains.ChannelSubscription{
+		SubscriberId:       subscriberId,
+		ChannelId:          tier.ChannelId,
+		TierId:             tier.Id,
+		Status:             domains.SubscriptionStatusPending,
+		BillingAnchor:      now,
+		CurrentPeriodStart: now,
+		CurrentPeriodEnd:   periodEnd(now, 1),
+		NextAttemptAt:      now.Add(renewalLease),
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if chargeErr := s.chargePeriod(ctx, *subscription, *tier); chargeErr != nil {
+		// any other failure may come after the charge committed, the subscription stays pending for the
+		// renewal job to activate or expire once it can tell
+		if isChargeDeclined(chargeErr) {
+			subscription.Status = domains.SubscriptionStatusExpired
+			if saved, updateErr := s.subscriptionRepo.Update(ctx, *subscription); updateErr != nil || !saved {
+				logger.Errorf(ctx, "failed to expire unpaid subscription %s, left to the renewal job [subscribe]", subscription.Id)
+			}
+		}
+		return nil, chargeErr
+	}
+
+	paidPeriod(subscription)
+	if saved, updateErr := s.subscriptionRepo.Update(ctx, *subscription); updateErr != nil || !saved {
+		// the period is paid, the renewal job finds the completed charge and activates the subscription
+		logger.Errorf(ctx, "failed to activate paid subscription %s, left to the renewal job [subscribe]", subscription.Id)
+	}
+
+	tierResp := dto.NewSubscriptionTierResponse(*tier, currency.Precision)
+	return &dto.SubscriptionResponse{ChannelSubscription: *subscription, Tier: &tierResp}, nil
+}
+
+// Cancel stops the renewals of the subscriber's subscription, an active one runs until its paid period ends
+func (s *SubscriptionService) Cancel(ctx context.Context, subscriberId uuid.UUID, subscriptionId uuid.UUID) (*dto.SubscriptionResponse, *response.Response[any]) {
+	subscription, errResp := s.subscriptionRepo.GetById(ctx, subscriptionId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if subscription.SubscriberId != subscriberId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_SUBSCRIPTION_NOT_FOUND, nil, nil, nil)
+	}
+
+	// an active subscription runs until its paid period ends, a past due one is cancelled right away as its
+	// current period was never paid for
+	cancelled, errResp := s.subscriptionRepo.Cancel(ctx, subscription.Id)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if cancelled != nil {
+		subscription = cancelled
+	}
+
+	out, errResp := s.toResponses(ctx, []domains.ChannelSubscription{*subscription})
+	if errResp != nil {
+		return nil, errResp
+	}
+	return &out[0], nil
+}
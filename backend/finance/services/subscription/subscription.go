@@ -0,0 +1,90 @@
+package subscriptionservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/config"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SubscriptionService struct {
+	accountRepo      domains.AccountRepository
+	currencyRepo     domains.CurrencyRepository
+	transactionRepo  domains.TransactionRepository
+	feeRuleRepo      domains.FeeRuleRepository
+	tierRepo         domains.SubscriptionTierRepository
+	subscriptionRepo domains.SubscriptionRepository
+	config           config.Subscription
+}
+
+func NewSubscriptionService(
+	accountRepo domains.AccountRepository,
+	currencyRepo domains.CurrencyRepository,
+	transactionRepo domains.TransactionRepository,
+	feeRuleRepo domains.FeeRuleRepository,
+	tierRepo domains.SubscriptionTierRepository,
+	subscriptionRepo domains.SubscriptionRepository,
+	cfg config.Subscription,
+) *SubscriptionService {
+	return &SubscriptionService{
+		accountRepo:      accountRepo,
+		currencyRepo:     currencyRepo,
+		transactionRepo:  transactionRepo,
+		feeRuleRepo:      feeRuleRepo,
+		tierRepo:         tierRepo,
+		subscriptionRepo: subscriptionRepo,
+		config:           cfg,
+	}
+}
+
+// precisionByCode maps the currency codes to their precision, for formatting amounts
+func (s *SubscriptionService) precisionByCode(ctx context.Context) (map[string]int, *response.Response[any]) {
+	currencies, errResp := s.currencyRepo.List(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	precisions := make(map[string]int, len(currencies))
+	for _, c := range currencies {
+		precisions[c.Code] = c.Precision
+	}
+	return precisions, nil
+}
+
+// toResponses attaches their tier to the subscriptions
+func (s *SubscriptionService) toResponses(ctx context.Context, subscriptions []domains.ChannelSubscription) ([]dto.SubscriptionResponse, *response.Response[any]) {
+	out := make([]dto.SubscriptionResponse, 0, len(subscriptions))
+	if len(subscriptions) == 0 {
+		return out, nil
+	}
+
+	tierIds := make([]uuid.UUID, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		tierIds = append(tierIds, subscription.TierId)
+	}
+	tiers, errResp := s.tierRepo.GetByIds(ctx, tierIds)
+	if errResp != nil {
+		return nil, errResp
+	}
+	precisions, errResp := s.precisionByCode(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	tierById := make(map[uuid.UUID]dto.SubscriptionTierResponse, len(tiers))
+	for _, tier := range tiers {
+		tierById[tier.Id] = dto.NewSubscriptionTierResponse(tier, precisions[tier.CurrencyCode])
+	}
+
+	for _, subscription := range subscriptions {
+		item := dto.SubscriptionResponse{ChannelSubscription: subscription}
+		if tier, ok := tierById[subscription.TierId]; ok {
+			item.Tier = &tier
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
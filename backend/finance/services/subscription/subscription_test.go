@@ -0,0 +1,140 @@
+package subscriptionservice
+
+import (
+	"sen1or/letslive/finance/domains"
+	"testing"
+	"time"
+)
+
+func TestPaidPeriod(t *testing.T) {
+	start := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	graceUntil := end.Add(72 * time.Hour)
+	monthEndStart := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		subscription domains.ChannelSubscription
+		wantStart    time.Time
+		wantEnd      time.Time
+		wantMonths   int
+	}{
+		{
+			name:         "first period keeps its dates",
+			subscription: domains.ChannelSubscription{Status: domains.SubscriptionStatusPending, BillingAnchor: start, CurrentPeriodStart: start, CurrentPeriodEnd: end},
+			wantStart:    start,
+			wantEnd:      end,
+			wantMonths:   1,
+		},
+		{
+			name:         "renewal starts where the period ended",
+			subscription: domains.ChannelSubscription{Status: domains.SubscriptionStatusActive, Months: 1, BillingAnchor: start, CurrentPeriodStart: start, CurrentPeriodEnd: end},
+			wantStart:    end,
+			wantEnd:      end.AddDate(0, 1, 0),
+			wantMonths:   2,
+		},
+		{
+			name: "late payment keeps the billing date",
+			subscription: domains.ChannelSubscription{Status: domains.SubscriptionStatusPastDue, Months: 3, FailedAttempts: 2,
+				BillingAnchor: start.AddDate(0, -2, 0), CurrentPeriodStart: start, CurrentPeriodEnd: end, GraceUntil: &graceUntil},
+			wantStart:  end,
+			wantEnd:    end.AddDate(0, 1, 0),
+			wantMonths: 4,
+		},
+		{
+			name: "renewal after a short month goes back to the anchor day",
+			subscription: domains.ChannelSubscription{Status: domains.SubscriptionStatusActive, Months: 1, BillingAnchor: monthEndStart,
+				CurrentPeriodStart: monthEndStart, CurrentPeriodEnd: time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)},
+			wantStart:  time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC),
+			wantEnd:    time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC),
+			wantMonths: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := tt.subscription
+			paidPeriod(&subscription)
+
+			if subscription.Status != domains.SubscriptionStatusActive || subscription.FailedAttempts != 0 || subscription.GraceUntil != nil {
+				t.Fatalf("got status %s, %d failed attempts, grace until %v, want an active subscription in good standing",
+					subscription.Status, subscription.FailedAttempts, subscription.GraceUntil)
+			}
+			if !subscription.CurrentPeriodStart.Equal(tt.wantStart) || !subscription.CurrentPeriodEnd.Equal(tt.wantEnd) {
+				t.Fatalf("got period %s - %s, want %s - %s", subscription.CurrentPeriodStart, subscription.CurrentPeriodEnd, tt.wantStart, tt.wantEnd)
+			}
+			if !subscription.NextAttemptAt.Equal(tt.wantEnd) {
+				t.Fatalf("got next attempt at %s, want %s", subscription.NextAttemptAt, tt.wantEnd)
+			}
+			if subscription.Months != tt.wantMonths {
+				t.Fatalf("got %d months, want %d", subscription.Months, tt.wantMonths)
+			}
+		})
+	}
+}
+
+func TestPeriodEnd(t *testing.T) {
+	anchor := time.Date(2026, 1, 31, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		anchor time.Time
+		months int
+		want   time.Time
+	}{
+		{name: "clamped to the end of february", anchor: anchor, months: 1, want: time.Date(2026, 2, 28, 12, 30, 0, 0, time.UTC)},
+		{name: "back to the anchor day after a short month", anchor: anchor, months: 2, want: time.Date(2026, 3, 31, 12, 30, 0, 0, time.UTC)},
+		{name: "clamped to a 30 day month", anchor: anchor, months: 3, want: time.Date(2026, 4, 30, 12, 30, 0, 0, time.UTC)},
+		{name: "next year", anchor: anchor, months: 13, want: time.Date(2027, 2, 28, 12, 30, 0, 0, time.UTC)},
+		{name: "leap year", anchor: time.Date(2028, 1, 31, 0, 0, 0, 0, time.UTC), months: 1, want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "day every month has", anchor: time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC), months: 1, want: time.Date(2026, 2, 10, 8, 0, 0, 0, time.UTC)},
+		{name: "anchor in another zone is taken in utc", anchor: time.Date(2026, 2, 1, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), months: 1,
+			want: time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := periodEnd(tt.anchor, tt.months); !got.Equal(tt.want) {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailRenewal(t *testing.T) {
+	end := time.Date(2026, 2, 10, 12, 0, 0, 0, time.UTC)
+	grace := 72 * time.Hour
+	retry := 24 * time.Hour
+
+	tests := []struct {
+		name            string
+		failedAttempts  int
+		now             time.Time
+		wantStatus      domains.SubscriptionStatus
+		wantNextAttempt time.Time
+	}{
+		{name: "first failure retries later", now: end, wantStatus: domains.SubscriptionStatusPastDue, wantNextAttempt: end.Add(retry)},
+		{name: "retry is capped at the end of the grace period", failedAttempts: 2, now: end.Add(60 * time.Hour), wantStatus: domains.SubscriptionStatusPastDue, wantNextAttempt: end.Add(grace)},
+		{name: "failure at the end of the grace period expires", failedAttempts: 3, now: end.Add(grace), wantStatus: domains.SubscriptionStatusExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := domains.ChannelSubscription{Status: domains.SubscriptionStatusActive, CurrentPeriodEnd: end, FailedAttempts: tt.failedAttempts}
+			failRenewal(&subscription, tt.now, grace, retry)
+
+			if subscription.Status != tt.wantStatus {
+				t.Fatalf("got status %s, want %s", subscription.Status, tt.wantStatus)
+			}
+			if subscription.FailedAttempts != tt.failedAttempts+1 {
+				t.Fatalf("got %d failed attempts, want %d", subscription.FailedAttempts, tt.failedAttempts+1)
+			}
+			if subscription.GraceUntil == nil || !subscription.GraceUntil.Equal(end.Add(grace)) {
+				t.Fatalf("got grace until %v, want %s", subscription.GraceUntil, end.Add(grace))
+			}
+			if tt.wantStatus == domains.SubscriptionStatusPastDue && !subscription.NextAttemptAt.Equal(tt.wantNextAttempt) {
+				t.Fatalf("got next attempt at %s, want %s", subscription.NextAttemptAt, tt.wantNextAttempt)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package subscriptionservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateTier adds a monthly tier to the creator's channel
+func (s *SubscriptionService) CreateTier(ctx context.Context, channelId uuid.UUID, req dto.CreateSubscriptionTierRequestDTO) (*dto.SubscriptionTierResponse, *response.Response[any]) {
+	currency, errResp := s.currencyRepo.GetByCode(ctx, req.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	price, err := dto.ParseAmount(req.Price, currency.Precision)
+	if err != nil || price <= 0 {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_AMOUNT, nil, nil, nil)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if len(name) == 0 {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	existing, errResp := s.tierRepo.ListActiveByChannel(ctx, channelId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if len(existing) >= s.config.MaxTiersPerChannel {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_SUBSCRIPTION_TIER_LIMIT, nil, nil, nil)
+	}
+
+	tier, errResp := s.tierRepo.Create(ctx, domains.SubscriptionTier{
+		ChannelId:    channelId,
+		Name:         name,
+		Description:  req.Description,
+		Price:        price,
+		CurrencyCode: currency.Code,
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := dto.NewSubscriptionTierResponse(*tier, currency.Precision)
+	return &out, nil
+}
+
+// UpdateTier changes a tier of the creator's channel, the subscribers are charged a new price from their next renewal
+func (s *SubscriptionService) UpdateTier(ctx context.Context, channelId uuid.UUID, tierId uuid.UUID, req dto.UpdateSubscriptionTierRequestDTO) (*dto.SubscriptionTierResponse, *response.Response[any]) {
+	tier, errResp := s.getOwnTier(ctx, channelId, tierId)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	currency, errResp := s.currencyRepo.GetByCode(ctx, tier.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if len(name) == 0 {
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+		}
+		tier.Name = name
+	}
+	if req.Description != nil {
+		tier.Description = req.Description
+	}
+	if req.Price != nil {
+		price, err := dto.ParseAmount(*req.Price, currency.Precision)
+		if err != nil || price <= 0 {
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_AMOUNT, nil, nil, nil)
+		}
+		tier.Price = price
+	}
+
+	updated, errResp := s.tierRepo.Update(ctx, *tier)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := dto.NewSubscriptionTierResponse(*updated, currency.Precision)
+	return &out, nil
+}
+
+// DeactivateTier stops a tier from taking subscribers, its subscriptions end with their current period
+func (s *SubscriptionService) DeactivateTier(ctx context.Context, channelId uuid.UUID, tierId uuid.UUID) *response.Response[any] {
+	tier, errResp := s.getOwnTier(ctx, channelId, tierId)
+	if errResp != nil {
+		return errResp
+	}
+
+	tier.IsActive = false
+	_, errResp = s.tierRepo.Update(ctx, *tier)
+	return errResp
+}
+
+// ListChannelTiers returns the tiers a channel can be subscribed with, the cheapest first
+func (s *SubscriptionService) ListChannelTiers(ctx context.Context, channelId uuid.UUID) ([]dto.SubscriptionTierResponse, *response.Response[any]) {
+	tiers, errResp := s.tierRepo.ListActiveByChannel(ctx, channelId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	precisions, errResp := s.precisionByCode(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := make([]dto.SubscriptionTierResponse, 0, len(tiers))
+	for _, tier := range tiers {
+		out = append(out, dto.NewSubscriptionTierResponse(tier, precisions[tier.CurrencyCode]))
+	}
+	return out, nil
+}
+
+// getOwnTier returns an active tier of the channel, tiers of other channels are reported as not found
+func (s *SubscriptionService) getOwnTier(ctx context.Context, channelId uuid.UUID, tierId uuid.UUID) (*domains.SubscriptionTier, *response.Response[any]) {
+	tier, errResp := s.tierRepo.GetById(ctx, tierId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if tier.ChannelId != channelId || !tier.IsActive {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_SUBSCRIPTION_TIER_NOT_FOUND, nil, nil, nil)
+	}
+	return tier, nil
+}
@@ -0,0 +1,176 @@
+package tipservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type tipMetadata struct {
+	RecipientId    uuid.UUID  `json:"recipientId"`
+	FeeRuleId      *uuid.UUID `json:"feeRuleId,omitempty"`
+	FeeRuleVersion int        `json:"feeRuleVersion,omitempty"`
+}
+
+// SendTip moves the amount from the sender's wallet to the recipient's wallet in one zero-sum donate
+// transaction, the platform fee of the fee rule in force going to the fee account
+func (s *TipService) SendTip(ctx context.Context, senderId uuid.UUID, req dto.SendTipRequestDTO) (*dto.TipResponse, *response.Response[any]) {
+	if req.RecipientId == senderId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	currency, errResp := s.currencyRepo.GetByCode(ctx, req.CurrencyCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+	amount, err := dto.ParseAmount(req.Amount, currency.Precision)
+	if err != nil || amount <= 0 {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_AMOUNT, nil, nil, nil)
+	}
+
+	var message *string
+	if req.Message != nil {
+		if trimmed := strings.TrimSpace(*req.Message); len(trimmed) > 0 {
+			message = &trimmed
+		}
+	}
+
+	if errResp := s.checkRecipient(ctx, senderId, req.RecipientId); errResp != nil {
+		return nil, errResp
+	}
+
+	rule, errResp := s.feeRuleRepo.GetInForce(ctx, domains.TransactionTypeDonate, currency.Code)
+	if errResp != nil {
+		return nil, errResp
+	}
+	creatorAmount, feeAmount := rule.Split(amount)
+	if creatorAmount <= 0 {
+		// the fee would take the whole tip
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_AMOUNT, nil, nil, nil)
+	}
+
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, senderId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if wallet.Status == domains.AccountStatusFrozen {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_ACCOUNT_FROZEN, nil, nil, nil)
+	}
+	creatorWallet, errResp := s.getOrCreateWallet(ctx, req.RecipientId)
+	if errResp != nil {
+		return nil, errResp
+	}
+	feeAccount, errResp := s.accountRepo.GetFee(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	metadata := tipMetadata{RecipientId: req.RecipientId}
+	var feeRuleId *uuid.UUID
+	if rule != nil {
+		feeRuleId = &rule.Id
+		metadata.FeeRuleId = feeRuleId
+		metadata.FeeRuleVersion = rule.Version
+	}
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		logger.Errorf(ctx, "metadata encoding failed [sendtip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+	metadataStr := string(metadataBytes)
+
+	reference := fmt.Sprintf("tip-%s", uuid.Must(uuid.NewV4()).String())
+	tx, errResp := s.transactionRepo.Create(ctx, domains.Transaction{
+		Type:      domains.TransactionTypeDonate,
+		Reference: &reference,
+		Status:    domains.ProcessStatusCreated,
+		ActorId:   &senderId,
+		Metadata:  &metadataStr,
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// the tip is recorded first so a completed transaction always has one, it is only listed once completed
+	tip, errResp := s.tipRepo.Create(ctx, domains.Tip{
+		TransactionId: tx.Id,
+		SenderId:      senderId,
+		RecipientId:   req.RecipientId,
+		CurrencyCode:  currency.Code,
+		Amount:        amount,
+		CreatorAmount: creatorAmount,
+		FeeAmount:     feeAmount,
+		FeeRuleId:     feeRuleId,
+		Message:       message,
+	})
+	if errResp != nil {
+		s.failTransaction(ctx, tx.Id)
+		return nil, errResp
+	}
+
+	entries := []domains.LedgerEntryDraft{
+		{AccountId: wallet.Id, CurrencyCode: currency.Code, Amount: -amount},
+		{AccountId: creatorWallet.Id, CurrencyCode: currency.Code, Amount: creatorAmount},
+	}
+	if feeAmount > 0 {
+		entries = append(entries, domains.LedgerEntryDraft{AccountId: feeAccount.Id, CurrencyCode: currency.Code, Amount: feeAmount})
+	}
+	if completeErr := s.transactionRepo.CompleteWithEntries(ctx, tx.Id, entries); completeErr != nil {
+		s.failTransaction(ctx, tx.Id)
+		return nil, completeErr
+	}
+
+	s.notifier.NotifyTipSent(ctx, *tip, currency.Precision)
+
+	out := dto.NewTipResponse(*tip, currency.Precision)
+	return &out, nil
+}
+
+// checkRecipient rejects tips to users that do not exist and between users where one blocked the other,
+// the tip is rejected as well when the user service cannot tell
+func (s *TipService) checkRecipient(ctx context.Context, senderId uuid.UUID, recipientId uuid.UUID) *response.Response[any] {
+	recipient, err := s.userGateway.GetUserPublicInfo(ctx, recipientId.String())
+	if err != nil {
+		logger.Errorf(ctx, "failed to get tip recipient %s [sendtip: %v]", recipientId, err)
+		return response.NewResponseFromTemplate[any](response.RES_ERR_USER_SERVICE_ERROR, nil, nil, nil)
+	}
+	if recipient == nil {
+		return response.NewResponseFromTemplate[any](response.RES_ERR_TIP_RECIPIENT_NOT_FOUND, nil, nil, nil)
+	}
+
+	for _, pair := range [][2]uuid.UUID{{recipientId, senderId}, {senderId, recipientId}} {
+		blocked, err := s.userGateway.IsBlocked(ctx, pair[0].String(), pair[1].String())
+		if err != nil {
+			logger.Errorf(ctx, "failed to check the blocks between %s and %s [sendtip: %v]", senderId, recipientId, err)
+			return response.NewResponseFromTemplate[any](response.RES_ERR_USER_SERVICE_ERROR, nil, nil, nil)
+		}
+		if blocked {
+			return response.NewResponseFromTemplate[any](response.RES_ERR_TIP_BLOCKED, nil, nil, nil)
+		}
+	}
+	return nil
+}
+
+func (s *TipService) failTransaction(ctx context.Context, transactionId uuid.UUID) {
+	if updateErr := s.transactionRepo.UpdateStatus(ctx, transactionId, domains.ProcessStatusFailed); updateErr != nil {
+		logger.Errorf(ctx, "failed to mark tip transaction %s as failed [sendtip]", transactionId)
+	}
+}
+
+func (s *TipService) getOrCreateWallet(ctx context.Context, ownerId uuid.UUID) (*domains.Account, *response.Response[any]) {
+	wallet, errResp := s.accountRepo.GetUserWalletByOwnerId(ctx, ownerId)
+	if errResp != nil {
+		if errResp.Code != response.RES_ERR_ACCOUNT_NOT_FOUND_CODE {
+			return nil, errResp
+		}
+		return s.accountRepo.CreateUserWallet(ctx, ownerId)
+	}
+	return wallet, nil
+}
@@ -0,0 +1,76 @@
+package tipservice
+
+import (
+	"context"
+	"sen1or/letslive/finance/domains"
+	"sen1or/letslive/finance/dto"
+	"sen1or/letslive/finance/gateway/userservice"
+	"sen1or/letslive/finance/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type TipService struct {
+	accountRepo     domains.AccountRepository
+	currencyRepo    domains.CurrencyRepository
+	transactionRepo domains.TransactionRepository
+	feeRuleRepo     domains.FeeRuleRepository
+	tipRepo         domains.TipRepository
+	userGateway     userservice.UserServiceGateway
+	notifier        domains.TipNotifier
+}
+
+func NewTipService(
+	accountRepo domains.AccountRepository,
+	currencyRepo domains.CurrencyRepository,
+	transactionRepo domains.TransactionRepository,
+	feeRuleRepo domains.FeeRuleRepository,
+	tipRepo domains.TipRepository,
+	userGateway userservice.UserServiceGateway,
+	notifier domains.TipNotifier,
+) *TipService {
+	return &TipService{
+		accountRepo:     accountRepo,
+		currencyRepo:    currencyRepo,
+		transactionRepo: transactionRepo,
+		feeRuleRepo:     feeRuleRepo,
+		tipRepo:         tipRepo,
+		userGateway:     userGateway,
+		notifier:        notifier,
+	}
+}
+
+func (s *TipService) ListReceived(ctx context.Context, recipientId uuid.UUID, page int, limit int) ([]dto.TipResponse, int, *response.Response[any]) {
+	tips, total, errResp := s.tipRepo.ListByRecipient(ctx, recipientId, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	responses, errResp := s.toResponses(ctx, tips)
+	return responses, total, errResp
+}
+
+func (s *TipService) ListSent(ctx context.Context, senderId uuid.UUID, page int, limit int) ([]dto.TipResponse, int, *response.Response[any]) {
+	tips, total, errResp := s.tipRepo.ListBySender(ctx, senderId, page, limit)
+	if errResp != nil {
+		return nil, 0, errResp
+	}
+	responses, errResp := s.toResponses(ctx, tips)
+	return responses, total, errResp
+}
+
+func (s *TipService) toResponses(ctx context.Context, tips []domains.Tip) ([]dto.TipResponse, *response.Response[any]) {
+	currencies, errResp := s.currencyRepo.List(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+	precisions := make(map[string]int, len(currencies))
+	for _, c := range currencies {
+		precisions[c.Code] = c.Precision
+	}
+
+	responses := make([]dto.TipResponse, len(tips))
+	for i, t := range tips {
+		responses[i] = dto.NewTipResponse(t, precisions[t.CurrencyCode])
+	}
+	return responses, nil
+}
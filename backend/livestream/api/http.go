@@ -45,7 +45,13 @@ func (a *APIServer) getHandler() http.Handler {
 
 	wrap("GET /v1/popular-livestreams", a.livestreamHandler.GetRecommendedLivestreamsPublicHandler)
 	wrap("GET /v1/livestreams", a.livestreamHandler.GetLivestreamOfUserPublicHandler)
+	wrap("GET /v1/categories", a.livestreamHandler.GetCategoriesPublicHandler)
+	wrap("PATCH /v1/livestreams/{livestreamId}", a.livestreamHandler.UpdateLivestreamPrivateHandler)
+	wrap("POST /v1/livestreams/{livestreamId}/heartbeat", a.livestreamHandler.RecordViewerHeartbeatPublicHandler)
 
+	wrap("GET /v1/internal/livestreams/search", a.livestreamHandler.SearchLivestreamsInternalHandler)
+	wrap("POST /v1/internal/livestreams/live", a.livestreamHandler.GetLiveLivestreamsOfUsersInternalHandler)
+	wrap("GET /v1/internal/livestreams/{livestreamId}", a.livestreamHandler.GetLivestreamByIdInternalHandler)
 	wrap("POST /v1/internal/livestreams/{livestreamId}/end", a.livestreamHandler.EndLivestreamAndCreateVODInternalHandler)
 	wrap("POST /v1/internal/livestreams", a.livestreamHandler.CreateLivestreamInternalHandler)
 
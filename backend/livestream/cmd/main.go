@@ -10,13 +10,18 @@ import (
 
 	"sen1or/letslive/livestream/api"
 	cfg "sen1or/letslive/livestream/config"
+	usergatewayhttp "sen1or/letslive/livestream/gateway/user/http"
 	vodgatewayhttp "sen1or/letslive/livestream/gateway/vod/http"
 	livestreamHandler "sen1or/letslive/livestream/handlers/livestream"
+	"sen1or/letslive/livestream/publisher"
 	"sen1or/letslive/livestream/repositories"
 	livestreamService "sen1or/letslive/livestream/services/livestream"
 
 	sharedconfig "sen1or/letslive/shared/config"
 	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/eventbus/natsbus"
 	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/shared/pkg/tracer"
 	sharedutils "sen1or/letslive/shared/utils"
@@ -64,7 +69,13 @@ func main() {
 	dbConn := sharedutils.ConnectDB(ctx, config.Database.ConnectionString)
 	defer dbConn.Close()
 
-	server := SetupServer(ctx, dbConn, registry, config)
+	producer := setupEventProducer(ctx, config.EventBus)
+	if producer != nil {
+		defer producer.Close()
+	}
+
+	server, livestreamSvc := SetupServer(ctx, dbConn, registry, producer, config)
+	go livestreamSvc.RunViewerStats(ctx)
 	go func() {
 		logger.Infof(ctx, "starting server on %s:%d...", config.Service.Hostname, config.Service.APIPort)
 		// ListenAndServe should ideally block until an error occurs (e.g., server stopped)
@@ -108,13 +119,45 @@ func main() {
 	logger.Infof(shutdownCtx, "service shut down complete.")
 }
 
-func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) *api.APIServer {
+// setupEventProducer returns nil when no event bus is configured or reachable, the service then runs without publishing
+func setupEventProducer(ctx context.Context, cfg cfg.EventBus) eventbus.Producer {
+	if cfg.URL == "" {
+		logger.Warnf(ctx, "eventBus.url is not set, events will not be published")
+		return nil
+	}
+
+	admin, err := natsbus.NewAdmin(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to connect to the event bus, events will not be published: %v", err)
+		return nil
+	}
+	defer admin.Close()
+
+	if err := admin.EnsureTopics(ctx, events.DefaultTopics()); err != nil {
+		logger.Errorf(ctx, "failed to ensure event bus topics: %v", err)
+	}
+
+	producer, err := natsbus.NewProducer(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the event producer, events will not be published: %v", err)
+		return nil
+	}
+
+	return producer
+}
+
+func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, producer eventbus.Producer, cfg *cfg.Config) (*api.APIServer, *livestreamService.LivestreamService) {
 	var livestreamRepo = repositories.NewLivestreamRepository(dbConn)
+	var metadataChangeRepo = repositories.NewLivestreamMetadataChangeRepository(dbConn)
+	var categoryRepo = repositories.NewCategoryRepository(dbConn)
+	var viewerRepo = repositories.NewLivestreamViewerRepository(dbConn)
 
 	var vodGateway = vodgatewayhttp.NewVODGateway(registry)
+	var userGateway = usergatewayhttp.NewUserGateway(registry)
+	var eventPublisher = publisher.NewEventPublisher(producer)
 
-	var livestreamService = livestreamService.NewLivestreamService(livestreamRepo, vodGateway)
+	var livestreamService = livestreamService.NewLivestreamService(livestreamRepo, metadataChangeRepo, categoryRepo, viewerRepo, vodGateway, userGateway, eventPublisher)
 
 	var livestreamHandler = livestreamHandler.NewLivestreamHandler(livestreamService)
-	return api.NewAPIServer(livestreamHandler, cfg, dbConn)
+	return api.NewAPIServer(livestreamHandler, cfg, dbConn), livestreamService
 }
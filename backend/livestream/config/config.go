@@ -32,10 +32,16 @@ type Tracer struct {
 	BatchTimeout int    `yaml:"batchTimeout"` /// in milli-second
 }
 
+// EventBus is the NATS server the events of the service are published to, nothing is published when URL is empty
+type EventBus struct {
+	URL string `yaml:"url"`
+}
+
 type Config struct {
 	Service  `yaml:"service"`
 	Database `yaml:"database"`
 	Tracer   `yaml:"tracer"`
+	EventBus `yaml:"eventBus"`
 }
 
 // TracerConfig interface implementation
@@ -0,0 +1,25 @@
+package domains
+
+import (
+	"context"
+	"sen1or/letslive/livestream/response"
+)
+
+// Category groups the livestreams by game or topic, the vods keep the category of their livestream
+type Category struct {
+	Slug string `json:"slug" db:"slug"`
+	Name string `json:"name" db:"name"`
+}
+
+// CategoryWithLiveStats is a category with its public livestreams that are live
+type CategoryWithLiveStats struct {
+	Category
+	LiveCount   int64 `json:"liveCount" db:"live_count"`
+	ViewerCount int64 `json:"viewerCount" db:"viewer_count"`
+}
+
+type CategoryRepository interface {
+	GetBySlug(ctx context.Context, slug string) (*Category, *response.Response[any])
+	// GetAllWithLiveStats returns every category, the ones with the most viewers first
+	GetAllWithLiveStats(ctx context.Context) ([]CategoryWithLiveStats, *response.Response[any])
+}
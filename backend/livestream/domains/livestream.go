@@ -3,6 +3,7 @@ package domains
 import (
 	"context"
 	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/search"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -16,24 +17,45 @@ const (
 )
 
 type Livestream struct {
-	Id           uuid.UUID            `json:"id" db:"id"`
-	UserId       uuid.UUID            `json:"userId" db:"user_id"`
-	Title        string               `json:"title" db:"title"`
-	Description  *string              `json:"description" db:"description"`
-	ThumbnailURL *string              `json:"thumbnailUrl" db:"thumbnail_url"`
-	ViewCount    int                  `json:"viewCount" db:"view_count"`
-	Visibility   LivestreamVisibility `json:"visibility" db:"visibility"`
-	StartedAt    time.Time            `json:"startedAt" db:"started_at"`
-	EndedAt      *time.Time           `json:"endedAt" db:"ended_at"`
-	CreatedAt    time.Time            `json:"createdAt" db:"created_at"`
-	UpdatedAt    time.Time            `json:"updatedAt" db:"updated_at"`
-	VODId        *uuid.UUID           `json:"vodId" db:"vod_id"`
+	Id              uuid.UUID            `json:"id" db:"id"`
+	UserId          uuid.UUID            `json:"userId" db:"user_id"`
+	Title           string               `json:"title" db:"title"`
+	Description     *string              `json:"description" db:"description"`
+	ThumbnailURL    *string              `json:"thumbnailUrl" db:"thumbnail_url"`
+	ViewCount       int                  `json:"viewCount" db:"view_count"`     // sessions that watched the livestream
+	ViewerCount     int                  `json:"viewerCount" db:"viewer_count"` // sessions watching it right now
+	PeakViewerCount int                  `json:"peakViewerCount" db:"peak_viewer_count"`
+	ViewerSeconds   int64                `json:"-" db:"viewer_seconds"`
+	Visibility      LivestreamVisibility `json:"visibility" db:"visibility"`
+	Category        *string              `json:"category" db:"category"` // slug of the category
+	Tags            []string             `json:"tags" db:"tags"`
+	StartedAt       time.Time            `json:"startedAt" db:"started_at"`
+	EndedAt         *time.Time           `json:"endedAt" db:"ended_at"`
+	CreatedAt       time.Time            `json:"createdAt" db:"created_at"`
+	UpdatedAt       time.Time            `json:"updatedAt" db:"updated_at"`
+	VODId           *uuid.UUID           `json:"vodId" db:"vod_id"`
+}
+
+// LivestreamFilter narrows a listing of livestreams, a nil field does not filter
+type LivestreamFilter struct {
+	Category *string
+	Tag      *string
+}
+
+// LivestreamNotifier tells the other services about the changes of the livestreams
+type LivestreamNotifier interface {
+	NotifyUpdated(ctx context.Context, previous Livestream, updated Livestream)
 }
 
 type LivestreamRepository interface {
 	GetById(ctx context.Context, id uuid.UUID) (*Livestream, *response.Response[any])
 	GetByUser(ctx context.Context, userId uuid.UUID) (*Livestream, *response.Response[any])
-	GetRecommendedLivestreams(ctx context.Context, page int, limit int) ([]Livestream, *response.Response[any])
+	// GetLiveByUsers returns the public livestreams on air of the users, the latest started first
+	GetLiveByUsers(ctx context.Context, userIds []uuid.UUID) ([]Livestream, *response.Response[any])
+	// GetRecommendationCandidates returns up to limit of the public livestreams on air, the most watched first
+	GetRecommendationCandidates(ctx context.Context, filter LivestreamFilter, limit int) ([]Livestream, *response.Response[any])
+	// Search returns the public livestreams on air whose title or description match the query, the best match first
+	Search(ctx context.Context, query string, language search.Language, page int, limit int) ([]Livestream, *response.Response[any])
 	Create(ctx context.Context, ls Livestream) (*Livestream, *response.Response[any])
 	Update(ctx context.Context, ls Livestream) (*Livestream, *response.Response[any])
 	Delete(ctx context.Context, id uuid.UUID) *response.Response[any]
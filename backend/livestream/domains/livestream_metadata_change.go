@@ -0,0 +1,30 @@
+package domains
+
+import (
+	"context"
+	"sen1or/letslive/livestream/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// LivestreamMetadataChange is a change of the title or the category of the livestream while it was live,
+// both are kept as they were before and after even when only one of them changed
+type LivestreamMetadataChange struct {
+	LivestreamId     uuid.UUID `json:"livestreamId" db:"livestream_id"`
+	PreviousTitle    string    `json:"previousTitle" db:"previous_title"`
+	Title            string    `json:"title" db:"title"`
+	PreviousCategory *string   `json:"previousCategory" db:"previous_category"`
+	Category         *string   `json:"category" db:"category"`
+	ChangedAt        time.Time `json:"changedAt" db:"changed_at"`
+}
+
+func (c LivestreamMetadataChange) TitleChanged() bool {
+	return c.Title != c.PreviousTitle
+}
+
+type LivestreamMetadataChangeRepository interface {
+	Create(ctx context.Context, change LivestreamMetadataChange) *response.Response[any]
+	// GetByLivestreamId returns the changes of the livestream in the order they were made
+	GetByLivestreamId(ctx context.Context, livestreamId uuid.UUID) ([]LivestreamMetadataChange, *response.Response[any])
+}
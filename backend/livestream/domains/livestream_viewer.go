@@ -0,0 +1,33 @@
+package domains
+
+import (
+	"context"
+	"sen1or/letslive/livestream/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// ViewerHeartbeatInterval is how often a player is asked to send a heartbeat
+	ViewerHeartbeatInterval = 15 * time.Second
+	// ViewerSessionWindow is how long a session is counted after its last heartbeat, it lets a player miss one
+	ViewerSessionWindow = 40 * time.Second
+	// ViewerStatsInterval is how often the viewer sessions are folded into the stats of the live livestreams
+	ViewerStatsInterval = 15 * time.Second
+	// MaxViewerSessionsPerClient caps the live sessions of a livestream from one client address, the session ids
+	// are made up by the players and would otherwise let one client count as any number of viewers
+	MaxViewerSessionsPerClient = 10
+)
+
+type LivestreamViewerRepository interface {
+	// RecordHeartbeat marks the session as watching the live livestream, the time since its previous heartbeat
+	// is added to the session. It returns false when nothing was recorded, because the livestream is not live
+	// or the client address already has MaxViewerSessionsPerClient live sessions on it
+	RecordHeartbeat(ctx context.Context, livestreamId uuid.UUID, sessionId string, clientIp string) (bool, *response.Response[any])
+	// AggregateLiveStats folds the sessions of every live livestream into its view count, peak and viewer seconds
+	AggregateLiveStats(ctx context.Context) *response.Response[any]
+	// AggregateStats does the same for one livestream, it is used once the livestream ended
+	AggregateStats(ctx context.Context, livestreamId uuid.UUID) *response.Response[any]
+	DeleteByLivestreamId(ctx context.Context, livestreamId uuid.UUID) *response.Response[any]
+}
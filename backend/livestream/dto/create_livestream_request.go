@@ -12,5 +12,6 @@ type CreateLivestreamRequestDTO struct {
 	Description  *string                       `json:"description,omitempty" validate:"omitempty,lte=1000"`
 	ThumbnailURL *string                       `json:"thumbnailUrl,omitempty" validate:"omitempty,url,lte=2048"`
 	Visibility   *domains.LivestreamVisibility `json:"visibility,omitempty" validate:"required,oneof=public private"`
+	Category     *string                       `json:"category,omitempty"`
+	Tags         []string                      `json:"tags,omitempty"`
 }
-
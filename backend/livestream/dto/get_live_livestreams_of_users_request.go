@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/gofrs/uuid/v5"
+
+// GetLiveLivestreamsOfUsersRequestDTO looks up which of the users are live in one request, callers with more users
+// send them in batches
+type GetLiveLivestreamsOfUsersRequestDTO struct {
+	UserIds []uuid.UUID `json:"userIds" validate:"max=1000"`
+}
@@ -5,4 +5,7 @@ type UpdateLivestreamRequestDTO struct {
 	Description  *string `json:"description,omitempty" validate:"omitempty,lte=500"`
 	ThumbnailURL *string `json:"thumbnailUrl,omitempty" validate:"omitempty,url,lte=2048"`
 	Visibility   *string `json:"visibility,omitempty" validate:"omitempty,oneof=public private"`
+	// slug of the category, an empty one removes it
+	Category *string   `json:"category,omitempty" validate:"omitempty,lte=64"`
+	Tags     *[]string `json:"tags,omitempty"`
 }
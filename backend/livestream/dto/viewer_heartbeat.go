@@ -0,0 +1,13 @@
+package dto
+
+// ViewerHeartbeatRequestDTO is sent by a player while it plays a livestream, the session id is generated by the
+// player and kept for as long as it stays on the livestream
+type ViewerHeartbeatRequestDTO struct {
+	SessionId string `json:"sessionId" validate:"required,uuid"`
+}
+
+type ViewerHeartbeatResponseDTO struct {
+	ViewerCount int `json:"viewerCount"`
+	// when the player should send its next heartbeat
+	HeartbeatIntervalSeconds int `json:"heartbeatIntervalSeconds"`
+}
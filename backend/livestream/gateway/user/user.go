@@ -12,6 +12,13 @@ type UserPublicInfo struct {
 	ProfilePicture *string   `json:"profilePicture,omitempty"`
 }
 
+// ViewerAffinity is what the user service knows about the taste of a viewer
+type ViewerAffinity struct {
+	FollowedUserIds []uuid.UUID        `json:"followedUserIds"`
+	Categories      map[string]float64 `json:"categories"`
+}
+
 type UserGateway interface {
 	GetUserPublicInfo(ctx context.Context, userId uuid.UUID) (*UserPublicInfo, error)
+	GetViewerAffinity(ctx context.Context, userId uuid.UUID) (*ViewerAffinity, error)
 }
@@ -7,13 +7,25 @@ import (
 )
 
 type CreateVODRequest struct {
-	LivestreamId string `json:"livestreamId"`
-	UserId       string `json:"userId"`
-	Title        string `json:"title"`
-	Description  string `json:"description,omitempty"`
-	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
-	PlaybackURL  string `json:"playbackUrl,omitempty"`
-	Duration     int64  `json:"duration"`
+	LivestreamId string   `json:"livestreamId"`
+	UserId       string   `json:"userId"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description,omitempty"`
+	ThumbnailURL string   `json:"thumbnailUrl,omitempty"`
+	PlaybackURL  string   `json:"playbackUrl,omitempty"`
+	Duration     int64    `json:"duration"`
+	Category     string   `json:"category,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	// viewers of the livestream, the average is over its whole length
+	PeakViewerCount    int `json:"peakViewerCount"`
+	AverageViewerCount int `json:"averageViewerCount"`
+	// the titles the livestream went through, the vod service fits them to the recorded duration
+	Chapters []Chapter `json:"chapters,omitempty"`
+}
+
+type Chapter struct {
+	StartSeconds float64 `json:"startSeconds"`
+	Title        string  `json:"title"`
 }
 
 type VODGateway interface {
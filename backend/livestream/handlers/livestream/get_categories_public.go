@@ -0,0 +1,29 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	response "sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *LivestreamHandler) GetCategoriesPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_categories_public_handler.livestream_service.get_categories")
+	categories, serviceErr := h.livestreamService.GetCategories(ctx)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(
+		response.RES_SUCC_OK,
+		&categories,
+		nil,
+		nil,
+	))
+}
@@ -0,0 +1,33 @@
+package livestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/livestream/dto"
+	response "sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *LivestreamHandler) GetLiveLivestreamsOfUsersInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+	defer r.Body.Close()
+
+	var requestBody dto.GetLiveLivestreamsOfUsersRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_live_livestreams_of_users_internal_handler.livestream_service.get_live_livestreams_of_users")
+	livestreams, serviceErr := h.livestreamService.GetLiveLivestreamsOfUsers(ctx, requestBody)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &livestreams, nil, nil))
+}
@@ -0,0 +1,33 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	response "sen1or/letslive/livestream/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetLivestreamByIdInternalHandler returns the livestream whatever its visibility, the caller decides what to expose
+func (h *LivestreamHandler) GetLivestreamByIdInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	livestreamId, err := uuid.FromString(r.PathValue("livestreamId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_livestream_by_id_internal_handler.livestream_service.get_livestream_by_id")
+	livestream, serviceErr := h.livestreamService.GetLivestreamById(ctx, livestreamId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, livestream, nil, nil))
+}
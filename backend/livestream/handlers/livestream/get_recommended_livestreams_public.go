@@ -3,19 +3,51 @@ package livestream
 import (
 	"context"
 	"net/http"
+	"sen1or/letslive/livestream/domains"
 	"sen1or/letslive/livestream/handlers/utils"
-	"sen1or/letslive/shared/pkg/tracer"
 	response "sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/ranking"
+	"sen1or/letslive/shared/pkg/tags"
+	"sen1or/letslive/shared/pkg/tracer"
 )
 
 func (h *LivestreamHandler) GetRecommendedLivestreamsPublicHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancelCtx := context.WithCancel(r.Context())
 	defer cancelCtx()
 
-	page, limit := utils.GetPageAndLimitQuery(r)
+	_, limit := utils.GetPageAndLimitQuery(r)
+
+	var cursor *ranking.Cursor
+	if encoded := r.URL.Query().Get("cursor"); len(encoded) > 0 {
+		decoded, err := ranking.DecodeCursor(encoded)
+		if err != nil {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		cursor = decoded
+	}
+
+	var filter domains.LivestreamFilter
+	if category := r.URL.Query().Get("category"); len(category) > 0 {
+		if !tags.ValidSlug(category) {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		filter.Category = &category
+	}
+	if tag := r.URL.Query().Get("tag"); len(tag) > 0 {
+		normalized, ok := tags.Normalize([]string{tag})
+		if !ok {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		filter.Tag = &normalized[0]
+	}
+
+	viewerId, _ := utils.GetUserIdFromCookie(r)
 
 	ctx, span := tracer.MyTracer.Start(ctx, "get_recommended_livestreams_public_handler.livestream_service.get_recommended_livestreams")
-	livestreams, serviceErr := h.livestreamService.GetRecommendedLivestreams(ctx, page, limit)
+	livestreams, next, serviceErr := h.livestreamService.GetRecommendedLivestreams(ctx, filter, viewerId, cursor, limit)
 	span.End()
 
 	if serviceErr != nil {
@@ -23,10 +55,15 @@ func (h *LivestreamHandler) GetRecommendedLivestreamsPublicHandler(w http.Respon
 		return
 	}
 
+	var meta *response.Meta
+	if next != nil {
+		meta = &response.Meta{NextCursor: next.Encode()}
+	}
+
 	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(
 		response.RES_SUCC_OK,
 		&livestreams,
-		nil,
+		meta,
 		nil,
 	))
 }
@@ -0,0 +1,42 @@
+package livestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/livestream/dto"
+	"sen1or/letslive/livestream/handlers/utils"
+	response "sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/tracer"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *LivestreamHandler) RecordViewerHeartbeatPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	livestreamId, err := uuid.FromString(r.PathValue("livestreamId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+	defer r.Body.Close()
+
+	var requestBody dto.ViewerHeartbeatRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "record_viewer_heartbeat_public_handler.livestream_service.record_viewer_heartbeat")
+	heartbeat, serviceErr := h.livestreamService.RecordViewerHeartbeat(ctx, livestreamId, utils.GetClientIp(r), requestBody)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, heartbeat, nil, nil))
+}
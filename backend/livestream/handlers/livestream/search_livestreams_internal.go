@@ -0,0 +1,27 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/livestream/handlers/utils"
+	response "sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/tracer"
+)
+
+func (h *LivestreamHandler) SearchLivestreamsInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	page, limit := utils.GetPageAndLimitQuery(r)
+
+	ctx, span := tracer.MyTracer.Start(ctx, "search_livestreams_internal_handler.livestream_service.search_livestreams")
+	livestreams, serviceErr := h.livestreamService.SearchLivestreams(ctx, r.URL.Query().Get("q"), r.URL.Query().Get("lang"), page, limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &livestreams, nil, nil))
+}
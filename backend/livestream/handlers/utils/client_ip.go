@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"net"
+	"net/http"
+)
+
+// GetClientIp returns the address of the client. Kong sets X-Real-IP to the address the request came from,
+// overwriting any value sent by the client
+func GetClientIp(r *http.Request) string {
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return ip
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- titles a livestream went through while it was live, they become the chapters of its vod
+CREATE TABLE IF NOT EXISTS livestream_title_changes (
+    livestream_id UUID NOT NULL REFERENCES livestreams(id) ON DELETE CASCADE,
+    previous_title VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_livestream_title_changes_livestream_id ON livestream_title_changes(livestream_id, changed_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_livestream_title_changes_livestream_id;
+DROP TABLE IF EXISTS livestream_title_changes;
+
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the categories a streamer can file a livestream under, the slug is what the other services store
+CREATE TABLE IF NOT EXISTS categories (
+    slug VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO categories (slug, name) VALUES
+    ('just-chatting', 'Just Chatting'),
+    ('gaming', 'Gaming'),
+    ('music', 'Music'),
+    ('art', 'Art'),
+    ('software-development', 'Software & Development'),
+    ('education', 'Education'),
+    ('sports', 'Sports'),
+    ('food-drink', 'Food & Drink'),
+    ('travel-outdoors', 'Travel & Outdoors'),
+    ('podcasts', 'Podcasts')
+ON CONFLICT (slug) DO NOTHING;
+
+ALTER TABLE livestreams ADD COLUMN IF NOT EXISTS category VARCHAR(64) REFERENCES categories(slug) ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE livestreams ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_livestreams_live_category ON livestreams(category) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_livestreams_tags ON livestreams USING GIN (tags);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_livestreams_tags;
+DROP INDEX IF EXISTS idx_livestreams_live_category;
+ALTER TABLE livestreams DROP COLUMN IF EXISTS tags;
+ALTER TABLE livestreams DROP COLUMN IF EXISTS category;
+DROP TABLE IF EXISTS categories;
+
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a player watching a livestream, it stops being counted once it misses its heartbeats
+CREATE TABLE IF NOT EXISTS livestream_viewer_sessions (
+    livestream_id UUID NOT NULL REFERENCES livestreams(id) ON DELETE CASCADE,
+    session_id VARCHAR(64) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (livestream_id, session_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_livestream_viewer_sessions_last_seen_at ON livestream_viewer_sessions(livestream_id, last_seen_at);
+
+-- viewer_seconds adds up the time every session was watched, divided by the length of the stream it is the average viewer count
+ALTER TABLE livestreams ADD COLUMN IF NOT EXISTS peak_viewer_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE livestreams ADD COLUMN IF NOT EXISTS viewer_seconds BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE livestreams DROP COLUMN IF EXISTS viewer_seconds;
+ALTER TABLE livestreams DROP COLUMN IF EXISTS peak_viewer_count;
+DROP INDEX IF EXISTS idx_livestream_viewer_sessions_last_seen_at;
+DROP TABLE IF EXISTS livestream_viewer_sessions;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the title changes become the history of the title and the category of a livestream while it was live
+ALTER TABLE livestream_title_changes RENAME TO livestream_metadata_changes;
+ALTER INDEX IF EXISTS idx_livestream_title_changes_livestream_id RENAME TO idx_livestream_metadata_changes_livestream_id;
+
+ALTER TABLE livestream_metadata_changes ADD COLUMN IF NOT EXISTS previous_category VARCHAR(64);
+ALTER TABLE livestream_metadata_changes ADD COLUMN IF NOT EXISTS category VARCHAR(64);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- the changes of the category alone can not be told apart from a title change without them
+DELETE FROM livestream_metadata_changes WHERE title = previous_title;
+
+ALTER TABLE livestream_metadata_changes DROP COLUMN IF EXISTS category;
+ALTER TABLE livestream_metadata_changes DROP COLUMN IF EXISTS previous_category;
+
+ALTER INDEX IF EXISTS idx_livestream_metadata_changes_livestream_id RENAME TO idx_livestream_title_changes_livestream_id;
+ALTER TABLE livestream_metadata_changes RENAME TO livestream_title_changes;
+
+-- +goose StatementEnd
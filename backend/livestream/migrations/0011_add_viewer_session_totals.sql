@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a heartbeat only writes its own session, the totals are folded into the livestream by the viewer stats job
+-- and when the stream ends. views counts the times the session started watching, watched_seconds how long it did
+ALTER TABLE livestream_viewer_sessions ADD COLUMN IF NOT EXISTS views INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE livestream_viewer_sessions ADD COLUMN IF NOT EXISTS watched_seconds BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE livestream_viewer_sessions DROP COLUMN IF EXISTS watched_seconds;
+ALTER TABLE livestream_viewer_sessions DROP COLUMN IF EXISTS views;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the session ids are made up by the players, so the live sessions of a livestream are capped per client address
+ALTER TABLE livestream_viewer_sessions ADD COLUMN IF NOT EXISTS client_ip VARCHAR(45) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_livestream_viewer_sessions_client_ip ON livestream_viewer_sessions(livestream_id, client_ip, last_seen_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_livestream_viewer_sessions_client_ip;
+ALTER TABLE livestream_viewer_sessions DROP COLUMN IF EXISTS client_ip;
+
+-- +goose StatementEnd
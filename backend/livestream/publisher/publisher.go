@@ -0,0 +1,99 @@
+package publisher
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/logger"
+	"slices"
+)
+
+const eventSource = "livestream"
+
+// EventPublisher publishes the events of the livestream service, a failed publish is only logged
+// since the livestream itself is always the source of truth
+type EventPublisher struct {
+	producer eventbus.Producer
+}
+
+// NewEventPublisher returns a publisher dropping every event when producer is nil,
+// which is the case when no event bus is configured
+func NewEventPublisher(producer eventbus.Producer) *EventPublisher {
+	return &EventPublisher{
+		producer: producer,
+	}
+}
+
+var _ domains.LivestreamNotifier = (*EventPublisher)(nil)
+
+func (p *EventPublisher) NotifyUpdated(ctx context.Context, previous domains.Livestream, updated domains.Livestream) {
+	event := events.LivestreamUpdatedEvent{
+		LivestreamId: updated.Id,
+		UserId:       updated.UserId,
+		UpdatedAt:    updated.UpdatedAt,
+	}
+	changed := false
+	if updated.Title != previous.Title {
+		event.Title = &updated.Title
+		changed = true
+	}
+	if changedOptional(previous.Description, updated.Description) {
+		event.Description = valueOrEmpty(updated.Description)
+		changed = true
+	}
+	if changedOptional(previous.ThumbnailURL, updated.ThumbnailURL) {
+		event.ThumbnailURL = valueOrEmpty(updated.ThumbnailURL)
+		changed = true
+	}
+	if updated.Visibility != previous.Visibility {
+		visibility := string(updated.Visibility)
+		event.Visibility = &visibility
+		changed = true
+	}
+	if changedOptional(previous.Category, updated.Category) {
+		event.Category = valueOrEmpty(updated.Category)
+		changed = true
+	}
+	if !slices.Equal(previous.Tags, updated.Tags) {
+		event.Tags = &updated.Tags
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	p.publish(ctx, events.TopicLivestream, updated.Id.String(), events.LivestreamUpdated, event)
+}
+
+func (p *EventPublisher) publish(ctx context.Context, topic string, key string, eventType string, data any) {
+	if p.producer == nil {
+		return
+	}
+
+	event, err := eventbus.NewEvent(eventType, eventSource, data)
+	if err != nil {
+		logger.Errorf(ctx, "failed to build event %s: %v", eventType, err)
+		return
+	}
+
+	if err := p.producer.Publish(ctx, topic, key, event); err != nil {
+		logger.Warnf(ctx, "failed to publish event %s: %v", eventType, err)
+	}
+}
+
+func changedOptional(previous *string, updated *string) bool {
+	if previous == nil || updated == nil {
+		return previous != updated
+	}
+	return *previous != *updated
+}
+
+// valueOrEmpty turns a removed value into an empty one, a nil field of the event means it did not change
+func valueOrEmpty(value *string) *string {
+	if value == nil {
+		empty := ""
+		return &empty
+	}
+	return value
+}
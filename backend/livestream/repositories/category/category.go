@@ -0,0 +1,17 @@
+package category
+
+import (
+	"sen1or/letslive/livestream/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresCategoryRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewCategoryRepository(conn *pgxpool.Pool) domains.CategoryRepository {
+	return &postgresCategoryRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,44 @@
+package category
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresCategoryRepo) GetAllWithLiveStats(ctx context.Context) ([]domains.CategoryWithLiveStats, *response.Response[any]) {
+	query := `
+		SELECT c.slug, c.name, count(DISTINCT l.id) AS live_count, count(s.session_id) AS viewer_count
+		FROM categories c
+		LEFT JOIN livestreams l ON l.category = c.slug AND l.ended_at IS NULL AND l.visibility = 'public'
+		LEFT JOIN livestream_viewer_sessions s ON s.livestream_id = l.id AND s.last_seen_at > now() - $1::interval
+		GROUP BY c.slug, c.name
+		ORDER BY viewer_count DESC, live_count DESC, c.name
+	`
+	rows, err := r.dbConn.Query(ctx, query, domains.ViewerSessionWindow)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getcategorieswithlivestats: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	categories, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.CategoryWithLiveStats])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getcategorieswithlivestats: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return categories, nil
+}
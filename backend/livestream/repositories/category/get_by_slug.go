@@ -0,0 +1,45 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresCategoryRepo) GetBySlug(ctx context.Context, slug string) (*domains.Category, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `SELECT slug, name FROM categories WHERE slug = $1`, slug)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getcategorybyslug: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	category, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.Category])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_CATEGORY_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getcategorybyslug: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &category, nil
+}
@@ -11,9 +11,9 @@ import (
 
 func (r *postgresLivestreamRepo) Create(ctx context.Context, newLivestream domains.Livestream) (*domains.Livestream, *response.Response[any]) {
 	query := `
-		INSERT INTO livestreams (user_id, title, description, thumbnail_url, visibility)
-        	VALUES ($1, $2, $3, $4, $5)
-        	RETURNING id, user_id, title, description, thumbnail_url, visibility, view_count, started_at, ended_at, created_at, updated_at, vod_id
+		INSERT INTO livestreams (user_id, title, description, thumbnail_url, visibility, category, tags)
+        	VALUES ($1, $2, $3, $4, $5, $6, coalesce($7::text[], '{}'))
+        	RETURNING id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, `+viewerCountColumn+`, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
 	`
 	rows, err := r.dbConn.Query(ctx, query,
 		newLivestream.UserId,
@@ -21,6 +21,8 @@ func (r *postgresLivestreamRepo) Create(ctx context.Context, newLivestream domai
 		newLivestream.Description,
 		newLivestream.ThumbnailURL,
 		newLivestream.Visibility,
+		newLivestream.Category,
+		newLivestream.Tags,
 	)
 
 	if err != nil {
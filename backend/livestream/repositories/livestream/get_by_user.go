@@ -13,7 +13,7 @@ import (
 
 func (r *postgresLivestreamRepo) GetByUser(ctx context.Context, userId uuid.UUID) (*domains.Livestream, *response.Response[any]) {
 	query := `
-		SELECT id, user_id, title, description, thumbnail_url, visibility, view_count, started_at, ended_at, created_at, updated_at, vod_id
+		SELECT id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, `+viewerCountColumn+`, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
 		FROM livestreams
 		WHERE user_id = $1 AND vod_id IS NULL AND ended_at IS NULL
 		ORDER BY started_at DESC, created_at DESC, id DESC
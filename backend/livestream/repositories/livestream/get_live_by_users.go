@@ -3,25 +3,23 @@ package livestream
 import (
 	"context"
 	"sen1or/letslive/livestream/domains"
-	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/jackc/pgx/v5"
 )
 
-// TODO: implement a recommendation system
-func (r *postgresLivestreamRepo) GetRecommendedLivestreams(ctx context.Context, page int, limit int) ([]domains.Livestream, *response.Response[any]) {
-	offset := limit * page
+func (r *postgresLivestreamRepo) GetLiveByUsers(ctx context.Context, userIds []uuid.UUID) ([]domains.Livestream, *response.Response[any]) {
 	query := `
-		SELECT id, user_id, title, description, thumbnail_url, visibility, view_count, started_at, ended_at, created_at, updated_at, vod_id
+		SELECT id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, ` + viewerCountColumn + `, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
 		FROM livestreams
-        	WHERE ended_at IS NULL AND visibility = 'public'
-        	ORDER BY started_at DESC
-        	OFFSET $1 LIMIT $2
+		WHERE user_id = ANY($1) AND ended_at IS NULL AND visibility = 'public'
+		ORDER BY started_at DESC, id DESC
 	`
-	rows, err := r.dbConn.Query(ctx, query, offset, limit)
+	rows, err := r.dbConn.Query(ctx, query, userIds)
 	if err != nil {
-		logger.Errorf(ctx, "db query error [getalllivestreamings: %v]", err)
+		logger.Errorf(ctx, "db query error [getlivebyusers: %v]", err)
 		return nil, response.NewResponseFromTemplate[any](
 			response.RES_ERR_DATABASE_QUERY,
 			nil,
@@ -32,7 +30,7 @@ func (r *postgresLivestreamRepo) GetRecommendedLivestreams(ctx context.Context,
 
 	livestreams, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.Livestream])
 	if err != nil {
-		logger.Errorf(ctx, "db scan error [getalllivestreamings: %v]", err)
+		logger.Errorf(ctx, "db scan error [getlivebyusers: %v]", err)
 		return nil, response.NewResponseFromTemplate[any](
 			response.RES_ERR_DATABASE_ISSUE,
 			nil,
@@ -40,6 +38,5 @@ func (r *postgresLivestreamRepo) GetRecommendedLivestreams(ctx context.Context,
 			nil,
 		)
 	}
-
 	return livestreams, nil
 }
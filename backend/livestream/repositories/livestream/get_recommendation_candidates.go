@@ -0,0 +1,45 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresLivestreamRepo) GetRecommendationCandidates(ctx context.Context, filter domains.LivestreamFilter, limit int) ([]domains.Livestream, *response.Response[any]) {
+	query := `
+		SELECT id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, ` + viewerCountColumn + `, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
+		FROM livestreams
+        	WHERE ended_at IS NULL AND visibility = 'public'
+        		AND ($2::text IS NULL OR category = $2)
+        		AND ($3::text IS NULL OR tags @> ARRAY[$3::text])
+        	ORDER BY viewer_count DESC, started_at DESC
+        	LIMIT $1
+	`
+	rows, err := r.dbConn.Query(ctx, query, limit, filter.Category, filter.Tag)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getrecommendationcandidates: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	livestreams, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.Livestream])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getrecommendationcandidates: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return livestreams, nil
+}
@@ -1,11 +1,18 @@
 package livestream
 
 import (
+	"fmt"
 	"sen1or/letslive/livestream/domains"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// viewerCountColumn counts the viewer sessions of the livestream that sent a heartbeat within the window
+var viewerCountColumn = fmt.Sprintf(
+	`(SELECT count(*) FROM livestream_viewer_sessions s WHERE s.livestream_id = livestreams.id AND s.last_seen_at > now() - interval '%d seconds')::int AS viewer_count`,
+	int(domains.ViewerSessionWindow.Seconds()),
+)
+
 type postgresLivestreamRepo struct {
 	dbConn *pgxpool.Pool
 }
@@ -0,0 +1,47 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/search"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// searchableText must stay the expression the search indexes of migration 0010 are built on
+const searchableText = `coalesce(title, '') || ' ' || coalesce(description, '')`
+
+func (r *postgresLivestreamRepo) Search(ctx context.Context, query string, language search.Language, page int, limit int) ([]domains.Livestream, *response.Response[any]) {
+	document, tsquery := language.Document(searchableText), language.Query("$1")
+	sql := `
+		SELECT id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, ` + viewerCountColumn + `, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
+		FROM livestreams
+		WHERE ended_at IS NULL AND visibility = 'public' AND ` + document + ` @@ ` + tsquery + `
+		ORDER BY ts_rank_cd(` + document + `, ` + tsquery + `) DESC, viewer_count DESC, id
+		OFFSET $2 LIMIT $3
+	`
+	rows, err := r.dbConn.Query(ctx, sql, query, page*limit, limit)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [searchlivestreams: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	livestreams, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.Livestream])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [searchlivestreams: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return livestreams, nil
+}
@@ -13,9 +13,9 @@ import (
 func (r *postgresLivestreamRepo) Update(ctx context.Context, livestream domains.Livestream) (*domains.Livestream, *response.Response[any]) {
 	query := `
 		UPDATE livestreams
-		SET title = $1, description = $2, thumbnail_url = $3, visibility = $4, ended_at = $5, vod_id = $6, updated_at = NOW()
-		WHERE id = $7
-		RETURNING id, user_id, title, description, thumbnail_url, visibility, view_count, started_at, ended_at, created_at, updated_at, vod_id
+		SET title = $1, description = $2, thumbnail_url = $3, visibility = $4, ended_at = $5, vod_id = $6, category = $7, tags = coalesce($8::text[], '{}'), updated_at = NOW()
+		WHERE id = $9
+		RETURNING id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, `+viewerCountColumn+`, peak_viewer_count, viewer_seconds, started_at, ended_at, created_at, updated_at, vod_id
 	`
 
 	rows, err := r.dbConn.Query(ctx, query,
@@ -25,6 +25,8 @@ func (r *postgresLivestreamRepo) Update(ctx context.Context, livestream domains.
 		livestream.Visibility,
 		livestream.EndedAt,
 		livestream.VODId,
+		livestream.Category,
+		livestream.Tags,
 		livestream.Id,
 	)
 	if err != nil {
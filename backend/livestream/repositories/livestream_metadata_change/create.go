@@ -0,0 +1,26 @@
+package livestreammetadatachange
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+)
+
+func (r *postgresLivestreamMetadataChangeRepo) Create(ctx context.Context, change domains.LivestreamMetadataChange) *response.Response[any] {
+	query := `
+		INSERT INTO livestream_metadata_changes (livestream_id, previous_title, title, previous_category, category, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := r.dbConn.Exec(ctx, query, change.LivestreamId, change.PreviousTitle, change.Title, change.PreviousCategory, change.Category, change.ChangedAt); err != nil {
+		logger.Errorf(ctx, "db exec error [createlivestreammetadatachange id=%s: %v]", change.LivestreamId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
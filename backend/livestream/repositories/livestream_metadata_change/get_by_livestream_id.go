@@ -0,0 +1,43 @@
+package livestreammetadatachange
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresLivestreamMetadataChangeRepo) GetByLivestreamId(ctx context.Context, livestreamId uuid.UUID) ([]domains.LivestreamMetadataChange, *response.Response[any]) {
+	query := `
+		SELECT livestream_id, previous_title, title, previous_category, category, changed_at
+		FROM livestream_metadata_changes
+		WHERE livestream_id = $1
+		ORDER BY changed_at
+	`
+	rows, err := r.dbConn.Query(ctx, query, livestreamId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getlivestreammetadatachanges id=%s: %v]", livestreamId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	changes, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.LivestreamMetadataChange])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getlivestreammetadatachanges id=%s: %v]", livestreamId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return changes, nil
+}
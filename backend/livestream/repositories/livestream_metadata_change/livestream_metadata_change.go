@@ -0,0 +1,17 @@
+package livestreammetadatachange
+
+import (
+	"sen1or/letslive/livestream/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresLivestreamMetadataChangeRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewLivestreamMetadataChangeRepository(conn *pgxpool.Pool) domains.LivestreamMetadataChangeRepository {
+	return &postgresLivestreamMetadataChangeRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,49 @@
+package livestreamviewer
+
+import (
+	"context"
+	"fmt"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// the totals only grow while the sessions are kept, greatest keeps a rerun or a slower replica from lowering them
+const aggregateStatsQuery = `
+	UPDATE livestreams l
+	SET view_count = greatest(coalesce(l.view_count, 0), s.views),
+		peak_viewer_count = greatest(l.peak_viewer_count, s.watching),
+		viewer_seconds = greatest(l.viewer_seconds, s.watched_seconds)
+	FROM (
+		SELECT livestream_id,
+			sum(views) AS views,
+			count(*) FILTER (WHERE last_seen_at > now() - $1::interval) AS watching,
+			sum(watched_seconds) AS watched_seconds
+		FROM livestream_viewer_sessions
+		WHERE %s
+		GROUP BY livestream_id
+	) s
+	WHERE l.id = s.livestream_id AND %s
+`
+
+func (r *postgresLivestreamViewerRepo) AggregateLiveStats(ctx context.Context) *response.Response[any] {
+	query := fmt.Sprintf(aggregateStatsQuery, "true", "l.ended_at IS NULL")
+	if _, err := r.dbConn.Exec(ctx, query, domains.ViewerSessionWindow); err != nil {
+		logger.Errorf(ctx, "db exec error [aggregateliveviewerstats: %v]", err)
+		return response.NewResponseFromTemplate[any](response.RES_ERR_DATABASE_QUERY, nil, nil, nil)
+	}
+
+	return nil
+}
+
+func (r *postgresLivestreamViewerRepo) AggregateStats(ctx context.Context, livestreamId uuid.UUID) *response.Response[any] {
+	query := fmt.Sprintf(aggregateStatsQuery, "livestream_id = $2", "l.id = $2")
+	if _, err := r.dbConn.Exec(ctx, query, domains.ViewerSessionWindow, livestreamId); err != nil {
+		logger.Errorf(ctx, "db exec error [aggregateviewerstats id=%s: %v]", livestreamId, err)
+		return response.NewResponseFromTemplate[any](response.RES_ERR_DATABASE_QUERY, nil, nil, nil)
+	}
+
+	return nil
+}
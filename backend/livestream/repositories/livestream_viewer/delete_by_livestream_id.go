@@ -0,0 +1,27 @@
+package livestreamviewer
+
+import (
+	"context"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresLivestreamViewerRepo) DeleteByLivestreamId(ctx context.Context, livestreamId uuid.UUID) *response.Response[any] {
+	query := `
+		DELETE FROM livestream_viewer_sessions
+		WHERE livestream_id = $1
+	`
+	if _, err := r.dbConn.Exec(ctx, query, livestreamId); err != nil {
+		logger.Errorf(ctx, "db exec error [deletelivestreamviewers id=%s: %v]", livestreamId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package livestreamviewer
+
+import (
+	"sen1or/letslive/livestream/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresLivestreamViewerRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewLivestreamViewerRepository(conn *pgxpool.Pool) domains.LivestreamViewerRepository {
+	return &postgresLivestreamViewerRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,43 @@
+package livestreamviewer
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresLivestreamViewerRepo) RecordHeartbeat(ctx context.Context, livestreamId uuid.UUID, sessionId string, clientIp string) (bool, *response.Response[any]) {
+	// only the row of the session is written, so the heartbeats of a livestream never wait on each other.
+	// A session coming back after the window starts over, the time it was away is not counted.
+	// A new session is only taken while its address has fewer live sessions on the livestream than the cap
+	result, err := r.dbConn.Exec(ctx, `
+		INSERT INTO livestream_viewer_sessions (livestream_id, session_id, client_ip)
+		SELECT l.id, $2, $4 FROM livestreams l
+		WHERE l.id = $1 AND l.ended_at IS NULL AND (
+			EXISTS (
+				SELECT 1 FROM livestream_viewer_sessions s
+				WHERE s.livestream_id = $1 AND s.session_id = $2
+			)
+			OR (
+				SELECT count(*) FROM livestream_viewer_sessions s
+				WHERE s.livestream_id = $1 AND s.client_ip = $4 AND s.last_seen_at > now() - $3::interval
+			) < $5
+		)
+		ON CONFLICT (livestream_id, session_id) DO UPDATE SET
+			views = livestream_viewer_sessions.views +
+				CASE WHEN livestream_viewer_sessions.last_seen_at > now() - $3::interval THEN 0 ELSE 1 END,
+			watched_seconds = livestream_viewer_sessions.watched_seconds +
+				CASE WHEN livestream_viewer_sessions.last_seen_at > now() - $3::interval
+					THEN extract(epoch FROM now() - livestream_viewer_sessions.last_seen_at)::bigint ELSE 0 END,
+			last_seen_at = now()
+	`, livestreamId, sessionId, domains.ViewerSessionWindow, clientIp, domains.MaxViewerSessionsPerClient)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [recordviewerheartbeat id=%s: %v]", livestreamId, err)
+		return false, response.NewResponseFromTemplate[any](response.RES_ERR_DATABASE_QUERY, nil, nil, nil)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
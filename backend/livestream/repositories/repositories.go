@@ -2,7 +2,10 @@ package repositories
 
 import (
 	"sen1or/letslive/livestream/domains"
+	categoryrepo "sen1or/letslive/livestream/repositories/category"
 	livestreamrepo "sen1or/letslive/livestream/repositories/livestream"
+	livestreammetadatachangerepo "sen1or/letslive/livestream/repositories/livestream_metadata_change"
+	livestreamviewerrepo "sen1or/letslive/livestream/repositories/livestream_viewer"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -10,3 +13,15 @@ import (
 func NewLivestreamRepository(conn *pgxpool.Pool) domains.LivestreamRepository {
 	return livestreamrepo.NewLivestreamRepository(conn)
 }
+
+func NewLivestreamMetadataChangeRepository(conn *pgxpool.Pool) domains.LivestreamMetadataChangeRepository {
+	return livestreammetadatachangerepo.NewLivestreamMetadataChangeRepository(conn)
+}
+
+func NewCategoryRepository(conn *pgxpool.Pool) domains.CategoryRepository {
+	return categoryrepo.NewCategoryRepository(conn)
+}
+
+func NewLivestreamViewerRepository(conn *pgxpool.Pool) domains.LivestreamViewerRepository {
+	return livestreamviewerrepo.NewLivestreamViewerRepository(conn)
+}
@@ -28,6 +28,8 @@ const (
 	RES_ERR_VOD_COMMENT_ALREADY_LIKED_CODE     = 40011
 	RES_ERR_VOD_COMMENT_NOT_LIKED_CODE         = 40012
 	RES_ERR_VOD_COMMENT_DELETE_FAILED_CODE     = 40013
+	RES_ERR_CATEGORY_NOT_FOUND_CODE            = 40014
+	RES_ERR_LIVESTREAM_NOT_LIVE_CODE           = 40015
 )
 
 // Error keys
@@ -55,6 +57,8 @@ const (
 	RES_ERR_VOD_COMMENT_ALREADY_LIKED_KEY     = "res_err_vod_comment_already_liked"
 	RES_ERR_VOD_COMMENT_NOT_LIKED_KEY         = "res_err_vod_comment_not_liked"
 	RES_ERR_VOD_COMMENT_DELETE_FAILED_KEY     = "res_err_vod_comment_delete_failed"
+	RES_ERR_CATEGORY_NOT_FOUND_KEY            = "res_err_category_not_found"
+	RES_ERR_LIVESTREAM_NOT_LIVE_KEY           = "res_err_livestream_not_live"
 )
 
 // Error templates
@@ -234,4 +238,20 @@ var (
 		Key:        RES_ERR_VOD_COMMENT_DELETE_FAILED_KEY,
 		Message:    "Failed to delete comment.",
 	}
+
+	RES_ERR_CATEGORY_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_CATEGORY_NOT_FOUND_CODE,
+		Key:        RES_ERR_CATEGORY_NOT_FOUND_KEY,
+		Message:    "Category not found.",
+	}
+
+	RES_ERR_LIVESTREAM_NOT_LIVE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_LIVESTREAM_NOT_LIVE_CODE,
+		Key:        RES_ERR_LIVESTREAM_NOT_LIVE_KEY,
+		Message:    "The livestream is not live.",
+	}
 )
@@ -10,6 +10,8 @@ type Meta struct {
 	Page     int `json:"page,omitempty"`
 	PageSize int `json:"page_size,omitempty"`
 	Total    int `json:"total,omitempty"`
+	// opaque cursor of the next page of a ranked listing, empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type ErrorDetail map[string]any
@@ -0,0 +1,32 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/tags"
+)
+
+func (s *LivestreamService) GetCategories(ctx context.Context) ([]domains.CategoryWithLiveStats, *response.Response[any]) {
+	return s.categoryRepo.GetAllWithLiveStats(ctx)
+}
+
+// knownCategory returns the category when it exists, nil when it is empty or unknown so a livestream is never
+// refused for a category removed since the streamer picked it
+func (s *LivestreamService) knownCategory(ctx context.Context, category *string) *string {
+	if category == nil || len(*category) == 0 {
+		return nil
+	}
+	if !tags.ValidSlug(*category) {
+		logger.Warnf(ctx, "ignoring invalid category %q", *category)
+		return nil
+	}
+
+	found, err := s.categoryRepo.GetBySlug(ctx, *category)
+	if err != nil {
+		logger.Warnf(ctx, "ignoring category %q: %s", *category, err.Message)
+		return nil
+	}
+	return &found.Slug
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"sen1or/letslive/livestream/domains"
 	"sen1or/letslive/livestream/dto"
-	"sen1or/letslive/shared/pkg/logger"
 	response "sen1or/letslive/livestream/response"
 	"sen1or/letslive/livestream/utils"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/tags"
 	"time"
 )
 
@@ -25,12 +26,21 @@ func (s *LivestreamService) Create(ctx context.Context, data dto.CreateLivestrea
 		*data.Visibility = domains.LivestreamPublicVisibility
 	}
 
+	// the streamer set these before going live, the stream starts without them rather than being refused
+	livestreamTags, ok := tags.Normalize(data.Tags)
+	if !ok {
+		logger.Warnf(ctx, "ignoring invalid tags %v of user %s", data.Tags, data.UserId)
+		livestreamTags = nil
+	}
+
 	livestreamData := domains.Livestream{
 		UserId:       data.UserId,
 		Title:        titleString,
 		Description:  data.Description,
 		ThumbnailURL: data.ThumbnailURL,
 		Visibility:   *data.Visibility,
+		Category:     s.knownCategory(ctx, data.Category),
+		Tags:         livestreamTags,
 	}
 
 	if livestreamData.Title == "" {
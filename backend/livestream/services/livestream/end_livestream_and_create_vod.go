@@ -4,9 +4,9 @@ import (
 	"context"
 	"sen1or/letslive/livestream/dto"
 	vodgateway "sen1or/letslive/livestream/gateway/vod"
-	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/livestream/response"
 	"sen1or/letslive/livestream/utils"
+	"sen1or/letslive/shared/pkg/logger"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -37,6 +37,8 @@ func (s *LivestreamService) EndLivestreamAndCreateVOD(ctx context.Context, strea
 	if err != nil {
 		return err
 	}
+	// the livestream is ended so no more heartbeats are recorded, its viewer stats are final
+	updatedLs = s.finalizeViewers(ctx, updatedLs)
 
 	// Create VOD via VOD service gateway
 	var description string
@@ -47,19 +49,33 @@ func (s *LivestreamService) EndLivestreamAndCreateVOD(ctx context.Context, strea
 	if currentLivestream.ThumbnailURL != nil {
 		thumbnailURL = *currentLivestream.ThumbnailURL
 	}
+	var category string
+	if currentLivestream.Category != nil {
+		category = *currentLivestream.Category
+	}
 	var playbackURL string
 	if endReqDTO.PlaybackURL != nil {
 		playbackURL = *endReqDTO.PlaybackURL
 	}
 
+	streamDuration := now.Sub(updatedLs.StartedAt)
+	if endReqDTO.Duration > 0 {
+		streamDuration = time.Duration(endReqDTO.Duration) * time.Second
+	}
+
 	createReq := vodgateway.CreateVODRequest{
-		LivestreamId: currentLivestream.Id.String(),
-		UserId:       currentLivestream.UserId.String(),
-		Title:        currentLivestream.Title,
-		Description:  description,
-		ThumbnailURL: thumbnailURL,
-		PlaybackURL:  playbackURL,
-		Duration:     endReqDTO.Duration,
+		LivestreamId:       currentLivestream.Id.String(),
+		UserId:             currentLivestream.UserId.String(),
+		Title:              currentLivestream.Title,
+		Description:        description,
+		ThumbnailURL:       thumbnailURL,
+		PlaybackURL:        playbackURL,
+		Duration:           endReqDTO.Duration,
+		Category:           category,
+		Tags:               currentLivestream.Tags,
+		PeakViewerCount:    updatedLs.PeakViewerCount,
+		AverageViewerCount: averageViewerCount(updatedLs.ViewerSeconds, streamDuration),
+		Chapters:           s.titleChapters(ctx, *currentLivestream),
 	}
 
 	vodId, createErr := s.vodGateway.CreateVOD(ctx, createReq)
@@ -0,0 +1,21 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/dto"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/livestream/utils"
+)
+
+// GetLiveLivestreamsOfUsers returns the public livestreams on air of the users, the latest started first
+func (s *LivestreamService) GetLiveLivestreamsOfUsers(ctx context.Context, data dto.GetLiveLivestreamsOfUsersRequestDTO) ([]domains.Livestream, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	if len(data.UserIds) == 0 {
+		return []domains.Livestream{}, nil
+	}
+	return s.livestreamRepo.GetLiveByUsers(ctx, data.UserIds)
+}
@@ -0,0 +1,13 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (s LivestreamService) GetLivestreamById(ctx context.Context, livestreamId uuid.UUID) (*domains.Livestream, *response.Response[any]) {
+	return s.livestreamRepo.GetById(ctx, livestreamId)
+}
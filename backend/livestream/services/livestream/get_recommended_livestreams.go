@@ -4,13 +4,19 @@ import (
 	"context"
 	"sen1or/letslive/livestream/domains"
 	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/ranking"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
 )
 
-func (s *LivestreamService) GetRecommendedLivestreams(ctx context.Context, page int, limit int) ([]domains.Livestream, *response.Response[any]) {
-	if page < 0 {
-		page = 0
-	}
+// recommendationCandidates caps the livestreams ranked for a request, the most watched ones are kept
+const recommendationCandidates = 500
 
+// GetRecommendedLivestreams ranks the public livestreams on air for the viewer, nil when anonymous. The pages after
+// the first one are ranked at the time kept in the cursor so they line up with the previous ones
+func (s *LivestreamService) GetRecommendedLivestreams(ctx context.Context, filter domains.LivestreamFilter, viewerId *uuid.UUID, after *ranking.Cursor, limit int) ([]domains.Livestream, *ranking.Cursor, *response.Response[any]) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -19,5 +25,52 @@ func (s *LivestreamService) GetRecommendedLivestreams(ctx context.Context, page
 		limit = 50
 	}
 
-	return s.livestreamRepo.GetRecommendedLivestreams(ctx, page, limit)
+	candidates, err := s.livestreamRepo.GetRecommendationCandidates(ctx, filter, recommendationCandidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asOf := time.Now()
+	if after != nil {
+		asOf = after.AsOf
+	}
+
+	items := make([]ranking.Item, len(candidates))
+	byId := make(map[uuid.UUID]domains.Livestream, len(candidates))
+	for i, livestream := range candidates {
+		items[i] = ranking.Item{
+			Id:          livestream.Id,
+			AuthorId:    livestream.UserId,
+			PublishedAt: livestream.StartedAt,
+			Popularity:  float64(livestream.ViewerCount),
+		}
+		if livestream.Category != nil {
+			items[i].Category = *livestream.Category
+		}
+		byId[livestream.Id] = livestream
+	}
+
+	ranked := ranking.Rank(items, s.viewerAffinity(ctx, viewerId), ranking.LivestreamWeights, asOf)
+	page, next := ranking.Page(ranked, after, limit, asOf)
+
+	livestreams := make([]domains.Livestream, len(page))
+	for i, scored := range page {
+		livestreams[i] = byId[scored.Id]
+	}
+	return livestreams, next, nil
+}
+
+// viewerAffinity asks the user service what the viewer follows, the livestreams are still ranked for an anonymous
+// viewer when it cannot tell
+func (s *LivestreamService) viewerAffinity(ctx context.Context, viewerId *uuid.UUID) ranking.Affinity {
+	if viewerId == nil {
+		return ranking.Affinity{}
+	}
+
+	affinity, err := s.userGateway.GetViewerAffinity(ctx, *viewerId)
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the affinity of viewer %s, ranking without it: %v", viewerId, err)
+		return ranking.Affinity{}
+	}
+	return ranking.NewAffinity(affinity.FollowedUserIds, affinity.Categories)
 }
@@ -2,17 +2,28 @@ package livestream
 
 import (
 	"sen1or/letslive/livestream/domains"
+	usergateway "sen1or/letslive/livestream/gateway/user"
 	vodgateway "sen1or/letslive/livestream/gateway/vod"
 )
 
 type LivestreamService struct {
-	livestreamRepo domains.LivestreamRepository
-	vodGateway     vodgateway.VODGateway
+	livestreamRepo     domains.LivestreamRepository
+	metadataChangeRepo domains.LivestreamMetadataChangeRepository
+	categoryRepo       domains.CategoryRepository
+	viewerRepo         domains.LivestreamViewerRepository
+	vodGateway         vodgateway.VODGateway
+	userGateway        usergateway.UserGateway
+	notifier           domains.LivestreamNotifier
 }
 
-func NewLivestreamService(livestreamRepo domains.LivestreamRepository, vodGateway vodgateway.VODGateway) *LivestreamService {
+func NewLivestreamService(livestreamRepo domains.LivestreamRepository, metadataChangeRepo domains.LivestreamMetadataChangeRepository, categoryRepo domains.CategoryRepository, viewerRepo domains.LivestreamViewerRepository, vodGateway vodgateway.VODGateway, userGateway usergateway.UserGateway, notifier domains.LivestreamNotifier) *LivestreamService {
 	return &LivestreamService{
-		livestreamRepo: livestreamRepo,
-		vodGateway:     vodGateway,
+		livestreamRepo:     livestreamRepo,
+		metadataChangeRepo: metadataChangeRepo,
+		categoryRepo:       categoryRepo,
+		viewerRepo:         viewerRepo,
+		vodGateway:         vodGateway,
+		userGateway:        userGateway,
+		notifier:           notifier,
 	}
 }
@@ -0,0 +1,28 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+)
+
+// recordMetadataChange keeps the title and the category the livestream was given while live, its vod gets a chapter
+// for every title. The update itself does not fail when it can not be recorded
+func (s *LivestreamService) recordMetadataChange(ctx context.Context, previous domains.Livestream, updated domains.Livestream) {
+	if previous.Title == updated.Title && equalOptional(previous.Category, updated.Category) {
+		return
+	}
+
+	err := s.metadataChangeRepo.Create(ctx, domains.LivestreamMetadataChange{
+		LivestreamId:     updated.Id,
+		PreviousTitle:    previous.Title,
+		Title:            updated.Title,
+		PreviousCategory: previous.Category,
+		Category:         updated.Category,
+		ChangedAt:        time.Now(),
+	})
+	if err != nil {
+		logger.Warnf(ctx, "failed to record the metadata change of livestream %s: %s", updated.Id, err.Message)
+	}
+}
@@ -0,0 +1,23 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/shared/pkg/search"
+)
+
+// SearchLivestreams returns a page of the public livestreams on air matching the query, stemmed in the language
+func (s *LivestreamService) SearchLivestreams(ctx context.Context, query string, language string, page int, limit int) ([]domains.Livestream, *response.Response[any]) {
+	query, ok := search.NormalizeQuery(query)
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	textSearchLanguage, ok := search.ParseLanguage(language)
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	return s.livestreamRepo.Search(ctx, query, textSearchLanguage, page, limit)
+}
@@ -0,0 +1,43 @@
+package livestream
+
+import (
+	"context"
+	"sen1or/letslive/livestream/domains"
+	vodgateway "sen1or/letslive/livestream/gateway/vod"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+)
+
+// titleChapters returns the chapters of the vod of the livestream, one per title it went through,
+// nil when its title never changed while live
+func (s *LivestreamService) titleChapters(ctx context.Context, livestream domains.Livestream) []vodgateway.Chapter {
+	changes, err := s.metadataChangeRepo.GetByLivestreamId(ctx, livestream.Id)
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the title changes of livestream %s, its vod has no chapters: %s", livestream.Id, err.Message)
+		return nil
+	}
+	return chaptersFromTitleChanges(livestream.StartedAt, changes)
+}
+
+// chaptersFromTitleChanges starts a chapter with the title the livestream started with and one at every change,
+// at its offset from the start. The vod service drops the ones after the end of the recording
+func chaptersFromTitleChanges(startedAt time.Time, metadataChanges []domains.LivestreamMetadataChange) []vodgateway.Chapter {
+	var changes []domains.LivestreamMetadataChange
+	for _, change := range metadataChanges {
+		if change.TitleChanged() {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	chapters := []vodgateway.Chapter{{StartSeconds: 0, Title: changes[0].PreviousTitle}}
+	for _, change := range changes {
+		chapters = append(chapters, vodgateway.Chapter{
+			StartSeconds: max(change.ChangedAt.Sub(startedAt).Seconds(), 0),
+			Title:        change.Title,
+		})
+	}
+	return chapters
+}
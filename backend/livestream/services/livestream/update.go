@@ -6,6 +6,8 @@ import (
 	"sen1or/letslive/livestream/dto"
 	"sen1or/letslive/livestream/response"
 	"sen1or/letslive/livestream/utils"
+	"sen1or/letslive/shared/pkg/tags"
+	"slices"
 
 	"github.com/gofrs/uuid/v5"
 )
@@ -43,6 +45,7 @@ func (s *LivestreamService) Update(ctx context.Context, data dto.UpdateLivestrea
 		)
 	}
 
+	previous := *currentLivestream
 	updated := false
 	if data.Title != nil && *data.Title != currentLivestream.Title {
 		currentLivestream.Title = *data.Title
@@ -63,6 +66,34 @@ func (s *LivestreamService) Update(ctx context.Context, data dto.UpdateLivestrea
 		updated = true
 	}
 
+	if data.Category != nil {
+		var category *string
+		if len(*data.Category) > 0 {
+			if !tags.ValidSlug(*data.Category) {
+				return nil, response.NewResponseFromTemplate[any](response.RES_ERR_CATEGORY_NOT_FOUND, nil, nil, nil)
+			}
+			found, err := s.categoryRepo.GetBySlug(ctx, *data.Category)
+			if err != nil {
+				return nil, err
+			}
+			category = &found.Slug
+		}
+		if !equalOptional(category, currentLivestream.Category) {
+			currentLivestream.Category = category
+			updated = true
+		}
+	}
+	if data.Tags != nil {
+		livestreamTags, ok := tags.Normalize(*data.Tags)
+		if !ok {
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+		}
+		if !slices.Equal(livestreamTags, currentLivestream.Tags) {
+			currentLivestream.Tags = livestreamTags
+			updated = true
+		}
+	}
+
 	// only call update if changes were actually made
 	if !updated {
 		return currentLivestream, nil
@@ -73,5 +104,15 @@ func (s *LivestreamService) Update(ctx context.Context, data dto.UpdateLivestrea
 		return nil, err
 	}
 
+	s.recordMetadataChange(ctx, previous, *updatedLivestream)
+	s.notifier.NotifyUpdated(ctx, previous, *updatedLivestream)
+
 	return updatedLivestream, nil
 }
+
+func equalOptional(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,90 @@
+package livestream
+
+import (
+	"context"
+	"math"
+	"sen1or/letslive/livestream/domains"
+	"sen1or/letslive/livestream/dto"
+	"sen1or/letslive/livestream/response"
+	"sen1or/letslive/livestream/utils"
+	"sen1or/letslive/shared/pkg/logger"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// RecordViewerHeartbeat counts the session as watching the livestream for another window. A session over the
+// cap of its client address is answered like the others but not counted
+func (s *LivestreamService) RecordViewerHeartbeat(ctx context.Context, livestreamId uuid.UUID, clientIp string, data dto.ViewerHeartbeatRequestDTO) (*dto.ViewerHeartbeatResponseDTO, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	recorded, err := s.viewerRepo.RecordHeartbeat(ctx, livestreamId, data.SessionId, clientIp)
+	if err != nil {
+		return nil, err
+	}
+
+	livestream, err := s.livestreamRepo.GetById(ctx, livestreamId)
+	if err != nil {
+		return nil, err
+	}
+	if !recorded && livestream.EndedAt != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_LIVESTREAM_NOT_LIVE, nil, nil, nil)
+	}
+
+	return &dto.ViewerHeartbeatResponseDTO{
+		ViewerCount:              livestream.ViewerCount,
+		HeartbeatIntervalSeconds: int(domains.ViewerHeartbeatInterval.Seconds()),
+	}, nil
+}
+
+// RunViewerStats folds the viewer sessions into the stats of the live livestreams until the context is cancelled
+func (s *LivestreamService) RunViewerStats(ctx context.Context) {
+	logger.Infof(ctx, "viewer stats started, running every %s", domains.ViewerStatsInterval)
+
+	ticker := time.NewTicker(domains.ViewerStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "viewer stats stopped")
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.viewerRepo.AggregateLiveStats(ctx); err != nil {
+			logger.Warnf(ctx, "failed to aggregate the viewer stats: %s", err.Message)
+		}
+	}
+}
+
+// finalizeViewers folds the last viewer sessions of an ended livestream into its stats, then removes them.
+// It returns the livestream with its final stats
+func (s *LivestreamService) finalizeViewers(ctx context.Context, livestream *domains.Livestream) *domains.Livestream {
+	if err := s.viewerRepo.AggregateStats(ctx, livestream.Id); err != nil {
+		// the sessions are kept, they go away with the livestream
+		logger.Warnf(ctx, "failed to aggregate the viewer stats of livestream %s: %s", livestream.Id, err.Message)
+		return livestream
+	}
+
+	if err := s.viewerRepo.DeleteByLivestreamId(ctx, livestream.Id); err != nil {
+		logger.Warnf(ctx, "failed to clear the viewer sessions of livestream %s: %s", livestream.Id, err.Message)
+	}
+
+	final, err := s.livestreamRepo.GetById(ctx, livestream.Id)
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the final viewer stats of livestream %s: %s", livestream.Id, err.Message)
+		return livestream
+	}
+	return final
+}
+
+// averageViewerCount spreads the time the livestream was watched over how long it lasted
+func averageViewerCount(viewerSeconds int64, duration time.Duration) int {
+	if viewerSeconds <= 0 || duration < time.Second {
+		return 0
+	}
+	return int(math.Round(float64(viewerSeconds) / duration.Seconds()))
+}
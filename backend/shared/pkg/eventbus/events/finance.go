@@ -29,10 +29,13 @@ type PaymentFailedEvent struct {
 }
 
 // DonationSentEvent is emitted when a donation transaction completes.
+// Amount is the decimal amount the sender gave, before the platform fee.
 type DonationSentEvent struct {
+	TipId         uuid.UUID `json:"tipId"`
 	TransactionId uuid.UUID `json:"transactionId"`
 	SenderId      uuid.UUID `json:"senderId"`
 	ReceiverId    uuid.UUID `json:"receiverId"`
 	Amount        string    `json:"amount"`
 	CurrencyCode  string    `json:"currencyCode"`
+	Message       *string   `json:"message,omitempty"`
 }
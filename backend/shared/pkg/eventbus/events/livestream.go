@@ -32,10 +32,15 @@ type LivestreamEndedEvent struct {
 }
 
 // LivestreamUpdatedEvent is emitted when livestream metadata changes.
+// Only the fields that changed are set, an empty Category means the category was removed.
 type LivestreamUpdatedEvent struct {
 	LivestreamId uuid.UUID `json:"livestreamId"`
 	UserId       uuid.UUID `json:"userId"`
 	Title        *string   `json:"title,omitempty"`
 	Description  *string   `json:"description,omitempty"`
 	ThumbnailURL *string   `json:"thumbnailUrl,omitempty"`
+	Visibility   *string   `json:"visibility,omitempty"`
+	Category     *string   `json:"category,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+	UpdatedAt    time.Time `json:"updatedAt"`
 }
@@ -9,6 +9,10 @@ const (
 	VODCreated         = "vod.created"
 	VODReady           = "vod.ready"
 	VODTranscodeFailed = "vod.transcode_failed"
+	// VODTranscodeJobQueued wakes the transcode workers up, a job may be queued without it being published
+	VODTranscodeJobQueued = "vod.transcode_job_queued"
+	// VODClipJobQueued wakes the transcode workers up to cut a clip, a clip may be queued without it being published
+	VODClipJobQueued = "vod.clip_job_queued"
 )
 
 // VODCreatedEvent is emitted when a new VOD is created (from upload or stream-to-VOD).
@@ -27,6 +31,16 @@ type VODReadyEvent struct {
 	Duration    int64     `json:"duration"`
 }
 
+// VODTranscodeJobQueuedEvent is emitted when a transcode job of a VOD becomes pending.
+type VODTranscodeJobQueuedEvent struct {
+	VODId uuid.UUID `json:"vodId"`
+}
+
+// VODClipJobQueuedEvent is emitted when a clip becomes pending.
+type VODClipJobQueuedEvent struct {
+	ClipId uuid.UUID `json:"clipId"`
+}
+
 // VODTranscodeFailedEvent is emitted when VOD transcoding fails.
 type VODTranscodeFailedEvent struct {
 	VODId    uuid.UUID `json:"vodId"`
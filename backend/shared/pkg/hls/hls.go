@@ -0,0 +1,246 @@
+// Package hls reads and writes the HLS playlists of the vods, clips and livestreams
+package hls
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxPlaylistSize bounds the playlists read from a source, a playlist of a long vod is a few hundred KiB
+const maxPlaylistSize = 8 << 20
+
+// Variant is a rendition listed by a master playlist
+type Variant struct {
+	StreamInf string // the #EXT-X-STREAM-INF line as it is in the master playlist
+	Bandwidth int
+	URL       string
+}
+
+// Segment is a segment of a media playlist, Start is its offset from the start of the source in seconds
+type Segment struct {
+	URL           string
+	Start         float64
+	Duration      float64
+	Discontinuity bool // preceded by #EXT-X-DISCONTINUITY, it does not follow the previous segment
+}
+
+func (s Segment) End() float64 {
+	return s.Start + s.Duration
+}
+
+// Rendition is a variant with its segments
+type Rendition struct {
+	Variant
+	Segments []Segment
+}
+
+// FetchRenditions reads the playlist at playlistURL and the media playlists it lists,
+// a media playlist is taken as the only rendition
+func FetchRenditions(ctx context.Context, playlistURL string) ([]Rendition, error) {
+	data, err := FetchPlaylist(ctx, playlistURL)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := []Variant{{StreamInf: "#EXT-X-STREAM-INF:BANDWIDTH=1", URL: playlistURL}}
+	if IsMasterPlaylist(data) {
+		if variants, err = ParseMasterPlaylist(data, playlistURL); err != nil {
+			return nil, err
+		}
+	}
+
+	renditions := make([]Rendition, len(variants))
+	for i, variant := range variants {
+		if variant.URL != playlistURL {
+			if data, err = FetchPlaylist(ctx, variant.URL); err != nil {
+				return nil, err
+			}
+		}
+
+		segments, err := ParseMediaPlaylist(data, variant.URL)
+		if err != nil {
+			return nil, err
+		}
+		if len(segments) == 0 {
+			return nil, fmt.Errorf("playlist %s has no segment", variant.URL)
+		}
+		renditions[i] = Rendition{Variant: variant, Segments: segments}
+	}
+
+	return renditions, nil
+}
+
+// FetchPlaylist downloads the playlist at playlistURL
+func FetchPlaylist(ctx context.Context, playlistURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, playlistURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get playlist %s: %w", playlistURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("playlist %s returned status %d", playlistURL, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxPlaylistSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read playlist %s: %w", playlistURL, err)
+	}
+	return data, nil
+}
+
+// IsMasterPlaylist is true when the playlist lists variants instead of segments
+func IsMasterPlaylist(data []byte) bool {
+	return bytes.Contains(data, []byte("#EXT-X-STREAM-INF"))
+}
+
+// ParseMasterPlaylist returns the variants of a master playlist, their urls are resolved against playlistURL
+func ParseMasterPlaylist(data []byte, playlistURL string) ([]Variant, error) {
+	base, err := url.Parse(playlistURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid playlist url: %w", err)
+	}
+
+	var variants []Variant
+	var streamInf string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#EXT-X-STREAM-INF:"):
+			streamInf = line
+		case line == "" || strings.HasPrefix(line, "#"):
+		case streamInf != "":
+			variantURL, err := base.Parse(line)
+			if err != nil {
+				return nil, fmt.Errorf("invalid variant url %q: %w", line, err)
+			}
+			variants = append(variants, Variant{
+				StreamInf: streamInf,
+				Bandwidth: streamInfBandwidth(streamInf),
+				URL:       variantURL.String(),
+			})
+			streamInf = ""
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read master playlist: %w", err)
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("master playlist has no variant")
+	}
+
+	return variants, nil
+}
+
+// ParseMediaPlaylist returns the segments of a media playlist, their urls are resolved against playlistURL.
+// The segments of a live playlist that are no longer listed are assumed to last the target duration,
+// which holds for the livestreams since their keyframes are forced every hlsTime
+func ParseMediaPlaylist(data []byte, playlistURL string) ([]Segment, error) {
+	base, err := url.Parse(playlistURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid playlist url: %w", err)
+	}
+
+	var segments []Segment
+	var targetDuration, mediaSequence int
+	var segmentDuration float64
+	var discontinuity bool
+	var offset float64
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#EXT-X-TARGETDURATION:"):
+			targetDuration, _ = strconv.Atoi(strings.TrimPrefix(line, "#EXT-X-TARGETDURATION:"))
+		case strings.HasPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"):
+			mediaSequence, _ = strconv.Atoi(strings.TrimPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"))
+		case line == "#EXT-X-DISCONTINUITY":
+			discontinuity = true
+		case strings.HasPrefix(line, "#EXTINF:"):
+			durationStr, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			segmentDuration, err = strconv.ParseFloat(strings.TrimSpace(durationStr), 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse EXTINF duration %q: %w", line, err)
+			}
+		case line == "" || strings.HasPrefix(line, "#"):
+		default:
+			segmentURL, err := base.Parse(line)
+			if err != nil {
+				return nil, fmt.Errorf("invalid segment url %q: %w", line, err)
+			}
+			if len(segments) == 0 {
+				offset = float64(mediaSequence * targetDuration)
+			}
+			segments = append(segments, Segment{
+				URL:           segmentURL.String(),
+				Start:         offset,
+				Duration:      segmentDuration,
+				Discontinuity: discontinuity && len(segments) > 0,
+			})
+			offset += segmentDuration
+			discontinuity = false
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read media playlist: %w", err)
+	}
+
+	return segments, nil
+}
+
+// WriteMediaPlaylist writes a vod playlist of segments named by segmentNames, which can be relative or absolute urls
+func WriteMediaPlaylist(w io.Writer, segments []Segment, segmentNames []string) error {
+	targetDuration := 1
+	for _, segment := range segments {
+		targetDuration = max(targetDuration, int(math.Ceil(segment.Duration)))
+	}
+
+	var playlist strings.Builder
+	fmt.Fprintf(&playlist, "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:%d\n#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n", targetDuration)
+	for i, segment := range segments {
+		if segment.Discontinuity && i > 0 {
+			playlist.WriteString("#EXT-X-DISCONTINUITY\n")
+		}
+		fmt.Fprintf(&playlist, "#EXTINF:%.6f,\n%s\n", segment.Duration, segmentNames[i])
+	}
+	playlist.WriteString("#EXT-X-ENDLIST\n")
+
+	_, err := io.WriteString(w, playlist.String())
+	return err
+}
+
+// WriteMasterPlaylist writes a master playlist listing the variants with their StreamInf, at their URL
+func WriteMasterPlaylist(w io.Writer, variants []Variant) error {
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	for _, variant := range variants {
+		fmt.Fprintf(&playlist, "%s\n%s\n", variant.StreamInf, variant.URL)
+	}
+
+	_, err := io.WriteString(w, playlist.String())
+	return err
+}
+
+func streamInfBandwidth(streamInf string) int {
+	for _, attribute := range strings.Split(strings.TrimPrefix(streamInf, "#EXT-X-STREAM-INF:"), ",") {
+		if value, ok := strings.CutPrefix(attribute, "BANDWIDTH="); ok {
+			bandwidth, _ := strconv.Atoi(value)
+			return bandwidth
+		}
+	}
+	return 0
+}
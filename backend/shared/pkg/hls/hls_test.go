@@ -0,0 +1,97 @@
+package hls
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseMediaPlaylist(t *testing.T) {
+	tests := []struct {
+		name     string
+		playlist string
+		url      string
+		want     []Segment
+	}{
+		{
+			name: "vod",
+			playlist: "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n" +
+				"#EXTINF:4.000000,\nsegment_000.ts\n#EXTINF:3.500000,\nsegment_001.ts\n" +
+				"#EXT-X-DISCONTINUITY\n#EXTINF:2.250000,\nhttp://cdn.test/other/segment_002.ts\n#EXT-X-ENDLIST\n",
+			url: "http://minio.test/vods/1/0/stream.m3u8",
+			want: []Segment{
+				{URL: "http://minio.test/vods/1/0/segment_000.ts", Start: 0, Duration: 4},
+				{URL: "http://minio.test/vods/1/0/segment_001.ts", Start: 4, Duration: 3.5},
+				{URL: "http://cdn.test/other/segment_002.ts", Start: 7.5, Duration: 2.25, Discontinuity: true},
+			},
+		},
+		{
+			name: "live window starts at media sequence times target duration",
+			playlist: "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:2\n#EXT-X-MEDIA-SEQUENCE:100\n" +
+				"#EXTINF:2.000,\nstream_100.ts\n#EXTINF:2.000,\nstream_101.ts\n#EXTINF:1.960,\nstream_102.ts\n",
+			url: "http://live.test/abc/0/stream.m3u8",
+			want: []Segment{
+				{URL: "http://live.test/abc/0/stream_100.ts", Start: 200, Duration: 2},
+				{URL: "http://live.test/abc/0/stream_101.ts", Start: 202, Duration: 2},
+				{URL: "http://live.test/abc/0/stream_102.ts", Start: 204, Duration: 1.96},
+			},
+		},
+		{
+			name:     "discontinuity before the first segment is dropped",
+			playlist: "#EXTM3U\n#EXT-X-TARGETDURATION:2\n#EXT-X-DISCONTINUITY\n#EXTINF:2.0,\na.ts\n",
+			url:      "http://live.test/abc/0/stream.m3u8",
+			want:     []Segment{{URL: "http://live.test/abc/0/a.ts", Start: 0, Duration: 2}},
+		},
+		{
+			name:     "no segment",
+			playlist: "#EXTM3U\n#EXT-X-TARGETDURATION:2\n",
+			url:      "http://live.test/abc/0/stream.m3u8",
+			want:     nil,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ParseMediaPlaylist([]byte(test.playlist), test.url)
+		if err != nil {
+			t.Errorf("%s: ParseMediaPlaylist() error = %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ParseMediaPlaylist() = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseMediaPlaylistInvalidDuration(t *testing.T) {
+	if _, err := ParseMediaPlaylist([]byte("#EXTM3U\n#EXTINF:abc,\na.ts\n"), "http://live.test/stream.m3u8"); err == nil {
+		t.Errorf("ParseMediaPlaylist() with an invalid EXTINF did not fail")
+	}
+}
+
+func TestParseMasterPlaylist(t *testing.T) {
+	playlist := "#EXTM3U\n#EXT-X-VERSION:3\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720\n0/stream.m3u8\n" +
+		"#EXT-X-STREAM-INF:RESOLUTION=640x360,BANDWIDTH=800000\n1/stream.m3u8\n"
+
+	got, err := ParseMasterPlaylist([]byte(playlist), "http://minio.test/vods/1/index.m3u8")
+	if err != nil {
+		t.Fatalf("ParseMasterPlaylist() error = %v", err)
+	}
+	want := []Variant{
+		{StreamInf: "#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720", Bandwidth: 2800000, URL: "http://minio.test/vods/1/0/stream.m3u8"},
+		{StreamInf: "#EXT-X-STREAM-INF:RESOLUTION=640x360,BANDWIDTH=800000", Bandwidth: 800000, URL: "http://minio.test/vods/1/1/stream.m3u8"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMasterPlaylist() = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseMasterPlaylist([]byte("#EXTM3U\n"), "http://minio.test/vods/1/index.m3u8"); err == nil {
+		t.Errorf("ParseMasterPlaylist() of a playlist without variant did not fail")
+	}
+}
+
+func TestSegmentEnd(t *testing.T) {
+	if got := (Segment{Start: 202, Duration: 1.96}).End(); math.Abs(got-203.96) > 1e-9 {
+		t.Errorf("End() = %v, want 203.96", got)
+	}
+}
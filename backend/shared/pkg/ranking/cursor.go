@@ -0,0 +1,61 @@
+package ranking
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points after the last item of a page. It keeps the time the first page was ranked at so the next pages
+// decay the items the same way, an item whose popularity changed in between may still move across pages
+type Cursor struct {
+	AsOf  time.Time `json:"asOf"`
+	Score float64   `json:"score"`
+	Id    uuid.UUID `json:"id"`
+}
+
+// Encode returns the cursor as an opaque string for the clients
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.AsOf.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// Page returns up to limit ranked items after the cursor, a nil cursor starts from the top. The cursor of the next
+// page is nil when there is none
+func Page(ranked []Scored, after *Cursor, limit int, asOf time.Time) ([]Scored, *Cursor) {
+	start := 0
+	if after != nil {
+		last := Scored{Item: Item{Id: after.Id}, Score: after.Score}
+		start = sort.Search(len(ranked), func(i int) bool {
+			return compare(ranked[i], last) > 0
+		})
+	}
+
+	end := min(start+max(limit, 0), len(ranked))
+	page := ranked[start:end]
+	if end == len(ranked) || len(page) == 0 {
+		return page, nil
+	}
+
+	last := page[len(page)-1]
+	return page, &Cursor{AsOf: asOf, Score: last.Score, Id: last.Id}
+}
@@ -0,0 +1,103 @@
+// Package ranking scores the livestreams and the vods recommended to a viewer, the same way in both services
+package ranking
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Item is a livestream or a vod to rank
+type Item struct {
+	Id          uuid.UUID
+	AuthorId    uuid.UUID
+	Category    string    // empty when it has none
+	PublishedAt time.Time // when the livestream started or the vod was created
+	Popularity  float64   // current viewers of a livestream, views of a vod
+}
+
+// Affinity is what is known about the taste of the viewer, the zero value ranks for an anonymous viewer
+type Affinity struct {
+	FollowedAuthors map[uuid.UUID]bool
+	Categories      map[string]float64 // share of the followed channels streaming in each category, from 0 to 1
+}
+
+// Weights tunes the score, see Score
+type Weights struct {
+	HalfLife   time.Duration // the score of an item halves every HalfLife since it was published
+	Recency    float64       // the score of an item nobody watched yet
+	Popularity float64       // added for every tenfold of the popularity
+	Follow     float64       // boost of the items of the followed authors, 1 doubles their score
+	Category   float64       // boost of the items of a category the viewer likes, scaled by its affinity
+}
+
+var (
+	// LivestreamWeights mostly ranks by current viewers, a livestream that started hours ago is not stale
+	LivestreamWeights = Weights{HalfLife: 6 * time.Hour, Recency: 1, Popularity: 2, Follow: 1.5, Category: 0.75}
+	// VODWeights decays within days so the old vods with a lot of views make room for the new ones
+	VODWeights = Weights{HalfLife: 3 * 24 * time.Hour, Recency: 1, Popularity: 1, Follow: 1, Category: 0.5}
+)
+
+// Scored is an item with its score
+type Scored struct {
+	Item
+	Score float64
+}
+
+// Score is the decayed base score of the item boosted by the affinity of the viewer:
+//
+//	0.5^(age/HalfLife) * (Recency + Popularity*log10(1+popularity)) * (1 + Follow*followed + Category*affinity)
+//
+// Every term decays so nothing stays on top forever, and the boosts only reorder items of a similar age and popularity
+func Score(item Item, affinity Affinity, weights Weights, now time.Time) float64 {
+	age := max(now.Sub(item.PublishedAt), 0)
+	decay := 1.0
+	if weights.HalfLife > 0 {
+		decay = math.Pow(0.5, age.Hours()/weights.HalfLife.Hours())
+	}
+
+	base := weights.Recency + weights.Popularity*math.Log10(1+max(item.Popularity, 0))
+
+	boost := 1.0
+	if affinity.FollowedAuthors[item.AuthorId] {
+		boost += weights.Follow
+	}
+	if item.Category != "" {
+		boost += weights.Category * min(max(affinity.Categories[item.Category], 0), 1)
+	}
+
+	return decay * base * boost
+}
+
+// Rank scores the items and sorts them from the highest score, the ties are broken by id so the order is stable
+func Rank(items []Item, affinity Affinity, weights Weights, now time.Time) []Scored {
+	ranked := make([]Scored, len(items))
+	for i, item := range items {
+		ranked[i] = Scored{Item: item, Score: Score(item, affinity, weights, now)}
+	}
+	slices.SortFunc(ranked, compare)
+	return ranked
+}
+
+// compare orders a before b when it ranks higher
+func compare(a Scored, b Scored) int {
+	if a.Score != b.Score {
+		if a.Score > b.Score {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(a.Id.Bytes(), b.Id.Bytes())
+}
+
+// NewAffinity builds the affinity of a viewer from the authors they follow and their category shares
+func NewAffinity(followedAuthorIds []uuid.UUID, categories map[string]float64) Affinity {
+	followed := make(map[uuid.UUID]bool, len(followedAuthorIds))
+	for _, id := range followedAuthorIds {
+		followed[id] = true
+	}
+	return Affinity{FollowedAuthors: followed, Categories: categories}
+}
@@ -0,0 +1,120 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func itemId(n byte) uuid.UUID {
+	return uuid.UUID{15: n}
+}
+
+func TestScore(t *testing.T) {
+	author := itemId(100)
+	weights := Weights{HalfLife: time.Hour, Recency: 1, Popularity: 1, Follow: 1, Category: 1}
+	fresh := Item{Id: itemId(1), AuthorId: author, PublishedAt: now}
+
+	tests := []struct {
+		name     string
+		item     Item
+		affinity Affinity
+		want     float64
+	}{
+		{"fresh without views", fresh, Affinity{}, 1},
+		{"one half-life old", Item{PublishedAt: now.Add(-time.Hour)}, Affinity{}, 0.5},
+		{"published in the future", Item{PublishedAt: now.Add(time.Hour)}, Affinity{}, 1},
+		{"99 views", Item{PublishedAt: now, Popularity: 99}, Affinity{}, 3},
+		{"followed author", fresh, Affinity{FollowedAuthors: map[uuid.UUID]bool{author: true}}, 2},
+		{"liked category", Item{Category: "music", PublishedAt: now}, Affinity{Categories: map[string]float64{"music": 0.5}}, 1.5},
+		{"affinity is capped", Item{Category: "music", PublishedAt: now}, Affinity{Categories: map[string]float64{"music": 3}}, 2},
+		{"no category", Item{PublishedAt: now}, Affinity{Categories: map[string]float64{"": 1}}, 1},
+	}
+
+	for _, test := range tests {
+		if got := Score(test.item, test.affinity, weights, now); got != test.want {
+			t.Errorf("%s: Score() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRankDecaysOldPopularItems(t *testing.T) {
+	old := Item{Id: itemId(1), PublishedAt: now.Add(-30 * 24 * time.Hour), Popularity: 1_000_000}
+	recent := Item{Id: itemId(2), PublishedAt: now.Add(-time.Hour), Popularity: 10}
+
+	ranked := Rank([]Item{old, recent}, Affinity{}, VODWeights, now)
+	if ranked[0].Id != recent.Id {
+		t.Errorf("Rank() put %v first, want the recent item", ranked[0].Id)
+	}
+}
+
+func TestRankBreaksTiesById(t *testing.T) {
+	items := []Item{{Id: itemId(3), PublishedAt: now}, {Id: itemId(1), PublishedAt: now}, {Id: itemId(2), PublishedAt: now}}
+
+	ranked := Rank(items, Affinity{}, VODWeights, now)
+	for i, want := range []uuid.UUID{itemId(1), itemId(2), itemId(3)} {
+		if ranked[i].Id != want {
+			t.Errorf("Rank()[%d] = %v, want %v", i, ranked[i].Id, want)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	var items []Item
+	for n := byte(1); n <= 5; n++ {
+		// items 2 and 3 tie
+		items = append(items, Item{Id: itemId(n), PublishedAt: now, Popularity: float64(10 - min(n, 3))})
+	}
+	ranked := Rank(items, Affinity{}, VODWeights, now)
+
+	var seen []uuid.UUID
+	var cursor *Cursor
+	for pages := 0; ; pages++ {
+		if pages > len(items) {
+			t.Fatal("Page() never returned a nil cursor")
+		}
+		var page []Scored
+		page, cursor = Page(ranked, cursor, 2, now)
+		for _, item := range page {
+			seen = append(seen, item.Id)
+		}
+		if cursor == nil {
+			break
+		}
+
+		decoded, err := DecodeCursor(cursor.Encode())
+		if err != nil || *decoded != *cursor {
+			t.Fatalf("DecodeCursor(Encode()) = %v, %v, want %v", decoded, err, cursor)
+		}
+		cursor = decoded
+	}
+
+	if len(seen) != len(ranked) {
+		t.Fatalf("Page() returned %d items over all pages, want %d", len(seen), len(ranked))
+	}
+	for i := range ranked {
+		if seen[i] != ranked[i].Id {
+			t.Errorf("item %d = %v, want %v", i, seen[i], ranked[i].Id)
+		}
+	}
+}
+
+func TestPageAfterTheLastItem(t *testing.T) {
+	ranked := Rank([]Item{{Id: itemId(1), PublishedAt: now}}, Affinity{}, VODWeights, now)
+
+	page, next := Page(ranked, &Cursor{AsOf: now, Score: ranked[0].Score, Id: ranked[0].Id}, 10, now)
+	if len(page) != 0 || next != nil {
+		t.Errorf("Page() = %v, %v, want an empty last page", page, next)
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	for _, encoded := range []string{"", "not base64!", "e30"} {
+		if _, err := DecodeCursor(encoded); err != ErrInvalidCursor {
+			t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidCursor", encoded, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Package search picks the Postgres text search configuration a viewer's query is stemmed with, the services index
+// their searchable text once per configuration
+package search
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxQueryLength is the longest search in characters
+const MaxQueryLength = 200
+
+// Language is a Postgres text search configuration
+type Language string
+
+const (
+	English Language = "english"
+	// Simple only lowercases the words, it is used for the languages Postgres has no stemmer for, e.g. Vietnamese
+	Simple Language = "simple"
+)
+
+// Languages are the configurations the search indexes are built for
+var Languages = []Language{English, Simple}
+
+// LanguageOfLocale returns the configuration for a BCP-47 locale such as "en-US", Simple when it has no stemmer
+func LanguageOfLocale(locale string) Language {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(locale)), "-")
+	switch primary {
+	case "en":
+		return English
+	default:
+		return Simple
+	}
+}
+
+// NormalizeQuery trims the search typed by the viewer, it fails when nothing is left or it is too long
+func NormalizeQuery(query string) (string, bool) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 || utf8.RuneCountInString(query) > MaxQueryLength {
+		return "", false
+	}
+	return query, true
+}
+
+// ParseLanguage accepts only the configurations the indexes are built for
+func ParseLanguage(s string) (Language, bool) {
+	for _, language := range Languages {
+		if string(language) == s {
+			return language, true
+		}
+	}
+	return "", false
+}
+
+// Document is the tsvector of the text in the configuration, the indexes are built on the same expression so the
+// queries using it can use them. The language is one of Languages, never input
+func (l Language) Document(text string) string {
+	return fmt.Sprintf("to_tsvector('%s', %s)", l, text)
+}
+
+// Query is the tsquery of the search typed by the viewer, placeholder holds it, e.g. "$1"
+func (l Language) Query(placeholder string) string {
+	return fmt.Sprintf("websearch_to_tsquery('%s', %s)", l, placeholder)
+}
@@ -0,0 +1,32 @@
+package search
+
+import "testing"
+
+func TestLanguageOfLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   Language
+	}{
+		{"en-US", English},
+		{"en", English},
+		{" EN-gb ", English},
+		{"vi-VN", Simple},
+		{"", Simple},
+		{"english", Simple},
+	}
+
+	for _, test := range tests {
+		if got := LanguageOfLocale(test.locale); got != test.want {
+			t.Errorf("LanguageOfLocale(%q) = %q, want %q", test.locale, got, test.want)
+		}
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	if language, ok := ParseLanguage("english"); !ok || language != English {
+		t.Errorf("ParseLanguage(english) = %q, %v", language, ok)
+	}
+	if _, ok := ParseLanguage("english'); drop table users; --"); ok {
+		t.Errorf("ParseLanguage accepted a configuration the indexes are not built for")
+	}
+}
@@ -0,0 +1,46 @@
+// Package tags normalizes the free-form tags and the category slugs of the livestreams and vods
+package tags
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	MaxTags      = 10
+	MaxTagLength = 25
+)
+
+var (
+	tagSeparators = regexp.MustCompile(`[\s_]+`)
+	validTag      = regexp.MustCompile(`^[\p{L}\p{N}]+(-[\p{L}\p{N}]+)*$`)
+	validSlug     = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// Normalize lowercases the tags and joins their words with a dash, so "Speed Run" and "speed_run" are the same tag.
+// The duplicates are dropped, it fails when there are more than MaxTags or one is empty, too long or not made
+// of letters and digits
+func Normalize(rawTags []string) ([]string, bool) {
+	normalized := make([]string, 0, len(rawTags))
+	seen := make(map[string]bool, len(rawTags))
+	for _, raw := range rawTags {
+		tag := tagSeparators.ReplaceAllString(strings.ToLower(strings.TrimSpace(raw)), "-")
+		if !validTag.MatchString(tag) || len([]rune(tag)) > MaxTagLength {
+			return nil, false
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxTags {
+		return nil, false
+	}
+	return normalized, true
+}
+
+// ValidSlug is true for a category slug, e.g. "just-chatting"
+func ValidSlug(slug string) bool {
+	return len(slug) <= 64 && validSlug.MatchString(slug)
+}
@@ -0,0 +1,39 @@
+package tags
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+		ok   bool
+	}{
+		{"no tag", nil, []string{}, true},
+		{"lowercased and joined", []string{" Speed Run ", "speed_run", "Tiếng-Việt"}, []string{"speed-run", "tiếng-việt"}, true},
+		{"empty tag", []string{"chill", " "}, nil, false},
+		{"punctuation", []string{"c++"}, nil, false},
+		{"too long", []string{strings.Repeat("a", MaxTagLength+1)}, nil, false},
+		{"too many", strings.Split("a b c d e f g h i j k", " "), nil, false},
+		{"duplicates do not count", strings.Split("a b c d e f g h i j J", " "), strings.Split("a b c d e f g h i j", " "), true},
+	}
+
+	for _, test := range tests {
+		got, ok := Normalize(test.tags)
+		if ok != test.ok || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: Normalize() = %v, %v, want %v, %v", test.name, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestValidSlug(t *testing.T) {
+	for slug, want := range map[string]bool{"gaming": true, "just-chatting": true, "Gaming": false, "-gaming": false, "just--chatting": false, "": false} {
+		if got := ValidSlug(slug); got != want {
+			t.Errorf("ValidSlug(%q) = %v, want %v", slug, got, want)
+		}
+	}
+}
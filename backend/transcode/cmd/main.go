@@ -7,6 +7,7 @@ import (
 	cfg "sen1or/letslive/transcode/config"
 	livestreamgateway "sen1or/letslive/transcode/gateway/livestream/http"
 	usergateway "sen1or/letslive/transcode/gateway/user/http"
+	vodgateway "sen1or/letslive/transcode/gateway/vod/http"
 	"sen1or/letslive/transcode/rtmp"
 	miniostorage "sen1or/letslive/transcode/storage/minio"
 	"sen1or/letslive/transcode/watcher"
@@ -19,10 +20,10 @@ import (
 
 	sharedconfig "sen1or/letslive/shared/config"
 	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/eventbus/natsbus"
 	"sen1or/letslive/shared/pkg/logger"
 	sharedutils "sen1or/letslive/shared/utils"
-
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
@@ -30,6 +31,9 @@ var (
 	configProfile     = os.Getenv("CONFIG_SERVER_PROFILE")
 
 	gracefulShutdownTimeout = 10 * time.Second
+
+	// the instances share the group, a queued job wakes one of them up
+	transcodeWorkerConsumerGroup = "transcode-worker"
 )
 
 func main() {
@@ -78,40 +82,19 @@ func main() {
 
 	// Initialize transcode worker for uploaded video processing
 	var transcodeWorker *worker.TranscodeWorker
-	if config.Database.ConnectionString != "" {
-		poolConfig, parseErr := pgxpool.ParseConfig(config.Database.ConnectionString)
-		if parseErr != nil {
-			logger.Errorf(ctx, "failed to parse database connection string: %v", parseErr)
-		}
-
-		if poolConfig != nil {
-			poolConfig.MaxConns = 10
-			poolConfig.MinConns = 1
-			poolConfig.MaxConnLifetime = 30 * time.Minute
-			poolConfig.MaxConnIdleTime = 5 * time.Minute
-			poolConfig.HealthCheckPeriod = 30 * time.Second
-
-			dbConn, dbErr := pgxpool.NewWithConfig(ctx, poolConfig)
-			if dbErr != nil {
-				logger.Errorf(ctx, "failed to connect to database for transcode worker: %v", dbErr)
-			} else {
-				if config.MinIO.UploadBucketName == "" {
-					logger.Panicf(ctx, "minio.uploadBucketName is required for transcode raw read bucket")
-				}
-
-				rawMinioClient := worker.NewRawMinIOClient(config.MinIO)
-				transcodeWorker = worker.NewTranscodeWorker(
-					dbConn,
-					minioStorage,
-					rawMinioClient,
-					config.MinIO.UploadBucketName,
-					config,
-					livestreamGateway,
-				)
-				go transcodeWorker.Start(ctx)
-				logger.Infof(ctx, "transcode worker started")
-			}
-		}
+	if config.MinIO.UploadBucketName != "" {
+		rawMinioClient := worker.NewRawMinIOClient(config.MinIO)
+		transcodeWorker = worker.NewTranscodeWorker(
+			minioStorage,
+			rawMinioClient,
+			config.MinIO.UploadBucketName,
+			config,
+			vodgateway.NewVODGateway(registry),
+		)
+		transcodeWorker.Start(ctx)
+		go subscribeToQueuedJobs(ctx, config.EventBus, transcodeWorker)
+	} else {
+		logger.Warnf(ctx, "minio.uploadBucketName is not set, uploaded vods will not be transcoded")
 	}
 
 	// TODO: find a way to remove the vodHandler from the rtmp, or change the design or config
@@ -151,9 +134,13 @@ func main() {
 	}()
 
 	if transcodeWorker != nil {
+		// the in-flight jobs get their own timeout, transcoding takes longer than the other components need to stop
+		workerShutdownCtx, cancelWorkerShutdown := context.WithTimeout(context.Background(), time.Duration(config.Worker.ShutdownTimeout)*time.Second)
+		defer cancelWorkerShutdown()
+
 		wg.Add(1)
 		go func() {
-			transcodeWorker.Shutdown()
+			transcodeWorker.Shutdown(workerShutdownCtx)
 			wg.Done()
 		}()
 	}
@@ -177,3 +164,33 @@ func setupHLSFolders(cfg cfg.Transcode) {
 		logger.Panicf(context.TODO(), "failed to create private hls folder: %s", err)
 	}
 }
+
+// subscribeToQueuedJobs wakes the worker up when the vod service queues a job until ctx is done,
+// the worker only relies on polling when no event bus is configured
+func subscribeToQueuedJobs(ctx context.Context, cfg cfg.EventBus, transcodeWorker *worker.TranscodeWorker) {
+	if cfg.URL == "" {
+		logger.Warnf(ctx, "eventBus.url is not set, the transcode worker only polls for queued jobs")
+		return
+	}
+
+	admin, err := natsbus.NewAdmin(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to connect to the event bus, the transcode worker only polls for queued jobs: %v", err)
+		return
+	}
+	if err := admin.EnsureTopics(ctx, events.DefaultTopics()); err != nil {
+		logger.Errorf(ctx, "failed to ensure event bus topics: %v", err)
+	}
+	admin.Close()
+
+	consumer, err := natsbus.NewConsumer(ctx, cfg.URL, transcodeWorkerConsumerGroup)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the event consumer, the transcode worker only polls for queued jobs: %v", err)
+		return
+	}
+	defer consumer.Close()
+
+	if err := consumer.Subscribe(ctx, []string{events.TopicVOD}, transcodeWorker.HandleEvent); err != nil {
+		logger.Errorf(ctx, "transcode worker subscription stopped: %v", err)
+	}
+}
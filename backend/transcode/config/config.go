@@ -1,11 +1,6 @@
 package config
 
-import (
-	"fmt"
-	neturl "net/url"
-	"os"
-	"strings"
-)
+import "runtime"
 
 type Service struct {
 	Name            string `yaml:"name"`
@@ -35,6 +30,7 @@ type Transcode struct {
 
 	FFMpegSetting struct {
 		FFMpegPath     string `yaml:"ffmpegPath"`
+		FFProbePath    string `yaml:"ffprobePath"`
 		MasterFileName string `yaml:"masterFileName"`
 		HLSTime        int    `yaml:"hlsTime"`
 		CRF            int    `yaml:"crf"`
@@ -48,14 +44,27 @@ type Transcode struct {
 			BufSize    string `yaml:"bufSize"`
 		} `yaml:"qualities"`
 	} `yaml:"ffmpegSetting"`
+
+	// PreviewSprites are the seek bar previews of uploaded vods, a frame every Interval seconds tiled into sheets
+	PreviewSprites struct {
+		Interval int `yaml:"interval"` // in seconds
+		Width    int `yaml:"width"`    // of one frame, the height keeps the aspect ratio
+		Columns  int `yaml:"columns"`
+		Rows     int `yaml:"rows"`
+	} `yaml:"previewSprites"`
+}
+
+// Worker controls the transcoding of uploaded vods
+type Worker struct {
+	Concurrency     int `yaml:"concurrency"`     // jobs transcoded at the same time by one instance
+	FFMpegThreads   int `yaml:"ffmpegThreads"`   // threads given to the ffmpeg of every job, the cpus are shared between the jobs by default
+	PollInterval    int `yaml:"pollInterval"`    // in seconds, fallback for retries becoming due and missed events
+	ShutdownTimeout int `yaml:"shutdownTimeout"` // in seconds, how long in-flight jobs may finish before they are handed back to the queue
 }
 
-type Database struct {
-	Host             string   `yaml:"host"`
-	Port             int      `yaml:"port"`
-	Name             string   `yaml:"name"`
-	Params           []string `yaml:"params"`
-	ConnectionString string
+// EventBus is the NATS server the worker is woken up from when a job is queued, the worker only polls when URL is empty
+type EventBus struct {
+	URL string `yaml:"url"`
 }
 
 type Config struct {
@@ -63,29 +72,44 @@ type Config struct {
 	RTMP      `yaml:"rtmp"`
 	Transcode `yaml:"transcode"`
 	MinIO     `yaml:"minio"`
-	Database  `yaml:"database"`
+	Worker    `yaml:"worker"`
+	EventBus  `yaml:"eventBus"`
 	Webserver struct {
 		Port int `yaml:"port"`
 	} `yaml:"webserver"`
 }
 
-// TODO: this is a temporary solution for transcode service to get uploaded-vod from vod service
-// do not intervene databases between services
+// PostProcess fills the ffprobe, preview sprites and worker defaults
 func PostProcess(config *Config) error {
-	if config.Database.Host != "" {
-		dbUser := os.Getenv("TRANSCODE_DB_USER")
-		dbPassword := os.Getenv("TRANSCODE_DB_PASSWORD")
+	if config.Transcode.FFMpegSetting.FFProbePath == "" {
+		config.Transcode.FFMpegSetting.FFProbePath = "ffprobe"
+	}
+
+	sprites := &config.Transcode.PreviewSprites
+	if sprites.Interval <= 0 {
+		sprites.Interval = 10
+	}
+	if sprites.Width <= 0 {
+		sprites.Width = 160
+	}
+	if sprites.Columns <= 0 {
+		sprites.Columns = 10
+	}
+	if sprites.Rows <= 0 {
+		sprites.Rows = 10
+	}
 
-		dbURL := &neturl.URL{
-			Scheme: "postgres",
-			User:   neturl.UserPassword(dbUser, dbPassword),
-			Host:   fmt.Sprintf("%s:%d", config.Database.Host, config.Database.Port),
-			Path:   "/" + config.Database.Name,
-		}
-		if len(config.Database.Params) > 0 {
-			dbURL.RawQuery = strings.Join(config.Database.Params, "&")
-		}
-		config.Database.ConnectionString = dbURL.String()
+	if config.Worker.Concurrency <= 0 {
+		config.Worker.Concurrency = 2
+	}
+	if config.Worker.FFMpegThreads <= 0 {
+		config.Worker.FFMpegThreads = max(runtime.NumCPU()/config.Worker.Concurrency, 1)
+	}
+	if config.Worker.PollInterval <= 0 {
+		config.Worker.PollInterval = 30
+	}
+	if config.Worker.ShutdownTimeout <= 0 {
+		config.Worker.ShutdownTimeout = 60
 	}
 
 	return nil
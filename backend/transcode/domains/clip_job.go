@@ -0,0 +1,31 @@
+package domains
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrClipJobNotProcessing is returned by the vod service once the lease of a clip expired and it was taken from the worker
+var ErrClipJobNotProcessing = errors.New("clip is no longer being processed")
+
+type ClipMode string
+
+const (
+	ClipModeCopy     ClipMode = "copy"     // the source segments covering the range are copied as they are
+	ClipModeReencode ClipMode = "reencode" // the range is cut at the exact frames and transcoded again
+)
+
+// ClipJob is a clip to cut out of the hls stream at SourcePlaybackURL, the offsets are in seconds from the start
+// of the source, which is the start of the livestream when IsLive. The worker owns it until LeaseExpiresAt
+type ClipJob struct {
+	Id                string    `json:"id"`
+	Mode              ClipMode  `json:"mode"`
+	SourcePlaybackURL string    `json:"sourcePlaybackUrl"`
+	IsLive            bool      `json:"isLive"`
+	StartSeconds      float64   `json:"startSeconds"`
+	EndSeconds        float64   `json:"endSeconds"`
+	Attempts          int       `json:"attempts"`
+	MaxAttempts       int       `json:"maxAttempts"`
+	LeaseId           string    `json:"leaseId"`
+	LeaseExpiresAt    time.Time `json:"leaseExpiresAt"`
+}
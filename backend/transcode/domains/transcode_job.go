@@ -0,0 +1,21 @@
+package domains
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTranscodeJobNotProcessing is returned by the vod service once a job was cancelled or taken from the worker,
+// the worker must drop it without reporting anything
+var ErrTranscodeJobNotProcessing = errors.New("transcode job is no longer being processed")
+
+// TranscodeJob is owned by the worker that leased it until LeaseExpiresAt, the worker keeps it by sending heartbeats
+type TranscodeJob struct {
+	Id              string    `json:"id"`
+	VodId           string    `json:"vodId"`
+	Attempts        int       `json:"attempts"`
+	MaxAttempts     int       `json:"maxAttempts"`
+	OriginalFileURL *string   `json:"originalFileUrl"`
+	LeaseId         string    `json:"leaseId"`
+	LeaseExpiresAt  time.Time `json:"leaseExpiresAt"`
+}
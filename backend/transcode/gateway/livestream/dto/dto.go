@@ -12,6 +12,8 @@ type CreateLivestreamRequestDTO struct {
 	Description  *string   `json:"description,omitempty" validate:"omitempty,lte=1000"`
 	ThumbnailURL *string   `json:"thumbnailUrl,omitempty" validate:"omitempty,url,lte=2048"`
 	Visibility   string    `json:"visibility" validate:"oneof=public private,required"`
+	Category     *string   `json:"category,omitempty"`
+	Tags         []string  `json:"tags,omitempty"`
 }
 
 type GetLivestreamRequestDTO struct{}
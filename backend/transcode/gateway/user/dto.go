@@ -25,4 +25,6 @@ type LivestreamInformationResponseDTO struct {
 	Title        *string   `json:"title"`
 	Description  *string   `json:"description"`
 	ThumbnailURL *string   `json:"thumbnailUrl"`
+	Category     *string   `json:"category"`
+	Tags         []string  `json:"tags"`
 }
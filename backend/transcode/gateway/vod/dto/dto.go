@@ -0,0 +1,43 @@
+package dto
+
+type HeartbeatTranscodeJobRequestDTO struct {
+	LeaseId string `json:"leaseId"`
+}
+
+type ReportTranscodeJobProgressRequestDTO struct {
+	LeaseId    string  `json:"leaseId"`
+	Progress   float64 `json:"progress"`
+	EtaSeconds *int    `json:"etaSeconds,omitempty"`
+}
+
+type ReleaseTranscodeJobRequestDTO struct {
+	LeaseId string `json:"leaseId"`
+}
+
+type CompleteTranscodeJobRequestDTO struct {
+	LeaseId                string   `json:"leaseId"`
+	PlaybackUrl            string   `json:"playbackUrl"`
+	ThumbnailUrl           *string  `json:"thumbnailUrl,omitempty"`
+	ThumbnailCandidateUrls []string `json:"thumbnailCandidateUrls,omitempty"` // frames the owner can pick the thumbnail from
+	PreviewTrackUrl        *string  `json:"previewTrackUrl,omitempty"`        // WebVTT track of the seek bar previews
+	Duration               *int64   `json:"duration,omitempty"`
+}
+
+type FailTranscodeJobRequestDTO struct {
+	LeaseId      string `json:"leaseId"`
+	ErrorMessage string `json:"errorMessage"`
+	Retryable    bool   `json:"retryable"`
+}
+
+type CompleteClipJobRequestDTO struct {
+	LeaseId      string  `json:"leaseId"`
+	PlaybackUrl  string  `json:"playbackUrl"`
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+	Duration     int64   `json:"duration"`
+}
+
+type FailClipJobRequestDTO struct {
+	LeaseId      string `json:"leaseId"`
+	ErrorMessage string `json:"errorMessage"`
+	Retryable    bool   `json:"retryable"`
+}
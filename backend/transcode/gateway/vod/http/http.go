@@ -0,0 +1,209 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/transcode/domains"
+	"sen1or/letslive/transcode/gateway"
+	dto "sen1or/letslive/transcode/gateway/vod/dto"
+	"sen1or/letslive/transcode/response"
+)
+
+// VODGateway reaches the transcode job and clip queues owned by the vod service
+type VODGateway struct {
+	registry discovery.Registry
+}
+
+func NewVODGateway(registry discovery.Registry) *VODGateway {
+	return &VODGateway{
+		registry: registry,
+	}
+}
+
+// LeaseTranscodeJob returns the next job to process, nil when there is none
+func (g *VODGateway) LeaseTranscodeJob(ctx context.Context) (*domains.TranscodeJob, error) {
+	resp, err := g.post(ctx, "/v1/internal/transcode-jobs/lease", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if err := checkTranscodeJobResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var leaseResponse response.Response[domains.TranscodeJob]
+	if err := json.NewDecoder(resp.Body).Decode(&leaseResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode leased transcode job: %w", err)
+	}
+	if leaseResponse.Data == nil {
+		return nil, nil
+	}
+
+	return leaseResponse.Data, nil
+}
+
+// HeartbeatTranscodeJob extends the lease of the job, it returns domains.ErrTranscodeJobNotProcessing once the job should be dropped
+func (g *VODGateway) HeartbeatTranscodeJob(ctx context.Context, jobId string, data dto.HeartbeatTranscodeJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/transcode-jobs/%s/heartbeat", jobId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+// ReportTranscodeJobProgress records the progress of the running attempt, shown to the owner of the vod
+func (g *VODGateway) ReportTranscodeJobProgress(ctx context.Context, jobId string, data dto.ReportTranscodeJobProgressRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/transcode-jobs/%s/progress", jobId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+// ReleaseTranscodeJob hands the job back to the queue without counting the attempt
+func (g *VODGateway) ReleaseTranscodeJob(ctx context.Context, jobId string, data dto.ReleaseTranscodeJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/transcode-jobs/%s/release", jobId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+func (g *VODGateway) CompleteTranscodeJob(ctx context.Context, jobId string, data dto.CompleteTranscodeJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/transcode-jobs/%s/complete", jobId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+func (g *VODGateway) FailTranscodeJob(ctx context.Context, jobId string, data dto.FailTranscodeJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/transcode-jobs/%s/fail", jobId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+// LeaseClipJob returns the next clip to cut, nil when there is none
+func (g *VODGateway) LeaseClipJob(ctx context.Context) (*domains.ClipJob, error) {
+	resp, err := g.post(ctx, "/v1/internal/clip-jobs/lease", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if err := checkTranscodeJobResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var leaseResponse response.Response[domains.ClipJob]
+	if err := json.NewDecoder(resp.Body).Decode(&leaseResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode leased clip: %w", err)
+	}
+
+	return leaseResponse.Data, nil
+}
+
+// CompleteClipJob marks the clip ready, it returns domains.ErrClipJobNotProcessing once the clip was taken from the worker
+func (g *VODGateway) CompleteClipJob(ctx context.Context, clipId string, data dto.CompleteClipJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/clip-jobs/%s/complete", clipId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+func (g *VODGateway) FailClipJob(ctx context.Context, clipId string, data dto.FailClipJobRequestDTO) error {
+	resp, err := g.post(ctx, fmt.Sprintf("/v1/internal/clip-jobs/%s/fail", clipId), data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkTranscodeJobResponse(resp)
+}
+
+func (g *VODGateway) post(ctx context.Context, path string, payload any) (*http.Response, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "vod")
+	if err != nil {
+		logger.Debugf(ctx, "get service address from gateway failed for %s", path)
+		return nil, fmt.Errorf("failed to get vod service address: %w", err)
+	}
+
+	var body io.Reader = http.NoBody
+	if payload != nil {
+		payloadBuf := new(bytes.Buffer)
+		if err := json.NewEncoder(payloadBuf).Encode(payload); err != nil {
+			return nil, fmt.Errorf("failed to encode payload of %s: %w", path, err)
+		}
+		body = payloadBuf
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s%s", addr, path), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request %s: %w", path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if setErr := gateway.SetRequestIDHeader(ctx, req); setErr != nil {
+		logger.Debugf(ctx, "failed to set request id header: %s", setErr)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", path, err)
+	}
+
+	return resp, nil
+}
+
+// keep in sync with RES_ERR_TRANSCODE_JOB_NOT_PROCESSING_CODE and RES_ERR_CLIP_NOT_PROCESSING_CODE of the vod service
+const (
+	transcodeJobNotProcessingCode = 40022
+	clipNotProcessingCode         = 40033
+)
+
+func checkTranscodeJobResponse(resp *http.Response) error {
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+
+	resInfo := response.Response[any]{}
+	if err := json.NewDecoder(resp.Body).Decode(&resInfo); err != nil {
+		return fmt.Errorf("vod service returned status %d", resp.StatusCode)
+	}
+	if resp.StatusCode == http.StatusConflict && resInfo.Code == transcodeJobNotProcessingCode {
+		return domains.ErrTranscodeJobNotProcessing
+	}
+	if resp.StatusCode == http.StatusConflict && resInfo.Code == clipNotProcessingCode {
+		return domains.ErrClipJobNotProcessing
+	}
+
+	return fmt.Errorf("vod service returned status %d: %s", resp.StatusCode, resInfo.Message)
+}
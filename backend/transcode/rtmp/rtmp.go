@@ -187,6 +187,8 @@ func (s *RTMPServer) onConnect(streamingKey string) (streamId string, userId str
 		Description:  userInfo.Data.LivestreamInformationResponseDTO.Description,
 		ThumbnailURL: thumb,
 		Visibility:   "public", // TODO: add to livestream information instead of default to public
+		Category:     userInfo.Data.LivestreamInformationResponseDTO.Category,
+		Tags:         userInfo.Data.LivestreamInformationResponseDTO.Tags,
 	}
 
 	req2Ctx, req2CtxCancel := context.WithTimeout(s.ctx, 10*time.Second)
@@ -0,0 +1,67 @@
+package transcoder
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TrimClip cuts duration of the input starting at offset into outputPath, the video is encoded again
+// so the clip starts and ends at the exact frames, the output is near lossless since it is transcoded again to hls
+func TrimClip(ctx context.Context, ffmpegPath string, inputPath string, outputPath string, offset time.Duration, duration time.Duration, threads int) error {
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-y",
+	}
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args,
+		// seeking after -i decodes from the start of the input, it is exact and the input is only a few segments long
+		"-i", inputPath,
+		"-ss", formatSeconds(offset),
+		"-t", formatSeconds(duration),
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-crf", "16",
+		"-pix_fmt", "yuv420p",
+		"-c:a", "aac",
+		"-b:a", "192k",
+		outputPath,
+	)
+
+	if output, err := exec.CommandContext(ctx, ffmpegPath, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// ExtractFrame writes the frame of the input at the given time into outputPath as a jpeg at most 1280 pixels wide
+func ExtractFrame(ctx context.Context, ffmpegPath string, inputPath string, at time.Duration, outputPath string, threads int) error {
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-y",
+	}
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args,
+		// seeking before -i jumps to the closest keyframe and decodes from there, it is fast and exact
+		"-ss", formatSeconds(at),
+		"-i", inputPath,
+		"-frames:v", "1",
+		"-vf", "scale='min(1280,iw)':-2",
+		"-q:v", "2",
+		outputPath,
+	)
+
+	if output, err := exec.CommandContext(ctx, ffmpegPath, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
@@ -10,14 +10,18 @@ import (
 	"path/filepath"
 	"sen1or/letslive/transcode/config"
 	"sen1or/letslive/shared/pkg/logger"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // TranscodeFile transcodes a video file to HLS format (blocking).
 // inputPath: path to the raw video file on disk.
 // outputDir: directory where HLS segments and playlists will be written.
 // Returns the path to the master playlist, thumbnail path, and any error.
-func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string, outputDir string) (string, string, error) {
+func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string, outputDir string, opts FileTranscodeOptions) (string, string, error) {
+	threads := opts.Threads
+
 	// Create output directory structure for each quality
 	for i := range cfg.FFMpegSetting.Qualities {
 		qualityDir := filepath.Join(outputDir, fmt.Sprintf("%d", i))
@@ -42,13 +46,21 @@ func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string,
 	args := []string{
 		"-hide_banner",
 		"-y",
+		// progress is written to stdout as key=value blocks, stderr keeps the logs
+		"-progress", "pipe:1",
+		"-nostats",
+	}
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args,
 		"-i", inputPath,
 		"-sc_threshold", "0",
 		"-preset", cfg.FFMpegSetting.Preset,
 		"-c:v", "libx264",
 		"-pix_fmt", "yuv420p",
 		"-crf", fmt.Sprintf("%v", cfg.FFMpegSetting.CRF),
-	}
+	)
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args, threadArgs("-filter_threads", threads)...)
 	args = append(args, strings.Fields(strings.Join(videoMaps, " "))...)
 	args = append(args, strings.Fields(strings.Join(audioMaps, " "))...)
 	args = append(args,
@@ -72,12 +84,24 @@ func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string,
 		return "", "", fmt.Errorf("failed to get stderr pipe: %w", err)
 	}
 
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get stdout pipe: %w", err)
+	}
+
 	logger.Infof(ctx, "starting file transcode: %s", inputPath)
 
 	if err := cmd.Start(); err != nil {
 		return "", "", fmt.Errorf("failed to start ffmpeg: %w", err)
 	}
 
+	// the pipes must be drained before waiting for ffmpeg
+	progressDone := make(chan struct{})
+	go func() {
+		defer close(progressDone)
+		readProgress(stdout, opts.OnProgress)
+	}()
+
 	// Log stderr in background
 	go func() {
 		scanner := bufio.NewReader(stderr)
@@ -96,6 +120,8 @@ func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string,
 		}
 	}()
 
+	<-progressDone
+
 	// Wait for FFmpeg to complete (blocking)
 	if err := cmd.Wait(); err != nil {
 		return "", "", fmt.Errorf("ffmpeg transcode failed: %w", err)
@@ -105,26 +131,61 @@ func TranscodeFile(ctx context.Context, cfg config.Transcode, inputPath string,
 
 	// Generate thumbnail from the file
 	thumbnailPath := filepath.Join(outputDir, "thumbnail.jpg")
-	generateThumbnailFromFile(ctx, cfg.FFMpegSetting.FFMpegPath, inputPath, thumbnailPath)
+	generateThumbnailFromFile(ctx, cfg.FFMpegSetting.FFMpegPath, inputPath, thumbnailPath, threads)
 
 	logger.Infof(ctx, "file transcode completed successfully: %s", inputPath)
 	return masterPlaylist, thumbnailPath, nil
 }
 
-func generateThumbnailFromFile(ctx context.Context, ffmpegPath, inputPath, outputPath string) {
+func generateThumbnailFromFile(ctx context.Context, ffmpegPath, inputPath, outputPath string, threads int) {
 	args := []string{
 		"-hide_banner",
 		"-loglevel", "error",
 		"-y",
+	}
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args,
 		"-i", inputPath,
 		"-vf", "select='eq(pict_type\\,I)*gte(t\\,1)',scale=640:-1",
 		"-frames:v", "1",
 		"-q:v", "2",
 		outputPath,
-	}
+	)
 
 	cmd := exec.CommandContext(ctx, ffmpegPath, args...)
 	if err := cmd.Run(); err != nil {
 		logger.Errorf(ctx, "thumbnail generation failed for %s: %v", inputPath, err)
 	}
 }
+
+// FileTranscodeOptions tunes a file transcode
+type FileTranscodeOptions struct {
+	Threads    int                         // decoding, filtering and encoding threads ffmpeg may use, 0 lets ffmpeg use every cpu
+	OnProgress func(outTime time.Duration) // called with how much of the input is transcoded so far, may be nil
+}
+
+// readProgress parses the blocks written by ffmpeg -progress until r is closed
+func readProgress(r io.Reader, onProgress func(outTime time.Duration)) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		// out_time_ms is in microseconds as well, older ffmpeg versions only write that one
+		if !found || onProgress == nil || (key != "out_time_us" && key != "out_time_ms") {
+			continue
+		}
+
+		microseconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || microseconds < 0 {
+			continue // N/A until the first frame is encoded
+		}
+		onProgress(time.Duration(microseconds) * time.Microsecond)
+	}
+}
+
+// threadArgs limits an ffmpeg thread pool, the option is placed before -i for decoding and after it for encoding
+func threadArgs(option string, threads int) []string {
+	if threads <= 0 {
+		return nil
+	}
+	return []string{option, strconv.Itoa(threads)}
+}
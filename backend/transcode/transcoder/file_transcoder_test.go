@@ -0,0 +1,39 @@
+package transcoder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadProgress(t *testing.T) {
+	output := strings.Join([]string{
+		"frame=0",
+		"out_time_us=N/A",
+		"out_time_ms=N/A",
+		"progress=continue",
+		"frame=48",
+		"out_time_us=2002000",
+		"out_time=00:00:02.002000",
+		"progress=continue",
+		"out_time_ms=4500000",
+		"out_time_us=-1",
+		"speed=2.5x",
+		"out_time_us=10000000",
+		"progress=end",
+	}, "\n")
+
+	var got []time.Duration
+	readProgress(strings.NewReader(output), func(outTime time.Duration) {
+		got = append(got, outTime)
+	})
+
+	want := []time.Duration{2002 * time.Millisecond, 4500 * time.Millisecond, 10 * time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readProgress() reported %v, want %v", got, want)
+	}
+
+	// a transcode without a progress callback still drains the output
+	readProgress(strings.NewReader(output), nil)
+}
@@ -0,0 +1,115 @@
+package transcoder
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sen1or/letslive/transcode/config"
+	"strings"
+	"time"
+)
+
+// PreviewTrackFileName is the WebVTT track written next to the sprite sheets
+const PreviewTrackFileName = "previews.vtt"
+
+// GeneratePreviewSprites writes sprite sheets of a frame taken every interval of the input into outputDir,
+// with a WebVTT track mapping every interval to its frame in the sheets, the track refers to the sheets by
+// their file name so it must be served from the same folder.
+// Returns the path of the track.
+func GeneratePreviewSprites(ctx context.Context, cfg config.Transcode, inputPath string, outputDir string, duration time.Duration, threads int) (string, error) {
+	sprites := cfg.PreviewSprites
+	if duration <= 0 {
+		return "", fmt.Errorf("unknown duration")
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create sprites dir %s: %w", outputDir, err)
+	}
+
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-y",
+	}
+	args = append(args, threadArgs("-threads", threads)...)
+	args = append(args,
+		"-i", inputPath,
+		"-an", "-sn",
+		"-vf", fmt.Sprintf("fps=1/%d,scale=%d:-2,tile=%dx%d", sprites.Interval, sprites.Width, sprites.Columns, sprites.Rows),
+		"-q:v", "5",
+	)
+	args = append(args, threadArgs("-filter_threads", threads)...)
+	args = append(args, filepath.Join(outputDir, "sprite_%03d.jpg"))
+
+	if output, err := exec.CommandContext(ctx, cfg.FFMpegSetting.FFMpegPath, args...).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("ffmpeg failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	sheets, err := filepath.Glob(filepath.Join(outputDir, "sprite_*.jpg"))
+	if err != nil || len(sheets) == 0 {
+		return "", fmt.Errorf("ffmpeg wrote no sprite sheet")
+	}
+
+	// the last sheet is padded to the full grid, so every sheet has the size of the first one
+	tileWidth, tileHeight, err := spriteTileSize(sheets[0], sprites.Columns, sprites.Rows)
+	if err != nil {
+		return "", err
+	}
+
+	interval := time.Duration(sprites.Interval) * time.Second
+	track := previewTrack(duration, interval, sprites.Columns, sprites.Rows, len(sheets), tileWidth, tileHeight)
+
+	trackPath := filepath.Join(outputDir, PreviewTrackFileName)
+	if err := os.WriteFile(trackPath, []byte(track), 0644); err != nil {
+		return "", fmt.Errorf("failed to write the preview track: %w", err)
+	}
+
+	return trackPath, nil
+}
+
+// previewTrack maps every interval of the input to its tile in the sprite sheets, the tiles are laid out row by row.
+// The frames are capped to the tiles of the sheets ffmpeg wrote
+func previewTrack(duration time.Duration, interval time.Duration, columns int, rows int, sheets int, tileWidth int, tileHeight int) string {
+	framesPerSheet := columns * rows
+	frames := min(int((duration+interval-1)/interval), sheets*framesPerSheet)
+
+	var track strings.Builder
+	track.WriteString("WEBVTT\n")
+	for i := range frames {
+		start := time.Duration(i) * interval
+		end := min(start+interval, duration)
+		tile := i % framesPerSheet
+
+		fmt.Fprintf(&track, "\n%s --> %s\nsprite_%03d.jpg#xywh=%d,%d,%d,%d\n",
+			formatVTTTimestamp(start), formatVTTTimestamp(end),
+			i/framesPerSheet+1,
+			(tile%columns)*tileWidth, (tile/columns)*tileHeight, tileWidth, tileHeight,
+		)
+	}
+	return track.String()
+}
+
+func spriteTileSize(sheetPath string, columns int, rows int) (int, int, error) {
+	file, err := os.Open(sheetPath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open sprite sheet: %w", err)
+	}
+	defer file.Close()
+
+	sheet, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read sprite sheet size: %w", err)
+	}
+
+	return sheet.Width / columns, sheet.Height / rows, nil
+}
+
+// formatVTTTimestamp formats d as hh:mm:ss.mmm
+func formatVTTTimestamp(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, ms%1000)
+}
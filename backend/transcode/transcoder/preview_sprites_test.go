@@ -0,0 +1,64 @@
+package transcoder
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPreviewTrack(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		columns  int
+		rows     int
+		sheets   int
+		want     string
+	}{
+		{
+			name:     "last cue ends with the input",
+			duration: 25 * time.Second,
+			columns:  2, rows: 1, sheets: 2,
+			want: "WEBVTT\n" +
+				"\n00:00:00.000 --> 00:00:10.000\nsprite_001.jpg#xywh=0,0,160,90\n" +
+				"\n00:00:10.000 --> 00:00:20.000\nsprite_001.jpg#xywh=160,0,160,90\n" +
+				"\n00:00:20.000 --> 00:00:25.000\nsprite_002.jpg#xywh=0,0,160,90\n",
+		},
+		{
+			name:     "tiles wrap to the next row",
+			duration: 40 * time.Second,
+			columns:  2, rows: 2, sheets: 1,
+			want: "WEBVTT\n" +
+				"\n00:00:00.000 --> 00:00:10.000\nsprite_001.jpg#xywh=0,0,160,90\n" +
+				"\n00:00:10.000 --> 00:00:20.000\nsprite_001.jpg#xywh=160,0,160,90\n" +
+				"\n00:00:20.000 --> 00:00:30.000\nsprite_001.jpg#xywh=0,90,160,90\n" +
+				"\n00:00:30.000 --> 00:00:40.000\nsprite_001.jpg#xywh=160,90,160,90\n",
+		},
+		{
+			name:     "frames are capped to the sheets written",
+			duration: 35 * time.Second,
+			columns:  1, rows: 1, sheets: 2,
+			want: "WEBVTT\n" +
+				"\n00:00:00.000 --> 00:00:10.000\nsprite_001.jpg#xywh=0,0,160,90\n" +
+				"\n00:00:10.000 --> 00:00:20.000\nsprite_002.jpg#xywh=0,0,160,90\n",
+		},
+	}
+
+	for _, test := range tests {
+		got := previewTrack(test.duration, 10*time.Second, test.columns, test.rows, test.sheets, 160, 90)
+		if got != test.want {
+			t.Errorf("%s: previewTrack() =\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFormatVTTTimestamp(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                       "00:00:00.000",
+		1500 * time.Millisecond: "00:00:01.500",
+		time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond: "01:02:03.456",
+	} {
+		if got := formatVTTTimestamp(d); got != want {
+			t.Errorf("formatVTTTimestamp(%s) = %s, want %s", d, got, want)
+		}
+	}
+}
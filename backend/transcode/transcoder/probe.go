@@ -0,0 +1,30 @@
+package transcoder
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProbeDuration returns the duration of a media file as read from its container by ffprobe
+func ProbeDuration(ctx context.Context, ffprobePath string, inputPath string) (time.Duration, error) {
+	output, err := exec.CommandContext(ctx, ffprobePath,
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		inputPath,
+	).Output()
+	if err != nil {
+		return 0, fmt.Errorf("ffprobe failed: %w", err)
+	}
+
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("ffprobe returned no duration: %q", strings.TrimSpace(string(output)))
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
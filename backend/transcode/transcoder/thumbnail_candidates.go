@@ -0,0 +1,45 @@
+package transcoder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// thumbnailCandidatePositions are the points of the input, in percent of its duration, the candidates are taken at
+var thumbnailCandidatePositions = []int{10, 30, 50, 70, 90}
+
+// GenerateThumbnailCandidates writes a frame taken at every candidate position of the input into outputDir,
+// the owner of the vod can pick one of them as its thumbnail.
+// Returns the paths of the candidates that could be extracted, in position order.
+func GenerateThumbnailCandidates(ctx context.Context, ffmpegPath string, inputPath string, outputDir string, duration time.Duration, threads int) ([]string, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("unknown duration")
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create thumbnails dir %s: %w", outputDir, err)
+	}
+
+	var candidates []string
+	var firstErr error
+	for i, position := range thumbnailCandidatePositions {
+		at := duration * time.Duration(position) / 100
+		outputPath := filepath.Join(outputDir, fmt.Sprintf("candidate_%d.jpg", i+1))
+
+		if err := ExtractFrame(ctx, ffmpegPath, inputPath, at, outputPath, threads); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed at %d%%: %w", position, err)
+			}
+			continue
+		}
+		candidates = append(candidates, outputPath)
+	}
+
+	if len(candidates) == 0 {
+		return nil, firstErr
+	}
+	return candidates, nil
+}
@@ -0,0 +1,345 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sen1or/letslive/shared/pkg/hls"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/transcode/domains"
+	voddto "sen1or/letslive/transcode/gateway/vod/dto"
+	"sen1or/letslive/transcode/transcoder"
+	"time"
+)
+
+// clipRangeTolerance absorbs the rounding of the durations listed by the playlists, in seconds
+const clipRangeTolerance = 0.05
+
+// errClipRangeNotAvailable is returned while a live clip ends after the last segment published, the clip is retried later
+var errClipRangeNotAvailable = errors.New("the end of the clip is not published yet")
+
+// processNextClipJob leases a clip and cuts it, it returns false when there was no clip to lease.
+// A clip has no heartbeat since its lease covers the whole job, one interrupted by the shutdown is cut again once its lease expired
+func (w *TranscodeWorker) processNextClipJob(ctx context.Context) bool {
+	job, err := w.vodGateway.LeaseClipJob(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "worker: failed to lease clip: %v", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	logger.Infof(ctx, "worker: cutting clip %s (%s, attempt %d/%d)", job.Id, job.Mode, job.Attempts, job.MaxAttempts)
+
+	jobCtx, cancelJob := context.WithDeadline(w.jobsCtx, job.LeaseExpiresAt)
+	defer cancelJob()
+
+	if err := w.doClip(jobCtx, job); err != nil {
+		logger.Errorf(ctx, "worker: clip %s failed: %v", job.Id, err)
+	}
+
+	return true
+}
+
+func (w *TranscodeWorker) doClip(ctx context.Context, job *domains.ClipJob) error {
+	tempDir, err := os.MkdirTemp("", fmt.Sprintf("clip-%s-*", job.Id))
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to create temp dir: %v", err)
+		w.markClipFailed(ctx, job, errMsg, true)
+		return errors.New(errMsg)
+	}
+	defer os.RemoveAll(tempDir)
+
+	variants, err := hls.FetchRenditions(ctx, job.SourcePlaybackURL)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to read the source: %v", err)
+		w.markClipFailed(ctx, job, errMsg, true)
+		return errors.New(errMsg)
+	}
+
+	for i := range variants {
+		variants[i].Segments, err = clipSegments(variants[i].Segments, job.StartSeconds, job.EndSeconds, job.IsLive)
+		if err != nil {
+			w.markClipFailed(ctx, job, err.Error(), errors.Is(err, errClipRangeNotAvailable))
+			return err
+		}
+	}
+
+	folder := path.Join("clips", job.Id)
+	var playbackURL string
+	var thumbnailPath string
+	var duration int64
+	if job.Mode == domains.ClipModeReencode {
+		playbackURL, thumbnailPath, duration, err = w.reencodeClip(ctx, job, variants, tempDir, folder)
+	} else {
+		playbackURL, thumbnailPath, duration, err = w.copyClip(ctx, job, variants, tempDir, folder)
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to cut the clip: %v", err)
+		w.markClipFailed(ctx, job, errMsg, true)
+		return errors.New(errMsg)
+	}
+
+	var thumbnailURL *string
+	if thumbnailPath != "" {
+		savedPath, uploadErr := w.hlsStorage.AddThumbnail(ctx, thumbnailPath, folder, "image/jpeg")
+		if uploadErr != nil {
+			logger.Warnf(ctx, "worker: failed to upload thumbnail of clip %s: %v", job.Id, uploadErr)
+		} else {
+			thumbnailURL = &savedPath
+		}
+	}
+
+	if err := w.vodGateway.CompleteClipJob(ctx, job.Id, voddto.CompleteClipJobRequestDTO{
+		LeaseId:      job.LeaseId,
+		PlaybackUrl:  playbackURL,
+		ThumbnailUrl: thumbnailURL,
+		Duration:     duration,
+	}); err != nil {
+		if errors.Is(err, domains.ErrClipJobNotProcessing) {
+			logger.Infof(ctx, "worker: clip %s was taken from this worker before it could be completed", job.Id)
+			return nil
+		}
+		errMsg := fmt.Sprintf("failed to complete clip: %v", err)
+		w.markClipFailed(ctx, job, errMsg, true)
+		return errors.New(errMsg)
+	}
+
+	logger.Infof(ctx, "worker: clip %s completed", job.Id)
+	return nil
+}
+
+// copyClip copies the segments covering the clip of every variant under folder, the clip starts and ends
+// on segment boundaries. Returns the url of the master playlist, a thumbnail taken at the start of the clip and the duration
+func (w *TranscodeWorker) copyClip(ctx context.Context, job *domains.ClipJob, variants []hls.Rendition, tempDir string, folder string) (string, string, int64, error) {
+	masterVariants := make([]hls.Variant, len(variants))
+	for i, variant := range variants {
+		variantDir := filepath.Join(tempDir, fmt.Sprintf("%d", i))
+		if err := os.MkdirAll(variantDir, 0755); err != nil {
+			return "", "", 0, fmt.Errorf("failed to create variant dir: %w", err)
+		}
+
+		names := make([]string, len(variant.Segments))
+		for j, segment := range variant.Segments {
+			names[j] = fmt.Sprintf("segment_%03d.ts", j)
+			segmentPath := filepath.Join(variantDir, names[j])
+			if err := downloadFile(ctx, segment.URL, segmentPath); err != nil {
+				return "", "", 0, err
+			}
+			if _, err := w.hlsStorage.AddSegment(ctx, segmentPath, folder, i); err != nil {
+				return "", "", 0, fmt.Errorf("failed to upload segment %s: %w", names[j], err)
+			}
+		}
+
+		playlistPath := filepath.Join(variantDir, "stream.m3u8")
+		if err := writePlaylist(playlistPath, func(out io.Writer) error { return hls.WriteMediaPlaylist(out, variant.Segments, names) }); err != nil {
+			return "", "", 0, err
+		}
+		if _, err := w.hlsStorage.AddSegment(ctx, playlistPath, folder, i); err != nil {
+			return "", "", 0, fmt.Errorf("failed to upload playlist of variant %d: %w", i, err)
+		}
+
+		masterVariants[i] = hls.Variant{StreamInf: variant.StreamInf, URL: fmt.Sprintf("%d/stream.m3u8", i)}
+	}
+
+	// the master playlist is uploaded last so it never points to missing variants
+	masterPath := filepath.Join(tempDir, w.config.Transcode.FFMpegSetting.MasterFileName)
+	if err := writePlaylist(masterPath, func(out io.Writer) error { return hls.WriteMasterPlaylist(out, masterVariants) }); err != nil {
+		return "", "", 0, err
+	}
+	playbackURL, err := w.hlsStorage.AddThumbnail(ctx, masterPath, folder, "application/vnd.apple.mpegurl")
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to upload master playlist: %w", err)
+	}
+
+	best := bestClipVariant(variants)
+	first := variants[best].Segments[0]
+	thumbnailPath := filepath.Join(tempDir, "thumbnail.jpg")
+	at := time.Duration(max(job.StartSeconds-first.Start, 0) * float64(time.Second))
+	if err := transcoder.ExtractFrame(ctx, w.config.Transcode.FFMpegSetting.FFMpegPath, filepath.Join(tempDir, fmt.Sprintf("%d", best), "segment_000.ts"), at, thumbnailPath, w.config.Worker.FFMpegThreads); err != nil {
+		logger.Warnf(ctx, "worker: failed to extract the thumbnail of clip %s: %v", job.Id, err)
+		thumbnailPath = ""
+	}
+
+	var duration float64
+	for _, segment := range variants[best].Segments {
+		duration += segment.Duration
+	}
+
+	return playbackURL, thumbnailPath, int64(math.Round(duration)), nil
+}
+
+// reencodeClip cuts the clip at the exact frames out of the best variant and transcodes it like an uploaded vod.
+// Returns the url of the master playlist, a thumbnail and the duration
+func (w *TranscodeWorker) reencodeClip(ctx context.Context, job *domains.ClipJob, variants []hls.Rendition, tempDir string, folder string) (string, string, int64, error) {
+	variant := variants[bestClipVariant(variants)]
+
+	// mpeg-ts segments can be joined as they are
+	sourcePath := filepath.Join(tempDir, "source.ts")
+	source, err := os.Create(sourcePath)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to create source file: %w", err)
+	}
+	for i, segment := range variant.Segments {
+		segmentPath := filepath.Join(tempDir, fmt.Sprintf("source_%03d.ts", i))
+		if err := downloadFile(ctx, segment.URL, segmentPath); err != nil {
+			source.Close()
+			return "", "", 0, err
+		}
+		if err := appendFile(source, segmentPath); err != nil {
+			source.Close()
+			return "", "", 0, err
+		}
+		os.Remove(segmentPath)
+	}
+	if err := source.Close(); err != nil {
+		return "", "", 0, fmt.Errorf("failed to write source file: %w", err)
+	}
+
+	trimmedPath := filepath.Join(tempDir, "trimmed.mp4")
+	offset := time.Duration(max(job.StartSeconds-variant.Segments[0].Start, 0) * float64(time.Second))
+	length := time.Duration((job.EndSeconds - job.StartSeconds) * float64(time.Second))
+	if err := transcoder.TrimClip(ctx, w.config.Transcode.FFMpegSetting.FFMpegPath, sourcePath, trimmedPath, offset, length, w.config.Worker.FFMpegThreads); err != nil {
+		return "", "", 0, fmt.Errorf("failed to trim: %w", err)
+	}
+
+	outputDir := filepath.Join(tempDir, "hls")
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return "", "", 0, fmt.Errorf("failed to create output dir: %w", err)
+	}
+	_, thumbnailPath, err := transcoder.TranscodeFile(ctx, w.config.Transcode, trimmedPath, outputDir, transcoder.FileTranscodeOptions{
+		Threads: w.config.Worker.FFMpegThreads,
+	})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("ffmpeg transcode failed: %w", err)
+	}
+	if _, err := os.Stat(thumbnailPath); err != nil {
+		thumbnailPath = ""
+	}
+
+	duration, err := getHLSDurationSeconds(outputDir)
+	if err != nil {
+		logger.Warnf(ctx, "worker: failed to calculate duration of clip %s: %v", job.Id, err)
+		duration = int64(math.Round(job.EndSeconds - job.StartSeconds))
+	}
+
+	playbackURL, err := w.uploadHLSToStorage(ctx, folder, outputDir)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to upload HLS segments: %w", err)
+	}
+
+	return playbackURL, thumbnailPath, duration, nil
+}
+
+// markClipFailed reports a failed attempt, the vod service retries the clip after a backoff
+// or fails it once it is not retryable or has no attempt left
+func (w *TranscodeWorker) markClipFailed(ctx context.Context, job *domains.ClipJob, errMsg string, retryable bool) {
+	// nothing to report once the lease expired or the worker is stopping, the clip is leased again
+	if ctx.Err() != nil {
+		return
+	}
+
+	err := w.vodGateway.FailClipJob(ctx, job.Id, voddto.FailClipJobRequestDTO{
+		LeaseId:      job.LeaseId,
+		ErrorMessage: errMsg,
+		Retryable:    retryable,
+	})
+	if err != nil && !errors.Is(err, domains.ErrClipJobNotProcessing) {
+		logger.Errorf(ctx, "worker: failed to report failure of clip %s: %v", job.Id, err)
+	}
+}
+
+// clipSegments returns the segments overlapping [start, end), they must cover the whole range
+func clipSegments(segments []hls.Segment, start float64, end float64, live bool) ([]hls.Segment, error) {
+	if len(segments) == 0 || segments[0].Start > start+clipRangeTolerance {
+		if live {
+			return nil, errors.New("the start of the clip already left the dvr window")
+		}
+		return nil, errors.New("the source has no segment at the start of the clip")
+	}
+	if segments[len(segments)-1].End() < end-clipRangeTolerance {
+		if live {
+			return nil, errClipRangeNotAvailable
+		}
+		return nil, errors.New("the clip ends after the source")
+	}
+
+	var selected []hls.Segment
+	for _, segment := range segments {
+		if segment.End() > start+clipRangeTolerance && segment.Start < end-clipRangeTolerance {
+			selected = append(selected, segment)
+		}
+	}
+	return selected, nil
+}
+
+func bestClipVariant(variants []hls.Rendition) int {
+	best := 0
+	for i, variant := range variants {
+		if variant.Bandwidth > variants[best].Bandwidth {
+			best = i
+		}
+	}
+	return best
+}
+
+func writePlaylist(playlistPath string, write func(out io.Writer) error) error {
+	file, err := os.Create(playlistPath)
+	if err != nil {
+		return fmt.Errorf("failed to create playlist: %w", err)
+	}
+	defer file.Close()
+
+	if err := write(file); err != nil {
+		return fmt.Errorf("failed to write playlist: %w", err)
+	}
+	return nil
+}
+
+func downloadFile(ctx context.Context, url string, destPath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	file, err := os.Create(destPath)
+	if err != nil {
+		return fmt.Errorf("failed to create dest file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		return fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return nil
+}
+
+func appendFile(dest *os.File, srcPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", srcPath, err)
+	}
+	defer src.Close()
+
+	if _, err := io.Copy(dest, src); err != nil {
+		return fmt.Errorf("failed to append %s: %w", srcPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"errors"
+	"reflect"
+	"sen1or/letslive/shared/pkg/hls"
+	"testing"
+)
+
+// segmentsFrom returns n segments of duration seconds, the first one starting at start
+func segmentsFrom(start float64, duration float64, n int) []hls.Segment {
+	segments := make([]hls.Segment, n)
+	for i := range segments {
+		segments[i] = hls.Segment{URL: "s", Start: start + float64(i)*duration, Duration: duration}
+	}
+	return segments
+}
+
+func TestClipSegments(t *testing.T) {
+	// a live window of five 2s segments from 200s, as listed by a playlist with a media sequence of 100
+	live := segmentsFrom(200, 2, 5)
+	vod := segmentsFrom(0, 4, 3)
+
+	tests := []struct {
+		name      string
+		segments  []hls.Segment
+		start     float64
+		end       float64
+		live      bool
+		want      []hls.Segment
+		wantErr   bool
+		notYetErr bool // errClipRangeNotAvailable, the clip is retried later
+	}{
+		{name: "range on segment boundaries", segments: live, start: 202, end: 206, live: true, want: live[1:3]},
+		{name: "range across segment boundaries", segments: live, start: 203, end: 205, live: true, want: live[1:3]},
+		{name: "start just before a boundary is within tolerance", segments: live, start: 201.97, end: 204, live: true, want: live[1:2]},
+		{name: "end just after a boundary is within tolerance", segments: live, start: 202, end: 204.04, live: true, want: live[1:2]},
+		{name: "whole window", segments: live, start: 200, end: 210, live: true, want: live},
+		{name: "end just past the window is within tolerance", segments: live, start: 206, end: 210.04, live: true, want: live[3:]},
+		{name: "start left the dvr window", segments: live, start: 198, end: 204, live: true, wantErr: true},
+		{name: "end not published yet", segments: live, start: 206, end: 212, live: true, wantErr: true, notYetErr: true},
+		{name: "no segment in a live window", segments: nil, start: 0, end: 10, live: true, wantErr: true},
+		{name: "vod range", segments: vod, start: 3, end: 9, want: vod},
+		{name: "vod range ends after the source", segments: vod, start: 4, end: 13, wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := clipSegments(test.segments, test.start, test.end, test.live)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: clipSegments() = %+v, want an error", test.name, got)
+			} else if errors.Is(err, errClipRangeNotAvailable) != test.notYetErr {
+				t.Errorf("%s: clipSegments() error = %v, retried later = %v, want %v", test.name, err, !test.notYetErr, test.notYetErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: clipSegments() error = %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: clipSegments() = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package worker
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+)
+
+// HandleEvent is the eventbus.EventHandler waking the worker up when the vod service queues a job or a clip
+func (w *TranscodeWorker) HandleEvent(ctx context.Context, event eventbus.Event) error {
+	switch event.Type {
+	case events.VODTranscodeJobQueued, events.VODClipJobQueued:
+		w.Notify()
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/transcode/domains"
+	voddto "sen1or/letslive/transcode/gateway/vod/dto"
+	"sync"
+	"time"
+)
+
+// how often the progress of a running job is sent to the vod service
+const progressReportInterval = 5 * time.Second
+
+// transcodeProgress is updated from the ffmpeg output and read by reportProgress
+type transcodeProgress struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	duration  time.Duration // probed duration of the input, 0 when unknown
+	outTime   time.Duration
+}
+
+func newTranscodeProgress(duration time.Duration) *transcodeProgress {
+	return &transcodeProgress{
+		startedAt: time.Now(),
+		duration:  duration,
+	}
+}
+
+func (p *transcodeProgress) update(outTime time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.outTime = outTime
+}
+
+// snapshot returns the percent done and the estimated seconds left, the estimate is nil until some output was produced,
+// ok is false when the input duration is unknown
+func (p *transcodeProgress) snapshot() (percent float64, etaSeconds *int, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.duration <= 0 {
+		return 0, nil, false
+	}
+
+	fraction := min(float64(p.outTime)/float64(p.duration), 1)
+	if fraction <= 0 {
+		return 0, nil, true
+	}
+
+	// assumes the remaining part of the input is transcoded at the speed observed so far
+	eta := int(math.Ceil(time.Since(p.startedAt).Seconds() * (1 - fraction) / fraction))
+	return math.Round(fraction*1000) / 10, &eta, true
+}
+
+// reportProgress sends the progress of the job to the vod service until ctx is done
+func (w *TranscodeWorker) reportProgress(ctx context.Context, job *domains.TranscodeJob, progress *transcodeProgress) {
+	ticker := time.NewTicker(progressReportInterval)
+	defer ticker.Stop()
+
+	lastPercent := -1.0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		percent, etaSeconds, ok := progress.snapshot()
+		if !ok {
+			return
+		}
+		if percent == lastPercent {
+			continue
+		}
+
+		err := w.vodGateway.ReportTranscodeJobProgress(ctx, job.Id, voddto.ReportTranscodeJobProgressRequestDTO{
+			LeaseId:    job.LeaseId,
+			Progress:   percent,
+			EtaSeconds: etaSeconds,
+		})
+		if err != nil {
+			// a lost job is stopped by keepLease
+			if !errors.Is(err, domains.ErrTranscodeJobNotProcessing) && ctx.Err() == nil {
+				logger.Warnf(ctx, "worker: failed to report progress of job %s: %v", job.Id, err)
+			}
+			continue
+		}
+		lastPercent = percent
+	}
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTranscodeProgressSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		duration    time.Duration
+		outTime     time.Duration
+		elapsed     time.Duration
+		wantPercent float64
+		wantEta     *int
+		wantOk      bool
+	}{
+		{name: "unknown duration", duration: 0, outTime: 5 * time.Second, elapsed: 5 * time.Second},
+		{name: "nothing transcoded yet", duration: 100 * time.Second, elapsed: 3 * time.Second, wantOk: true},
+		{name: "half way", duration: 100 * time.Second, outTime: 50 * time.Second, elapsed: 9500 * time.Millisecond, wantPercent: 50, wantEta: ptr(10), wantOk: true},
+		{name: "percent is rounded to one decimal", duration: 3 * time.Second, outTime: time.Second, elapsed: 1900 * time.Millisecond, wantPercent: 33.3, wantEta: ptr(4), wantOk: true},
+		{name: "output past the probed duration", duration: 10 * time.Second, outTime: 11 * time.Second, elapsed: 5 * time.Second, wantPercent: 100, wantEta: ptr(0), wantOk: true},
+	}
+
+	for _, test := range tests {
+		progress := newTranscodeProgress(test.duration)
+		progress.startedAt = time.Now().Add(-test.elapsed)
+		progress.update(test.outTime)
+
+		percent, eta, ok := progress.snapshot()
+		if ok != test.wantOk || percent != test.wantPercent {
+			t.Errorf("%s: snapshot() = %v, ok %v, want %v, ok %v", test.name, percent, ok, test.wantPercent, test.wantOk)
+		}
+		if (eta == nil) != (test.wantEta == nil) || (eta != nil && *eta != *test.wantEta) {
+			t.Errorf("%s: snapshot() eta = %v, want %v", test.name, deref(eta), deref(test.wantEta))
+		}
+	}
+}
+
+func ptr(v int) *int {
+	return &v
+}
+
+func deref(v *int) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
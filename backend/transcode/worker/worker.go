@@ -8,52 +8,81 @@ import (
 	"io"
 	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/transcode/config"
 	"sen1or/letslive/transcode/domains"
-	"sen1or/letslive/shared/pkg/logger"
+	voddto "sen1or/letslive/transcode/gateway/vod/dto"
 	"sen1or/letslive/transcode/storage"
 	"sen1or/letslive/transcode/transcoder"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/minio/minio-go/v7"
 	miniocreds "github.com/minio/minio-go/v7/pkg/credentials"
 )
 
-type LivestreamGateway interface {
-	UpdateVODStatus(ctx context.Context, vodId string, status domains.VODStatus, playbackUrl string, thumbnailUrl string, duration *int64) error
+// VODGateway is the transcode job queue, owned by the vod service
+type VODGateway interface {
+	LeaseTranscodeJob(ctx context.Context) (*domains.TranscodeJob, error)
+	HeartbeatTranscodeJob(ctx context.Context, jobId string, data voddto.HeartbeatTranscodeJobRequestDTO) error
+	CompleteTranscodeJob(ctx context.Context, jobId string, data voddto.CompleteTranscodeJobRequestDTO) error
+	FailTranscodeJob(ctx context.Context, jobId string, data voddto.FailTranscodeJobRequestDTO) error
+	ReleaseTranscodeJob(ctx context.Context, jobId string, data voddto.ReleaseTranscodeJobRequestDTO) error
+	ReportTranscodeJobProgress(ctx context.Context, jobId string, data voddto.ReportTranscodeJobProgressRequestDTO) error
+	LeaseClipJob(ctx context.Context) (*domains.ClipJob, error)
+	CompleteClipJob(ctx context.Context, clipId string, data voddto.CompleteClipJobRequestDTO) error
+	FailClipJob(ctx context.Context, clipId string, data voddto.FailClipJobRequestDTO) error
 }
 
+const (
+	// used when the lease returned by the vod service is too short to derive the interval from
+	defaultHeartbeatInterval = 10 * time.Second
+	// bound of the call handing an interrupted job back, the lease expiring covers a failed one
+	releaseTimeout = 5 * time.Second
+)
+
+// TranscodeWorker runs up to Worker.Concurrency jobs at the same time, one per slot,
+// the slots are woken up by Notify when a job is queued and poll as a fallback
 type TranscodeWorker struct {
-	db                 *pgxpool.Pool
-	hlsStorage         storage.Storage
-	rawMinioClient     *minio.Client
-	rawMinioBucket     string
-	config             *config.Config
-	livestreamGateway  LivestreamGateway
-	stopChan           chan struct{}
+	hlsStorage     storage.Storage
+	rawMinioClient *minio.Client
+	rawMinioBucket string
+	config         *config.Config
+	vodGateway     VODGateway
+
+	wakeChan chan struct{}
+	stopChan chan struct{}
+	stopOnce sync.Once
+	slots    sync.WaitGroup
+
+	// the jobs do not use the context given to Start so the in-flight ones can finish once the service is stopping,
+	// abortJobs interrupts them when the shutdown timeout is over
+	jobsCtx   context.Context
+	abortJobs context.CancelFunc
 }
 
 func NewTranscodeWorker(
-	db *pgxpool.Pool,
 	hlsStorage storage.Storage,
 	rawMinioClient *minio.Client,
 	rawMinioBucket string,
 	cfg *config.Config,
-	livestreamGateway LivestreamGateway,
+	vodGateway VODGateway,
 ) *TranscodeWorker {
+	jobsCtx, abortJobs := context.WithCancel(context.Background())
 	return &TranscodeWorker{
-		db:                db,
-		hlsStorage:        hlsStorage,
-		rawMinioClient:    rawMinioClient,
-		rawMinioBucket:    rawMinioBucket,
-		config:            cfg,
-		livestreamGateway: livestreamGateway,
-		stopChan:          make(chan struct{}),
+		hlsStorage:     hlsStorage,
+		rawMinioClient: rawMinioClient,
+		rawMinioBucket: rawMinioBucket,
+		config:         cfg,
+		vodGateway:     vodGateway,
+		wakeChan:       make(chan struct{}, max(cfg.Worker.Concurrency, 1)),
+		stopChan:       make(chan struct{}),
+		jobsCtx:        jobsCtx,
+		abortJobs:      abortJobs,
 	}
 }
 
@@ -68,95 +97,170 @@ func NewRawMinIOClient(cfg config.MinIO) *minio.Client {
 	return client
 }
 
+// Start runs the slots in the background, they stop leasing jobs once ctx is done or Shutdown is called
 func (w *TranscodeWorker) Start(ctx context.Context) {
-	logger.Infof(ctx, "transcode worker started, polling every 5 seconds")
+	cfg := w.config.Worker
+	logger.Infof(ctx, "transcode worker started with %d slots, %d ffmpeg threads per job, polling every %d seconds",
+		cfg.Concurrency, cfg.FFMpegThreads, cfg.PollInterval)
+
+	for range max(cfg.Concurrency, 1) {
+		w.slots.Add(1)
+		go func() {
+			defer w.slots.Done()
+			w.runSlot(ctx)
+		}()
+	}
+}
+
+// Notify wakes an idle slot up to lease the job that was just queued, it is dropped when every slot is busy
+// since a slot looks for another job as soon as it is done
+func (w *TranscodeWorker) Notify() {
+	select {
+	case w.wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+// Shutdown stops leasing jobs and lets the in-flight ones finish until ctx is done,
+// the jobs still running then are interrupted and handed back to the queue
+func (w *TranscodeWorker) Shutdown(ctx context.Context) {
+	w.stopOnce.Do(func() { close(w.stopChan) })
+
+	done := make(chan struct{})
+	go func() {
+		w.slots.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		logger.Infof(ctx, "transcode worker stopped")
+	case <-ctx.Done():
+		logger.Warnf(ctx, "transcode worker shutdown timed out, handing the in-flight jobs back to the queue")
+		w.abortJobs()
+		<-done
+	}
+}
 
-	ticker := time.NewTicker(5 * time.Second)
+func (w *TranscodeWorker) runSlot(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(w.config.Worker.PollInterval) * time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			logger.Infof(ctx, "transcode worker stopping (context cancelled)")
 			return
 		case <-w.stopChan:
-			logger.Infof(ctx, "transcode worker stopping (stop signal)")
 			return
+		default:
+		}
+
+		// keep leasing while jobs are due, the slot only waits once the queue is empty, clips come after the uploads
+		if w.processNextJob(ctx) || w.processNextClipJob(ctx) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopChan:
+			return
+		case <-w.wakeChan:
 		case <-ticker.C:
-			w.processNextJob(ctx)
 		}
 	}
 }
 
-func (w *TranscodeWorker) Shutdown() {
-	close(w.stopChan)
-}
-
-func (w *TranscodeWorker) processNextJob(ctx context.Context) {
-	tx, err := w.db.BeginTx(ctx, pgx.TxOptions{})
+// processNextJob leases a job and runs it, it returns false when there was no job to lease
+func (w *TranscodeWorker) processNextJob(ctx context.Context) bool {
+	job, err := w.vodGateway.LeaseTranscodeJob(ctx)
 	if err != nil {
-		logger.Errorf(ctx, "worker: failed to begin tx: %v", err)
-		return
+		logger.Errorf(ctx, "worker: failed to lease transcode job: %v", err)
+		return false
+	}
+	if job == nil {
+		return false // no pending jobs
 	}
-	defer tx.Rollback(ctx)
 
-	// Poll for a pending job with row-level locking
-	var jobId, vodId string
-	var attempts, maxAttempts int
-	var originalFileURL *string
+	if job.OriginalFileURL == nil || *job.OriginalFileURL == "" {
+		logger.Errorf(ctx, "worker: vod %s has no original file URL", job.VodId)
+		w.markJobFailed(ctx, job, "no original file URL", false)
+		return true
+	}
 
-	err = tx.QueryRow(ctx, `
-		SELECT tj.id, tj.vod_id, tj.attempts, tj.max_attempts, v.original_file_url
-		FROM transcode_jobs tj
-		JOIN vods v ON v.id = tj.vod_id
-		WHERE tj.status = 'pending' AND tj.attempts < tj.max_attempts
-		ORDER BY tj.created_at ASC
-		LIMIT 1
-		FOR UPDATE OF tj SKIP LOCKED
-	`).Scan(&jobId, &vodId, &attempts, &maxAttempts, &originalFileURL)
+	logger.Infof(ctx, "worker: processing job %s for vod %s (attempt %d/%d)", job.Id, job.VodId, job.Attempts, job.MaxAttempts)
 
-	if err != nil {
-		if err == pgx.ErrNoRows {
-			tx.Rollback(ctx)
-			return // no pending jobs
-		}
-		logger.Errorf(ctx, "worker: failed to query pending job: %v", err)
-		return
+	// the job context is cancelled, killing ffmpeg, as soon as the job is taken from this worker
+	jobCtx, cancelJob := context.WithCancel(w.jobsCtx)
+	defer cancelJob()
+	go w.keepLease(jobCtx, cancelJob, job)
+
+	// Do the actual transcoding work
+	if err := w.doTranscode(jobCtx, job); err != nil {
+		logger.Errorf(ctx, "worker: transcode failed for job %s: %v", job.Id, err)
 	}
 
-	if originalFileURL == nil || *originalFileURL == "" {
-		logger.Errorf(ctx, "worker: vod %s has no original file URL", vodId)
-		w.failJob(ctx, tx, jobId, vodId, "no original file URL", attempts, maxAttempts)
-		return
+	if w.jobsCtx.Err() != nil {
+		w.releaseJob(ctx, job)
 	}
 
-	// Mark job as processing
-	_, err = tx.Exec(ctx, `UPDATE transcode_jobs SET status = 'processing', started_at = now(), attempts = attempts + 1, updated_at = now() WHERE id = $1`, jobId)
-	if err != nil {
-		logger.Errorf(ctx, "worker: failed to mark job processing: %v", err)
+	return true
+}
+
+// releaseJob hands a job interrupted by the shutdown back to the queue, a job that was completed in the meantime is left alone
+func (w *TranscodeWorker) releaseJob(ctx context.Context, job *domains.TranscodeJob) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+
+	err := w.vodGateway.ReleaseTranscodeJob(releaseCtx, job.Id, voddto.ReleaseTranscodeJobRequestDTO{LeaseId: job.LeaseId})
+	if err != nil && !errors.Is(err, domains.ErrTranscodeJobNotProcessing) {
+		logger.Errorf(ctx, "worker: failed to release job %s, it is retried once its lease expires: %v", job.Id, err)
 		return
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		logger.Errorf(ctx, "worker: failed to commit tx: %v", err)
-		return
+	logger.Infof(ctx, "worker: released job %s of vod %s back to the queue", job.Id, job.VodId)
+}
+
+// keepLease extends the lease of the job with heartbeats until ctx is done, it cancels the job
+// once the vod service reports the job was cancelled or its lease expired and it went back to the queue
+func (w *TranscodeWorker) keepLease(ctx context.Context, cancelJob context.CancelFunc, job *domains.TranscodeJob) {
+	// a third of the lease leaves room for two failed heartbeats before the job is reaped
+	interval := time.Until(job.LeaseExpiresAt) / 3
+	if interval < time.Second {
+		interval = defaultHeartbeatInterval
 	}
 
-	logger.Infof(ctx, "worker: processing job %s for vod %s", jobId, vodId)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	// Do the actual transcoding work
-	if err := w.doTranscode(ctx, jobId, vodId, *originalFileURL, attempts+1, maxAttempts); err != nil {
-		logger.Errorf(ctx, "worker: transcode failed for job %s: %v", jobId, err)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := w.vodGateway.HeartbeatTranscodeJob(ctx, job.Id, voddto.HeartbeatTranscodeJobRequestDTO{LeaseId: job.LeaseId})
+		if errors.Is(err, domains.ErrTranscodeJobNotProcessing) {
+			logger.Infof(ctx, "worker: job %s was cancelled or lost its lease, stopping it", job.Id)
+			cancelJob()
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			logger.Warnf(ctx, "worker: failed to send heartbeat for job %s: %v", job.Id, err)
+		}
 	}
 }
 
-func (w *TranscodeWorker) doTranscode(ctx context.Context, jobId, vodId, rawFileURL string, currentAttempt, maxAttempts int) error {
+func (w *TranscodeWorker) doTranscode(ctx context.Context, job *domains.TranscodeJob) error {
+	jobId, vodId, rawFileURL := job.Id, job.VodId, *job.OriginalFileURL
+
 	// Extract the object path from the raw file URL
 	// URL format: http://host:port/bucket/raw-videos/vodId/filename
 	objectName := extractObjectName(rawFileURL, w.rawMinioBucket)
 	if objectName == "" {
 		errMsg := "failed to extract object name from URL"
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, false)
 		return errors.New(errMsg)
 	}
 
@@ -164,7 +268,7 @@ func (w *TranscodeWorker) doTranscode(ctx context.Context, jobId, vodId, rawFile
 	tempDir, err := os.MkdirTemp("", fmt.Sprintf("transcode-%s-*", vodId))
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to create temp dir: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, true)
 		return errors.New(errMsg)
 	}
 	defer os.RemoveAll(tempDir)
@@ -173,7 +277,7 @@ func (w *TranscodeWorker) doTranscode(ctx context.Context, jobId, vodId, rawFile
 	rawFilePath := filepath.Join(tempDir, "input"+filepath.Ext(objectName))
 	if err := w.downloadFromMinIO(ctx, objectName, rawFilePath); err != nil {
 		errMsg := fmt.Sprintf("failed to download raw file: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, true)
 		return errors.New(errMsg)
 	}
 
@@ -181,14 +285,28 @@ func (w *TranscodeWorker) doTranscode(ctx context.Context, jobId, vodId, rawFile
 	outputDir := filepath.Join(tempDir, "hls")
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		errMsg := fmt.Sprintf("failed to create output dir: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, true)
 		return errors.New(errMsg)
 	}
 
-	_, thumbnailPath, err := transcoder.TranscodeFile(ctx, w.config.Transcode, rawFilePath, outputDir)
+	// the progress is relative to the probed duration, it is not reported when the duration is unknown
+	inputDuration, probeErr := transcoder.ProbeDuration(ctx, w.config.Transcode.FFMpegSetting.FFProbePath, rawFilePath)
+	if probeErr != nil {
+		logger.Warnf(ctx, "worker: failed to probe the duration of vod %s, its progress will not be reported: %v", vodId, probeErr)
+	}
+	progress := newTranscodeProgress(inputDuration)
+
+	reportCtx, stopReporting := context.WithCancel(ctx)
+	go w.reportProgress(reportCtx, job, progress)
+
+	_, thumbnailPath, err := transcoder.TranscodeFile(ctx, w.config.Transcode, rawFilePath, outputDir, transcoder.FileTranscodeOptions{
+		Threads:    w.config.Worker.FFMpegThreads,
+		OnProgress: progress.update,
+	})
+	stopReporting()
 	if err != nil {
 		errMsg := fmt.Sprintf("ffmpeg transcode failed: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, true)
 		return errors.New(errMsg)
 	}
 
@@ -199,36 +317,88 @@ func (w *TranscodeWorker) doTranscode(ctx context.Context, jobId, vodId, rawFile
 		durationPtr = &duration
 	}
 
+	// previews and thumbnail candidates are optional, a vod without them is still playable
+	previewDuration := inputDuration
+	if previewDuration <= 0 && durationPtr != nil {
+		previewDuration = time.Duration(*durationPtr) * time.Second
+	}
+
+	spritesDir := filepath.Join(tempDir, "sprites")
+	previewTrackPath, spritesErr := transcoder.GeneratePreviewSprites(ctx, w.config.Transcode, rawFilePath, spritesDir, previewDuration, w.config.Worker.FFMpegThreads)
+	if spritesErr != nil {
+		logger.Warnf(ctx, "worker: failed to generate preview sprites for vod %s: %v", vodId, spritesErr)
+	}
+
+	candidatePaths, candidatesErr := transcoder.GenerateThumbnailCandidates(ctx, w.config.Transcode.FFMpegSetting.FFMpegPath, rawFilePath, filepath.Join(tempDir, "thumbnails"), previewDuration, w.config.Worker.FFMpegThreads)
+	if candidatesErr != nil {
+		logger.Warnf(ctx, "worker: failed to generate thumbnail candidates for vod %s: %v", vodId, candidatesErr)
+	}
+
+	// the vod may have been deleted while ffmpeg was running, do not upload files nobody will clean up
+	if err := w.vodGateway.HeartbeatTranscodeJob(ctx, jobId, voddto.HeartbeatTranscodeJobRequestDTO{LeaseId: job.LeaseId}); err != nil {
+		if errors.Is(err, domains.ErrTranscodeJobNotProcessing) {
+			logger.Infof(ctx, "worker: job %s was cancelled, dropping the output of vod %s", jobId, vodId)
+			return nil
+		}
+		logger.Warnf(ctx, "worker: failed to check job %s before uploading, uploading anyway: %v", jobId, err)
+	}
+
 	// Upload HLS segments and playlists to MinIO
 	playbackURL, err := w.uploadHLSToStorage(ctx, vodId, outputDir)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to upload HLS segments: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
+		w.markJobFailed(ctx, job, errMsg, true)
 		return errors.New(errMsg)
 	}
 
 	// Upload thumbnail
-	var thumbnailURL string
+	var thumbnailURL *string
 	if _, err := os.Stat(thumbnailPath); err == nil {
 		savedPath, uploadErr := w.hlsStorage.AddThumbnail(ctx, thumbnailPath, vodId, "image/jpeg")
 		if uploadErr != nil {
 			logger.Warnf(ctx, "worker: failed to upload thumbnail for vod %s: %v", vodId, uploadErr)
 		} else {
-			thumbnailURL = savedPath
+			thumbnailURL = &savedPath
 		}
 	}
 
-	// Update VOD status to ready via livestream gateway
-	if err := w.livestreamGateway.UpdateVODStatus(ctx, vodId, domains.VODStatusReady, playbackURL, thumbnailURL, durationPtr); err != nil {
-		errMsg := fmt.Sprintf("failed to update VOD status: %v", err)
-		w.markJobFailed(ctx, jobId, vodId, errMsg, currentAttempt, maxAttempts)
-		return errors.New(errMsg)
+	// a candidate that failed to upload is left out, the owner picks among the others
+	var thumbnailCandidateURLs []string
+	for _, candidatePath := range candidatePaths {
+		savedPath, uploadErr := w.hlsStorage.AddThumbnail(ctx, candidatePath, path.Join(vodId, "thumbnails"), "image/jpeg")
+		if uploadErr != nil {
+			logger.Warnf(ctx, "worker: failed to upload thumbnail candidate %s for vod %s: %v", filepath.Base(candidatePath), vodId, uploadErr)
+			continue
+		}
+		thumbnailCandidateURLs = append(thumbnailCandidateURLs, savedPath)
 	}
 
-	// Mark job completed
-	_, err = w.db.Exec(ctx, `UPDATE transcode_jobs SET status = 'completed', completed_at = now(), updated_at = now() WHERE id = $1`, jobId)
-	if err != nil {
-		logger.Errorf(ctx, "worker: failed to mark job completed: %v", err)
+	var previewTrackURL *string
+	if spritesErr == nil {
+		savedPath, uploadErr := w.uploadPreviewSprites(ctx, vodId, spritesDir, previewTrackPath)
+		if uploadErr != nil {
+			logger.Warnf(ctx, "worker: failed to upload preview sprites for vod %s: %v", vodId, uploadErr)
+		} else {
+			previewTrackURL = &savedPath
+		}
+	}
+
+	// Mark the job completed and the VOD ready
+	if err := w.vodGateway.CompleteTranscodeJob(ctx, jobId, voddto.CompleteTranscodeJobRequestDTO{
+		LeaseId:                job.LeaseId,
+		PlaybackUrl:            playbackURL,
+		ThumbnailUrl:           thumbnailURL,
+		ThumbnailCandidateUrls: thumbnailCandidateURLs,
+		PreviewTrackUrl:        previewTrackURL,
+		Duration:               durationPtr,
+	}); err != nil {
+		if errors.Is(err, domains.ErrTranscodeJobNotProcessing) {
+			logger.Infof(ctx, "worker: job %s was cancelled before it could be completed", jobId)
+			return nil
+		}
+		errMsg := fmt.Sprintf("failed to complete transcode job: %v", err)
+		w.markJobFailed(ctx, job, errMsg, true)
+		return errors.New(errMsg)
 	}
 
 	// Clean up raw file from MinIO
@@ -322,31 +492,41 @@ func (w *TranscodeWorker) uploadHLSToStorage(ctx context.Context, vodId, outputD
 	return masterPlaylistURL, nil
 }
 
-func (w *TranscodeWorker) markJobFailed(ctx context.Context, jobId, vodId, errMsg string, currentAttempt, maxAttempts int) {
-	if currentAttempt >= maxAttempts {
-		// Max attempts reached, mark as failed permanently
-		_, err := w.db.Exec(ctx, `UPDATE transcode_jobs SET status = 'failed', error_message = $1, updated_at = now() WHERE id = $2`, errMsg, jobId)
-		if err != nil {
-			logger.Errorf(ctx, "worker: failed to mark job as failed: %v", err)
-		}
-		// Mark VOD as failed too
-		w.livestreamGateway.UpdateVODStatus(ctx, vodId, domains.VODStatusFailed, "", "", nil)
-	} else {
-		// Reset to pending for retry
-		_, err := w.db.Exec(ctx, `UPDATE transcode_jobs SET status = 'pending', error_message = $1, updated_at = now() WHERE id = $2`, errMsg, jobId)
-		if err != nil {
-			logger.Errorf(ctx, "worker: failed to reset job to pending: %v", err)
+// uploadPreviewSprites uploads the sprite sheets next to their track under {vodId}/sprites and returns the track url
+func (w *TranscodeWorker) uploadPreviewSprites(ctx context.Context, vodId, spritesDir, trackPath string) (string, error) {
+	sheets, err := filepath.Glob(filepath.Join(spritesDir, "sprite_*.jpg"))
+	if err != nil {
+		return "", err
+	}
+
+	folder := path.Join(vodId, "sprites")
+	for _, sheet := range sheets {
+		if _, err := w.hlsStorage.AddThumbnail(ctx, sheet, folder, "image/jpeg"); err != nil {
+			return "", fmt.Errorf("failed to upload sprite sheet %s: %w", filepath.Base(sheet), err)
 		}
 	}
+
+	// the track is uploaded last so it never points to missing sheets
+	return w.hlsStorage.AddThumbnail(ctx, trackPath, folder, "text/vtt")
 }
 
-func (w *TranscodeWorker) failJob(ctx context.Context, tx pgx.Tx, jobId, vodId, errMsg string, attempts, maxAttempts int) {
-	_, err := tx.Exec(ctx, `UPDATE transcode_jobs SET status = 'failed', error_message = $1, attempts = attempts + 1, updated_at = now() WHERE id = $2`, errMsg, jobId)
-	if err != nil {
-		logger.Errorf(ctx, "worker: failed to mark job as failed: %v", err)
+// markJobFailed reports a failed attempt, the vod service retries the job after a backoff
+// or fails it with its vod once it is not retryable or has no attempt left
+func (w *TranscodeWorker) markJobFailed(ctx context.Context, job *domains.TranscodeJob, errMsg string, retryable bool) {
+	// nothing to report when the job was taken from this worker,
+	// a job interrupted by the shutdown is released by processNextJob instead
+	if ctx.Err() != nil {
+		return
+	}
+
+	err := w.vodGateway.FailTranscodeJob(ctx, job.Id, voddto.FailTranscodeJobRequestDTO{
+		LeaseId:      job.LeaseId,
+		ErrorMessage: errMsg,
+		Retryable:    retryable,
+	})
+	if err != nil && !errors.Is(err, domains.ErrTranscodeJobNotProcessing) {
+		logger.Errorf(ctx, "worker: failed to report failure of job %s: %v", job.Id, err)
 	}
-	tx.Commit(ctx)
-	w.livestreamGateway.UpdateVODStatus(ctx, vodId, domains.VODStatusFailed, "", "", nil)
 }
 
 func getHLSDurationSeconds(outputDir string) (int64, error) {
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"sen1or/letslive/user/config"
+	blockhandler "sen1or/letslive/user/handlers/block"
 	"sen1or/letslive/user/handlers/follow"
 	"sen1or/letslive/user/handlers/general"
 	gifthandler "sen1or/letslive/user/handlers/gift"
 	inventoryhandler "sen1or/letslive/user/handlers/inventory"
+	searchhandler "sen1or/letslive/user/handlers/search"
 	"sen1or/letslive/user/handlers/livestream_information"
 	"sen1or/letslive/user/handlers/notification"
 	"sen1or/letslive/user/handlers/user"
@@ -34,9 +36,11 @@ type APIServer struct {
 	notificationHandler          *notification.NotificationHandler
 	inventoryHandler             *inventoryhandler.InventoryHandler
 	giftHandler                  *gifthandler.GiftHandler
+	searchHandler                *searchhandler.SearchHandler
+	blockHandler                 *blockhandler.BlockHandler
 }
 
-func NewAPIServer(userHandler *user.UserHandler, livestreamInfoHandler *livestream_information.LivestreamInformationHandler, followHandler *follow.FollowHandler, notificationHandler *notification.NotificationHandler, invHandler *inventoryhandler.InventoryHandler, gHandler *gifthandler.GiftHandler, cfg *config.Config, db *pgxpool.Pool) *APIServer {
+func NewAPIServer(userHandler *user.UserHandler, livestreamInfoHandler *livestream_information.LivestreamInformationHandler, followHandler *follow.FollowHandler, notificationHandler *notification.NotificationHandler, invHandler *inventoryhandler.InventoryHandler, gHandler *gifthandler.GiftHandler, sHandler *searchhandler.SearchHandler, bHandler *blockhandler.BlockHandler, cfg *config.Config, db *pgxpool.Pool) *APIServer {
 	return &APIServer{
 		logger: logger.Logger,
 		config: cfg,
@@ -48,6 +52,8 @@ func NewAPIServer(userHandler *user.UserHandler, livestreamInfoHandler *livestre
 		notificationHandler:          notificationHandler,
 		inventoryHandler:             invHandler,
 		giftHandler:                  gHandler,
+		searchHandler:                sHandler,
+		blockHandler:                 bHandler,
 	}
 }
 
@@ -62,11 +68,18 @@ func (a *APIServer) getHandler() http.Handler {
 
 	wrap("GET /v1/users/recommendations", a.userHandler.GetRecommendedChannelsPublicHandler)
 	wrap("GET /v1/users/search", a.userHandler.SearchUsersPublicHandler)
+	wrap("GET /v1/search", a.searchHandler.SearchPublicHandler)
 	wrap("GET /v1/user/me/following", a.userHandler.GetFollowingChannelsPrivateHandler)
+	wrap("GET /v1/user/me/following/feed", a.followHandler.GetFollowingFeedPrivateHandler)
 	wrap("GET /v1/user/{userId}", a.userHandler.GetUserByIdPublicHandler)
 
 	wrap("POST /v1/user/{userId}/follow", a.followHandler.FollowPrivateHandler)
 	wrap("DELETE /v1/user/{userId}/unfollow", a.followHandler.UnfollowPrivateHandler)
+	wrap("GET /v1/internal/users/{userId}/affinity", a.followHandler.GetViewerAffinityInternalHandler) // internal
+	wrap("POST /v1/user/{userId}/block", a.blockHandler.BlockPrivateHandler)
+	wrap("DELETE /v1/user/{userId}/unblock", a.blockHandler.UnblockPrivateHandler)
+	wrap("GET /v1/user/me/blocks", a.blockHandler.GetBlockedUsersPrivateHandler)
+	wrap("GET /v1/internal/users/{userId}/blocks/{blockedUserId}", a.blockHandler.IsBlockedInternalHandler) // internal
 	wrap("GET /v1/user/me", a.userHandler.GetCurrentUserPrivateHandler)
 	wrap("PUT /v1/user/me", a.userHandler.UpdateCurrentUserPrivateHandler)
 	wrap("PATCH /v1/user/me/livestream-information", a.livestreamInformationHandler.UpdatePrivateHandler)
@@ -95,6 +108,7 @@ func (a *APIServer) getHandler() http.Handler {
 
 	wrap("POST /v1/user", a.userHandler.CreateUserInternalHandler)                        // internal
 	wrap("PUT /v1/user/{userId}", a.userHandler.UpdateUserInternalHandler)                // internal
+	wrap("DELETE /v1/internal/users/{userId}", a.userHandler.DeleteUserInternalHandler)   // internal
 	wrap("GET /v1/verify-stream-key", a.userHandler.GetUserByStreamAPIKeyInternalHandler) // internal
 
 	wrap("GET /v1/health", a.generalHandler.RouteServiceHealth)
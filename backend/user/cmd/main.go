@@ -10,10 +10,15 @@ import (
 
 	"sen1or/letslive/user/api"
 	cfg "sen1or/letslive/user/config"
+	chathttp "sen1or/letslive/user/gateway/chat/http"
 	financehttp "sen1or/letslive/user/gateway/finance/http"
+	livestreamhttp "sen1or/letslive/user/gateway/livestream/http"
+	vodhttp "sen1or/letslive/user/gateway/vod/http"
+	blockhandler "sen1or/letslive/user/handlers/block"
 	"sen1or/letslive/user/handlers/follow"
 	gifthandler "sen1or/letslive/user/handlers/gift"
 	inventoryhandler "sen1or/letslive/user/handlers/inventory"
+	searchhandler "sen1or/letslive/user/handlers/search"
 	"sen1or/letslive/user/handlers/livestream_information"
 	notificationhandler "sen1or/letslive/user/handlers/notification"
 	"sen1or/letslive/user/handlers/user"
@@ -22,6 +27,8 @@ import (
 
 	sharedconfig "sen1or/letslive/shared/config"
 	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/eventbus/natsbus"
 	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/shared/pkg/tracer"
 	sharedutils "sen1or/letslive/shared/utils"
@@ -35,6 +42,8 @@ var (
 
 	shutdownTimeout            = 15 * time.Second
 	discoveryDeregisterTimeout = 10 * time.Second
+
+	donationConsumerGroup = "user-donations"
 )
 
 func main() {
@@ -71,7 +80,8 @@ func main() {
 	dbConn := sharedutils.ConnectDB(ctx, config.Database.ConnectionString)
 	defer dbConn.Close()
 
-	server := SetupServer(ctx, dbConn, registry, config)
+	server, donationService := SetupServer(ctx, dbConn, registry, config)
+	go subscribeToDonations(ctx, config.EventBus, donationService)
 	go func() {
 		logger.Infof(ctx, "starting server on %s:%d...", config.Service.Hostname, config.Service.APIPort)
 		// ListenAndServe should ideally block until an error occurs (e.g., server stopped)
@@ -115,22 +125,56 @@ func main() {
 	logger.Infof(shutdownCtx, "service shut down complete.")
 }
 
-func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) *api.APIServer {
+// subscribeToDonations notifies creators about the tips they receive until ctx is done
+func subscribeToDonations(ctx context.Context, cfg cfg.EventBus, donationService *services.DonationService) {
+	if cfg.URL == "" {
+		logger.Warnf(ctx, "eventBus.url is not set, donations will not be notified")
+		return
+	}
+
+	admin, err := natsbus.NewAdmin(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to connect to the event bus, donations will not be notified: %v", err)
+		return
+	}
+	if err := admin.EnsureTopics(ctx, events.DefaultTopics()); err != nil {
+		logger.Errorf(ctx, "failed to ensure event bus topics: %v", err)
+	}
+	admin.Close()
+
+	consumer, err := natsbus.NewConsumer(ctx, cfg.URL, donationConsumerGroup)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the event consumer, donations will not be notified: %v", err)
+		return
+	}
+	defer consumer.Close()
+
+	if err := consumer.Subscribe(ctx, []string{events.TopicFinance}, donationService.HandleEvent); err != nil {
+		logger.Errorf(ctx, "donation subscription stopped: %v", err)
+	}
+}
+
+func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) (*api.APIServer, *services.DonationService) {
 	var userRepo = repositories.NewUserRepository(dbConn)
 	var livestreamInfoRepo = repositories.NewLivestreamInformationRepository(dbConn)
 	var followRepo = repositories.NewFollowRepository(dbConn)
 	var notificationRepo = repositories.NewNotificationRepository(dbConn)
 	var inventoryRepo = repositories.NewInventoryRepository(dbConn)
 	var giftRepo = repositories.NewGiftRepository(dbConn)
+	var blockRepo = repositories.NewUserBlockRepository(dbConn)
 
 	minioService := services.NewMinIOService(ctx, cfg.MinIO)
-	var userService = services.NewUserService(userRepo, livestreamInfoRepo, notificationRepo, followRepo, *minioService)
+	var financeGateway = financehttp.NewFinanceGateway(registry)
+	var userService = services.NewUserService(userRepo, livestreamInfoRepo, notificationRepo, followRepo, *minioService, financeGateway)
 	var livestreamInfoService = services.NewLivestreamInformationService(livestreamInfoRepo)
-	var followService = services.NewFollowService(followRepo)
-	var notificationService = services.NewNotificationService(notificationRepo)
+	var livestreamGateway = livestreamhttp.NewLivestreamGateway(registry)
+	var vodGateway = vodhttp.NewVODGateway(registry)
+	var followService = services.NewFollowService(followRepo, blockRepo, livestreamGateway, vodGateway)
+	var searchService = services.NewSearchService(userRepo, livestreamGateway, vodGateway)
+	var notificationService = services.NewNotificationService(notificationRepo, blockRepo)
 	var inventoryService = services.NewInventoryService(inventoryRepo)
-	var financeGateway = financehttp.NewFinanceGateway(registry)
-	var giftService = services.NewGiftService(giftRepo, inventoryRepo, userRepo, financeGateway, notificationService)
+	var giftService = services.NewGiftService(giftRepo, inventoryRepo, userRepo, blockRepo, financeGateway, notificationService)
+	var donationService = services.NewDonationService(userRepo, notificationService, chathttp.NewChatGateway(registry))
 
 	var userHandler = user.NewUserHandler(*userService)
 	var livestreamInfoHandler = livestream_information.NewLivestreamInformationHandler(*livestreamInfoService, *minioService)
@@ -138,5 +182,8 @@ func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.R
 	var notifHandler = notificationhandler.NewNotificationHandler(*notificationService)
 	var invHandler = inventoryhandler.NewInventoryHandler(inventoryService)
 	var gHandler = gifthandler.NewGiftHandler(giftService)
-	return api.NewAPIServer(userHandler, livestreamInfoHandler, followHandler, notifHandler, invHandler, gHandler, cfg, dbConn)
+	var blockService = services.NewUserBlockService(blockRepo, userRepo)
+	var sHandler = searchhandler.NewSearchHandler(searchService)
+	var bHandler = blockhandler.NewBlockHandler(blockService)
+	return api.NewAPIServer(userHandler, livestreamInfoHandler, followHandler, notifHandler, invHandler, gHandler, sHandler, bHandler, cfg, dbConn), donationService
 }
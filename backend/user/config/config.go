@@ -31,11 +31,17 @@ type Database struct {
 	ConnectionString string
 }
 
+// EventBus is the NATS server the donations are consumed from, no donation is notified when URL is empty
+type EventBus struct {
+	URL string `yaml:"url"`
+}
+
 type Config struct {
 	Service  `yaml:"service"`
 	Database `yaml:"database"`
 	MinIO    `yaml:"minio"`
 	Tracer   `yaml:"tracer"`
+	EventBus `yaml:"eventBus"`
 }
 
 type Tracer struct {
@@ -14,8 +14,16 @@ type Follower struct {
 	FollowedAt time.Time `json:"createdAt" db:"created_at"`
 }
 
+// ViewerAffinity is what the recommendations of the livestream and vod services know about a viewer
+type ViewerAffinity struct {
+	FollowedUserIds []uuid.UUID        `json:"followedUserIds"`
+	Categories      map[string]float64 `json:"categories"` // share of the followed channels streaming in each category
+}
+
 type FollowRepository interface {
 	FollowUser(ctx context.Context, followUser, followedUser uuid.UUID) *response.Response[any]
 	UnfollowUser(ctx context.Context, followUser, followedUser uuid.UUID) *response.Response[any]
 	GetFollowedUserIds(ctx context.Context, followerId uuid.UUID) ([]uuid.UUID, *response.Response[any])
+	// GetFollowedCategoryCounts counts the followed channels by the category set in their livestream information
+	GetFollowedCategoryCounts(ctx context.Context, followerId uuid.UUID) (map[string]int, *response.Response[any])
 }
@@ -12,6 +12,8 @@ type LivestreamInformation struct {
 	Title        *string   `db:"title,omitempty" json:"title"`
 	Description  *string   `db:"description,omitempty" json:"description"`
 	ThumbnailURL *string   `db:"thumbnail_url,omitempty" json:"thumbnailUrl"`
+	Category     *string   `db:"category,omitempty" json:"category"` // slug of a category of the livestream service
+	Tags         []string  `db:"tags,omitempty" json:"tags"`
 }
 
 type LivestreamInformationRepository interface {
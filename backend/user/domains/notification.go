@@ -8,7 +8,10 @@ import (
 	"github.com/gofrs/uuid/v5"
 )
 
-const NotificationTypeGiftReceived = "gift_received"
+const (
+	NotificationTypeGiftReceived     = "gift_received"
+	NotificationTypeDonationReceived = "donation_received"
+)
 
 type Notification struct {
 	Id          uuid.UUID  `json:"id" db:"id"`
@@ -2,6 +2,7 @@ package domains
 
 import (
 	"context"
+	"sen1or/letslive/shared/pkg/search"
 	"sen1or/letslive/user/dto"
 	"sen1or/letslive/user/response"
 	"time"
@@ -51,6 +52,7 @@ type AuthProvider string
 const (
 	AuthProviderLocal  AuthProvider = "local"
 	AuthProviderGoogle AuthProvider = "google"
+	AuthProviderOIDC   AuthProvider = "oidc" // any provider configured in the auth service's generic OIDC layer
 )
 
 type UserRepository interface {
@@ -65,10 +67,13 @@ type UserRepository interface {
 	GetPublicInfosByIds(ctx context.Context, ids []uuid.UUID, authenticatedUserId *uuid.UUID) ([]dto.GetUserPublicResponseDTO, *response.Response[any])
 	GetRecommendedPublic(ctx context.Context, excludeUserId *uuid.UUID, page, limit int) ([]dto.GetUserPublicResponseDTO, *response.Response[any])
 	SearchUsersByUsername(ctx context.Context, username string, authenticatedUserId *uuid.UUID) ([]dto.GetUserPublicResponseDTO, *response.Response[any])
+	// SearchUsers matches the query against the usernames and bios in the language, and fuzzily against the usernames
+	SearchUsers(ctx context.Context, query string, language search.Language, authenticatedUserId *uuid.UUID, page int, limit int) ([]dto.GetUserPublicResponseDTO, *response.Response[any])
 
 	Create(ctx context.Context, username string, email string, authProvider AuthProvider) (*User, *response.Response[any])
 	Update(ctx context.Context, user dto.UpdateUserRequestDTO) (*User, *response.Response[any])
 	UpdateStreamAPIKey(ctx context.Context, userId uuid.UUID, newKey string) *response.Response[any]
 	UpdateProfilePicture(ctx context.Context, userId uuid.UUID, newProfilePictureURL string) *response.Response[any]
 	UpdateBackgroundPicture(ctx context.Context, userId uuid.UUID, newBackgroundPictureURL string) *response.Response[any]
+	Delete(ctx context.Context, userId uuid.UUID) *response.Response[any]
 }
@@ -0,0 +1,20 @@
+package domains
+
+import (
+	"context"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type UserBlockRepository interface {
+	// Block also removes the follows between the two users, blocking again does nothing
+	Block(ctx context.Context, blockerId, blockedId uuid.UUID) *response.Response[any]
+	Unblock(ctx context.Context, blockerId, blockedId uuid.UUID) *response.Response[any]
+	// GetBlockedUserIds returns the users the blocker blocked, the latest blocked first
+	GetBlockedUserIds(ctx context.Context, blockerId uuid.UUID) ([]uuid.UUID, *response.Response[any])
+	// IsBlocked is true when the blocker blocked the other user
+	IsBlocked(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, *response.Response[any])
+	// IsBlockedEitherWay is true when one of the users blocked the other
+	IsBlockedEitherWay(ctx context.Context, userId, otherUserId uuid.UUID) (bool, *response.Response[any])
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "encoding/json"
+
+type FollowingFeedItemType string
+
+const (
+	FollowingFeedLivestreamItem FollowingFeedItemType = "livestream"
+	FollowingFeedVODItem        FollowingFeedItemType = "vod"
+)
+
+// FollowingFeedItemDTO is a livestream or a vod of a followed channel, as the livestream or the vod service returned it
+type FollowingFeedItemDTO struct {
+	Type       FollowingFeedItemType `json:"type"`
+	Livestream json.RawMessage       `json:"livestream,omitempty"`
+	VOD        json.RawMessage       `json:"vod,omitempty"`
+}
@@ -22,7 +22,17 @@ type GetUserPublicResponseDTO struct {
 	// whether or not the current fetching user is following the fetched user
 	IsFollowing *bool `json:"isFollowing,omitempty"`
 
+	// the subscription of the current fetching user to the fetched user's channel, if any
+	Subscription *SubscriberBadgeDTO `json:"subscription,omitempty"`
+
 	LivestreamInformation `json:"livestreamInformation"`
 	SocialMediaLinks      *SocialMediaLinks `json:"socialMediaLinks,omitempty"`
 	SocialLinksJSON       string            `json:"-"`
 }
+
+type SubscriberBadgeDTO struct {
+	TierId   string `json:"tierId"`
+	TierName string `json:"tierName"`
+	Months   int    `json:"months"`
+	Status   string `json:"status"`
+}
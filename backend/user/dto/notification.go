@@ -2,6 +2,7 @@ package dto
 
 type CreateNotificationRequestDTO struct {
 	UserId      string  `json:"userId" validate:"required,uuid"`
+	ActorId     *string `json:"actorId,omitempty" validate:"omitempty,uuid"` // the notification is dropped when the user blocked the actor
 	Type        string  `json:"type" validate:"required,lte=50"`
 	Title       string  `json:"title" validate:"required,lte=200"`
 	Message     string  `json:"message" validate:"required,lte=500"`
@@ -0,0 +1,20 @@
+package dto
+
+import "encoding/json"
+
+type SearchType string
+
+const (
+	SearchAll         SearchType = "all"
+	SearchChannels    SearchType = "channel"
+	SearchLivestreams SearchType = "livestream"
+	SearchVODs        SearchType = "vod"
+)
+
+// SearchResultsDTO holds a page of every type searched, each ranked by its own service. The livestreams and the vods
+// are as the livestream and the vod services returned them
+type SearchResultsDTO struct {
+	Channels    []GetUserPublicResponseDTO `json:"channels"`
+	Livestreams []json.RawMessage          `json:"livestreams"`
+	VODs        []json.RawMessage          `json:"vods"`
+}
@@ -0,0 +1,17 @@
+package chat
+
+import "context"
+
+// DonationAlert is the on-stream alert raised in the chat room of the channel receiving a donation
+type DonationAlert struct {
+	UserId       string  `json:"userId"`
+	Username     string  `json:"username"`
+	Amount       string  `json:"amount"`
+	CurrencyCode string  `json:"currencyCode"`
+	Text         *string `json:"text,omitempty"`
+}
+
+type ChatGateway interface {
+	// PostDonationAlert raises the alert in the chat room of the channel, the room id is the streamer's user id
+	PostDonationAlert(ctx context.Context, roomId string, alert DonationAlert) error
+}
@@ -0,0 +1,60 @@
+package chathttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/gateway"
+	chatgateway "sen1or/letslive/user/gateway/chat"
+)
+
+type chatHTTPGateway struct {
+	registry discovery.Registry
+}
+
+func NewChatGateway(registry discovery.Registry) chatgateway.ChatGateway {
+	return &chatHTTPGateway{
+		registry: registry,
+	}
+}
+
+func (g *chatHTTPGateway) PostDonationAlert(ctx context.Context, roomId string, alert chatgateway.DonationAlert) error {
+	addr, err := g.registry.ServiceAddress(ctx, "chat")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get chat service address: %v", err)
+		return fmt.Errorf("chat service unavailable")
+	}
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/rooms/%s/donations", addr, roomId)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call chat service PostDonationAlert: %v", err)
+		return fmt.Errorf("failed to call chat service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("chat service returned status %d on PostDonationAlert", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -8,6 +8,16 @@ type ShopItem struct {
 	AnimationURL string `json:"animationUrl"`
 }
 
+// SubscriberBadge is what a channel shows next to one of its subscribers
+type SubscriberBadge struct {
+	UserId   string `json:"userId"`
+	TierId   string `json:"tierId"`
+	TierName string `json:"tierName"`
+	Months   int    `json:"months"`
+	Status   string `json:"status"`
+}
+
 type FinanceGateway interface {
 	GetShopItem(ctx context.Context, shopItemID string) (*ShopItem, error)
+	GetSubscriberBadges(ctx context.Context, channelId string, userIds []string) ([]SubscriberBadge, error)
 }
@@ -1,6 +1,7 @@
 package financehttp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -64,3 +65,55 @@ func (g *financeHTTPGateway) GetShopItem(ctx context.Context, shopItemID string)
 
 	return result.Data, nil
 }
+
+type getSubscriberBadgesRequest struct {
+	ChannelId string   `json:"channelId"`
+	UserIds   []string `json:"userIds"`
+}
+
+type getSubscriberBadgesResponse struct {
+	Data []financegateway.SubscriberBadge `json:"data"`
+}
+
+func (g *financeHTTPGateway) GetSubscriberBadges(ctx context.Context, channelId string, userIds []string) ([]financegateway.SubscriberBadge, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "finance")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get finance service address: %v", err)
+		return nil, fmt.Errorf("finance service unavailable")
+	}
+
+	body, err := json.Marshal(getSubscriberBadgesRequest{ChannelId: channelId, UserIds: userIds})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/subscriptions/badges", addr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call finance service GetSubscriberBadges: %v", err)
+		return nil, fmt.Errorf("failed to call finance service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("finance service returned status %d on GetSubscriberBadges", resp.StatusCode)
+	}
+
+	var result getSubscriberBadgesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode GetSubscriberBadges response: %v", err)
+		return nil, fmt.Errorf("failed to decode finance service response")
+	}
+
+	return result.Data, nil
+}
@@ -0,0 +1,155 @@
+package livestreamhttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/search"
+	"sen1or/letslive/user/gateway"
+	livestreamgateway "sen1or/letslive/user/gateway/livestream"
+	"slices"
+	"strconv"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// userIdsBatchSize is the most users the livestream service takes in one lookup
+const userIdsBatchSize = 1000
+
+type livestreamHTTPGateway struct {
+	registry discovery.Registry
+}
+
+func NewLivestreamGateway(registry discovery.Registry) livestreamgateway.LivestreamGateway {
+	return &livestreamHTTPGateway{
+		registry: registry,
+	}
+}
+
+type getLiveLivestreamsOfUsersRequest struct {
+	UserIds []uuid.UUID `json:"userIds"`
+}
+
+type getLiveLivestreamsOfUsersResponse struct {
+	Data []json.RawMessage `json:"data"`
+}
+
+func (g *livestreamHTTPGateway) GetLiveLivestreamsOfUsers(ctx context.Context, userIds []uuid.UUID) ([]livestreamgateway.Livestream, error) {
+	var livestreams []livestreamgateway.Livestream
+	for batch := range slices.Chunk(userIds, userIdsBatchSize) {
+		found, err := g.getLiveLivestreamsOfUsers(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		livestreams = append(livestreams, found...)
+	}
+
+	slices.SortFunc(livestreams, func(a, b livestreamgateway.Livestream) int {
+		if c := b.StartedAt.Compare(a.StartedAt); c != 0 {
+			return c
+		}
+		return bytes.Compare(b.Id.Bytes(), a.Id.Bytes())
+	})
+	return livestreams, nil
+}
+
+func (g *livestreamHTTPGateway) getLiveLivestreamsOfUsers(ctx context.Context, userIds []uuid.UUID) ([]livestreamgateway.Livestream, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "livestream")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get livestream service address: %v", err)
+		return nil, fmt.Errorf("livestream service unavailable")
+	}
+
+	body, err := json.Marshal(getLiveLivestreamsOfUsersRequest{UserIds: userIds})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/livestreams/live", addr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call livestream service GetLiveLivestreamsOfUsers: %v", err)
+		return nil, fmt.Errorf("failed to call livestream service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("livestream service returned status %d on GetLiveLivestreamsOfUsers", resp.StatusCode)
+	}
+
+	var result getLiveLivestreamsOfUsersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode GetLiveLivestreamsOfUsers response: %v", err)
+		return nil, fmt.Errorf("failed to decode livestream service response")
+	}
+
+	livestreams := make([]livestreamgateway.Livestream, len(result.Data))
+	for i, raw := range result.Data {
+		if err := json.Unmarshal(raw, &livestreams[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode livestream: %w", err)
+		}
+		livestreams[i].Raw = raw
+	}
+	return livestreams, nil
+}
+
+type searchResponse struct {
+	Data []json.RawMessage `json:"data"`
+}
+
+func (g *livestreamHTTPGateway) SearchLivestreams(ctx context.Context, query string, language search.Language, page int, limit int) ([]json.RawMessage, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "livestream")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get livestream service address: %v", err)
+		return nil, fmt.Errorf("livestream service unavailable")
+	}
+
+	params := neturl.Values{
+		"q":     {query},
+		"lang":  {string(language)},
+		"page":  {strconv.Itoa(page)},
+		"limit": {strconv.Itoa(limit)},
+	}
+	url := fmt.Sprintf("http://%s/v1/internal/livestreams/search?%s", addr, params.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call livestream service SearchLivestreams: %v", err)
+		return nil, fmt.Errorf("failed to call livestream service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("livestream service returned status %d on SearchLivestreams", resp.StatusCode)
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode SearchLivestreams response: %v", err)
+		return nil, fmt.Errorf("failed to decode livestream service response")
+	}
+	return result.Data, nil
+}
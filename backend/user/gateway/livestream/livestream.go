@@ -0,0 +1,27 @@
+package livestream
+
+import (
+	"context"
+	"encoding/json"
+	"sen1or/letslive/shared/pkg/search"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Livestream has what the following feed orders the livestreams by, Raw is the livestream as the livestream
+// service returned it
+type Livestream struct {
+	Id        uuid.UUID       `json:"id"`
+	UserId    uuid.UUID       `json:"userId"`
+	StartedAt time.Time       `json:"startedAt"`
+	Raw       json.RawMessage `json:"-"`
+}
+
+type LivestreamGateway interface {
+	// GetLiveLivestreamsOfUsers returns the public livestreams on air of the users, the latest started first
+	GetLiveLivestreamsOfUsers(ctx context.Context, userIds []uuid.UUID) ([]Livestream, error)
+	// SearchLivestreams returns a page of the public livestreams on air matching the query, as the livestream
+	// service returned them
+	SearchLivestreams(ctx context.Context, query string, language search.Language, page int, limit int) ([]json.RawMessage, error)
+}
@@ -0,0 +1,158 @@
+package vodhttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/search"
+	"sen1or/letslive/user/gateway"
+	vodgateway "sen1or/letslive/user/gateway/vod"
+	"slices"
+	"strconv"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// userIdsBatchSize is the most users the vod service takes in one lookup
+const userIdsBatchSize = 1000
+
+type vodHTTPGateway struct {
+	registry discovery.Registry
+}
+
+func NewVODGateway(registry discovery.Registry) vodgateway.VODGateway {
+	return &vodHTTPGateway{
+		registry: registry,
+	}
+}
+
+type getRecentVODsOfUsersRequest struct {
+	UserIds []uuid.UUID             `json:"userIds"`
+	Before  *vodgateway.VODPosition `json:"before"`
+	Limit   int                     `json:"limit"`
+}
+
+type getRecentVODsOfUsersResponse struct {
+	Data []json.RawMessage `json:"data"`
+}
+
+// GetRecentVODsOfUsers asks for a page of every batch of users and keeps the newest of them
+func (g *vodHTTPGateway) GetRecentVODsOfUsers(ctx context.Context, userIds []uuid.UUID, before *vodgateway.VODPosition, limit int) ([]vodgateway.VOD, error) {
+	var vods []vodgateway.VOD
+	for batch := range slices.Chunk(userIds, userIdsBatchSize) {
+		found, err := g.getRecentVODsOfUsers(ctx, getRecentVODsOfUsersRequest{UserIds: batch, Before: before, Limit: limit})
+		if err != nil {
+			return nil, err
+		}
+		vods = append(vods, found...)
+	}
+
+	slices.SortFunc(vods, func(a, b vodgateway.VOD) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return bytes.Compare(b.Id.Bytes(), a.Id.Bytes())
+	})
+	return vods[:min(len(vods), limit)], nil
+}
+
+func (g *vodHTTPGateway) getRecentVODsOfUsers(ctx context.Context, request getRecentVODsOfUsersRequest) ([]vodgateway.VOD, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "vod")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get vod service address: %v", err)
+		return nil, fmt.Errorf("vod service unavailable")
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/vods/recent", addr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call vod service GetRecentVODsOfUsers: %v", err)
+		return nil, fmt.Errorf("failed to call vod service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("vod service returned status %d on GetRecentVODsOfUsers", resp.StatusCode)
+	}
+
+	var result getRecentVODsOfUsersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode GetRecentVODsOfUsers response: %v", err)
+		return nil, fmt.Errorf("failed to decode vod service response")
+	}
+
+	vods := make([]vodgateway.VOD, len(result.Data))
+	for i, raw := range result.Data {
+		if err := json.Unmarshal(raw, &vods[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode vod: %w", err)
+		}
+		vods[i].Raw = raw
+	}
+	return vods, nil
+}
+
+type searchResponse struct {
+	Data []json.RawMessage `json:"data"`
+}
+
+func (g *vodHTTPGateway) SearchVODs(ctx context.Context, query string, language search.Language, page int, limit int) ([]json.RawMessage, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "vod")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get vod service address: %v", err)
+		return nil, fmt.Errorf("vod service unavailable")
+	}
+
+	params := neturl.Values{
+		"q":     {query},
+		"lang":  {string(language)},
+		"page":  {strconv.Itoa(page)},
+		"limit": {strconv.Itoa(limit)},
+	}
+	url := fmt.Sprintf("http://%s/v1/internal/vods/search?%s", addr, params.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call vod service SearchVODs: %v", err)
+		return nil, fmt.Errorf("failed to call vod service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("vod service returned status %d on SearchVODs", resp.StatusCode)
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode SearchVODs response: %v", err)
+		return nil, fmt.Errorf("failed to decode vod service response")
+	}
+	return result.Data, nil
+}
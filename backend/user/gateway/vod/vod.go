@@ -0,0 +1,31 @@
+package vod
+
+import (
+	"context"
+	"encoding/json"
+	"sen1or/letslive/shared/pkg/search"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// VOD has what the following feed orders the vods by, Raw is the vod as the vod service returned it
+type VOD struct {
+	Id        uuid.UUID       `json:"id"`
+	UserId    uuid.UUID       `json:"userId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Raw       json.RawMessage `json:"-"`
+}
+
+// VODPosition is a place in a listing of vods ordered by creation, the id breaks the ties
+type VODPosition struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        uuid.UUID `json:"id"`
+}
+
+type VODGateway interface {
+	// GetRecentVODsOfUsers returns up to limit public ready vods of the users created before the position, newest first
+	GetRecentVODsOfUsers(ctx context.Context, userIds []uuid.UUID, before *VODPosition, limit int) ([]VOD, error)
+	// SearchVODs returns a page of the public ready vods matching the query, as the vod service returned them
+	SearchVODs(ctx context.Context, query string, language search.Language, page int, limit int) ([]json.RawMessage, error)
+}
@@ -0,0 +1,52 @@
+package blockhandler
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/user/handlers/basehandler"
+	"sen1or/letslive/user/handlers/utils"
+	"sen1or/letslive/user/response"
+	"sen1or/letslive/user/services"
+)
+
+type BlockHandler struct {
+	basehandler.BaseHandler
+	blockService *services.UserBlockService
+}
+
+func NewBlockHandler(blockService *services.UserBlockService) *BlockHandler {
+	return &BlockHandler{blockService: blockService}
+}
+
+func (h *BlockHandler) BlockPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	blockerId, cookieErr := utils.GetUserIdFromCookie(r)
+	if cookieErr != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+			response.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "block_private_handler.block_service.block")
+	serviceErr := h.blockService.Block(ctx, *blockerId, r.PathValue("userId"))
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+		response.RES_SUCC_OK,
+		nil,
+		nil,
+		nil,
+	))
+}
@@ -0,0 +1,42 @@
+package blockhandler
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type isBlockedResponse struct {
+	Blocked bool `json:"blocked"`
+}
+
+func (h *BlockHandler) IsBlockedInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	blockerId, err1 := uuid.FromString(r.PathValue("userId"))
+	blockedId, err2 := uuid.FromString(r.PathValue("blockedUserId"))
+	if err1 != nil || err2 != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "is_blocked_internal_handler.block_service.is_blocked")
+	blocked, serviceErr := h.blockService.IsBlocked(ctx, blockerId, blockedId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &isBlockedResponse{Blocked: blocked}, nil, nil))
+}
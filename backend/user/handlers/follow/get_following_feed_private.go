@@ -0,0 +1,47 @@
+package follow
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/user/handlers/utils"
+	"sen1or/letslive/user/response"
+	"strconv"
+)
+
+func (h *FollowHandler) GetFollowingFeedPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, cookieErr := utils.GetUserIdFromCookie(r)
+	if cookieErr != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+			response.RES_ERR_UNAUTHORIZED,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil {
+		limit = 0
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_following_feed_private_handler.follow_service.get_following_feed")
+	items, nextCursor, serviceErr := h.followService.GetFollowingFeed(ctx, *userId, r.URL.Query().Get("cursor"), limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	var meta *response.Meta
+	if len(nextCursor) > 0 {
+		meta = &response.Meta{NextCursor: nextCursor}
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &items, meta, nil))
+}
@@ -0,0 +1,37 @@
+package follow
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *FollowHandler) GetViewerAffinityInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userId, err := uuid.FromString(r.PathValue("userId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_viewer_affinity_internal_handler.follow_service.get_viewer_affinity")
+	affinity, serviceErr := h.followService.GetViewerAffinity(ctx, userId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, affinity, nil, nil))
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"sen1or/letslive/shared/pkg/tags"
+	"sen1or/letslive/shared/pkg/tracer"
 	"sen1or/letslive/user/domains"
 	"sen1or/letslive/user/handlers/utils"
-	"sen1or/letslive/shared/pkg/tracer"
 	"sen1or/letslive/user/response"
 )
 
@@ -62,6 +63,40 @@ func (h *LivestreamInformationHandler) UpdatePrivateHandler(w http.ResponseWrite
 		return
 	}
 
+	// the category and tags are only changed when the form has them, an empty category removes it
+	var category *string
+	if values, ok := r.MultipartForm.Value["category"]; ok && len(values) > 0 {
+		if len(values[0]) > 0 && !tags.ValidSlug(values[0]) {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+				response.RES_ERR_INVALID_INPUT,
+				nil,
+				nil,
+				nil,
+			))
+			return
+		}
+		category = &values[0]
+	}
+
+	// one tags field per tag, a single empty one removes them all
+	var livestreamTags []string
+	if values, ok := r.MultipartForm.Value["tags"]; ok {
+		if len(values) == 1 && len(values[0]) == 0 {
+			values = nil
+		}
+		normalized, valid := tags.Normalize(values)
+		if !valid {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](
+				response.RES_ERR_INVALID_INPUT,
+				nil,
+				nil,
+				nil,
+			))
+			return
+		}
+		livestreamTags = normalized
+	}
+
 	var thumbnailUrl string
 
 	file, fileHeader, formErr := r.FormFile("thumbnail")
@@ -87,6 +122,8 @@ func (h *LivestreamInformationHandler) UpdatePrivateHandler(w http.ResponseWrite
 		Title:        &title,
 		Description:  &description,
 		ThumbnailURL: &thumbnailUrl,
+		Category:     category,
+		Tags:         livestreamTags,
 	}
 
 	ctx, span := tracer.MyTracer.Start(ctx, "update_private_handler.livestream_service.update")
@@ -0,0 +1,60 @@
+package searchhandler
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/user/dto"
+	"sen1or/letslive/user/handlers/basehandler"
+	"sen1or/letslive/user/handlers/utils"
+	"sen1or/letslive/user/response"
+	"sen1or/letslive/user/services"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+type SearchHandler struct {
+	basehandler.BaseHandler
+	searchService *services.SearchService
+}
+
+func NewSearchHandler(searchService *services.SearchService) *SearchHandler {
+	return &SearchHandler{searchService: searchService}
+}
+
+func (h *SearchHandler) SearchPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 0 {
+		page = 0
+	}
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	viewerId, _ := utils.GetUserIdFromCookie(r)
+	// the first language the browser asks for, e.g. "vi-VN" from "vi-VN,vi;q=0.9,en;q=0.8"
+	requestLocale, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	requestLocale, _, _ = strings.Cut(requestLocale, ";")
+
+	ctx, span := tracer.MyTracer.Start(ctx, "search_public_handler.search_service.search")
+	results, serviceErr := h.searchService.Search(ctx, r.URL.Query().Get("q"), dto.SearchType(r.URL.Query().Get("type")), viewerId, requestLocale, page, limit)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, results, &response.Meta{Page: page, PageSize: limit}, nil))
+}
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE user_auth_provider_enum ADD VALUE IF NOT EXISTS 'oidc';
+
+-- +goose Down
+-- postgres can not drop a value from an enum, the users are moved back to 'local' instead
+UPDATE users SET auth_provider = 'local' WHERE auth_provider = 'oidc';
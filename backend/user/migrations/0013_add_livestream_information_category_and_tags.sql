@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- category slug and tags of the next livestream, the categories themselves belong to the livestream service
+ALTER TABLE livestream_information ADD COLUMN IF NOT EXISTS category VARCHAR(64);
+ALTER TABLE livestream_information ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE livestream_information DROP COLUMN IF EXISTS tags;
+ALTER TABLE livestream_information DROP COLUMN IF EXISTS category;
+
+-- +goose StatementEnd
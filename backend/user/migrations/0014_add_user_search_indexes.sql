@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- one index per text search configuration of shared/pkg/search, the expression is the one the search queries use
+CREATE INDEX IF NOT EXISTS idx_users_search_english ON users USING GIN (to_tsvector('english', coalesce(username, '') || ' ' || coalesce(bio, '')));
+CREATE INDEX IF NOT EXISTS idx_users_search_simple ON users USING GIN (to_tsvector('simple', coalesce(username, '') || ' ' || coalesce(bio, '')));
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_users_username_trgm;
+DROP INDEX IF EXISTS idx_users_search_simple;
+DROP INDEX IF EXISTS idx_users_search_english;
+
+-- +goose StatementEnd
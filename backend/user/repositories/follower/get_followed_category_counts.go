@@ -0,0 +1,48 @@
+package follower
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresFollowRepo) GetFollowedCategoryCounts(ctx context.Context, followerId uuid.UUID) (map[string]int, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT l.category, count(*)
+		FROM followers f
+		JOIN livestream_information l ON l.user_id = f.user_id
+		WHERE f.follower_id = $1 AND l.category IS NOT NULL
+		GROUP BY l.category
+	`, followerId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get followed category counts: %s", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	counts := make(map[string]int)
+	var category string
+	var count int
+	_, err = pgx.ForEachRow(rows, []any{&category, &count}, func() error {
+		counts[category] = count
+		return nil
+	})
+	if err != nil {
+		logger.Errorf(ctx, "failed to collect followed category counts: %s", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return counts, nil
+}
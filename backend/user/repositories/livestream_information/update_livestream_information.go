@@ -15,9 +15,16 @@ func (r *postgresLivestreamInformationRepo) Update(ctx context.Context, livestre
 		"title":         livestreamInformation.Title,
 		"description":   livestreamInformation.Description,
 		"thumbnail_url": livestreamInformation.ThumbnailURL,
+		"category":      livestreamInformation.Category,
+		"tags":          livestreamInformation.Tags,
 	}
 
-	rows, err := r.dbConn.Query(ctx, "UPDATE livestream_information SET title = @title, description = @description, thumbnail_url = @thumbnail_url WHERE user_id = @user_id RETURNING *", params)
+	rows, err := r.dbConn.Query(ctx, `
+		UPDATE livestream_information
+		SET title = @title, description = @description, thumbnail_url = @thumbnail_url,
+			category = nullif(coalesce(@category, category), ''), tags = coalesce(@tags::text[], tags)
+		WHERE user_id = @user_id
+		RETURNING *`, params)
 	if err != nil {
 		return nil, response.NewResponseFromTemplate[any](
 			response.RES_ERR_DATABASE_QUERY,
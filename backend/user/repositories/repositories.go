@@ -8,6 +8,7 @@ import (
 	livestreaminforepo "sen1or/letslive/user/repositories/livestream_information"
 	notificationrepo "sen1or/letslive/user/repositories/notification"
 	userrepo "sen1or/letslive/user/repositories/user"
+	userblockrepo "sen1or/letslive/user/repositories/user_block"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -35,3 +36,7 @@ func NewInventoryRepository(conn *pgxpool.Pool) domains.InventoryRepository {
 func NewGiftRepository(conn *pgxpool.Pool) domains.GiftRepository {
 	return giftrepo.NewGiftRepository(conn)
 }
+
+func NewUserBlockRepository(conn *pgxpool.Pool) domains.UserBlockRepository {
+	return userblockrepo.NewUserBlockRepository(conn)
+}
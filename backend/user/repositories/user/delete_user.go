@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresUserRepo) Delete(ctx context.Context, userId uuid.UUID) *response.Response[any] {
+	result, err := r.dbConn.Exec(ctx, "DELETE FROM users WHERE id = $1", userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to delete user: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	} else if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_USER_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
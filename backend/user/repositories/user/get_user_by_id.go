@@ -30,6 +30,8 @@ func (r *postgresUserRepo) GetById(ctx context.Context, userId uuid.UUID) (*doma
 			l.title,
 			l.description,
 			l.thumbnail_url,
+			l.category,
+			l.tags,
 			COALESCE(
 				jsonb_object_agg(usl.platform, usl.url) FILTER (WHERE usl.platform IS NOT NULL),
 				'{}'::jsonb
@@ -41,7 +43,7 @@ func (r *postgresUserRepo) GetById(ctx context.Context, userId uuid.UUID) (*doma
 		GROUP BY
 			u.id, u.username, u.email, u.status, u.created_at, u.auth_provider,
 			u.stream_api_key, u.phone_number, u.bio, u.profile_picture, u.background_picture,
-			l.user_id, l.title, l.description, l.thumbnail_url
+			l.user_id, l.title, l.description, l.thumbnail_url, l.category, l.tags
 	`, userId.String())
 	if err != nil {
 		logger.Errorf(ctx, "failed to query user full information: %s", err)
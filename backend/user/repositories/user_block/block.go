@@ -0,0 +1,61 @@
+package userblock
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r postgresUserBlockRepo) Block(ctx context.Context, blockerId, blockedId uuid.UUID) *response.Response[any] {
+	tx, err := r.dbConn.Begin(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin block transaction: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_blocks (blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, blockerId, blockedId); err != nil {
+		logger.Errorf(ctx, "failed to exec block user: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM followers
+		WHERE (user_id = $1 AND follower_id = $2) OR (user_id = $2 AND follower_id = $1)
+	`, blockerId, blockedId); err != nil {
+		logger.Errorf(ctx, "failed to remove the follows of the blocked user: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit block transaction: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
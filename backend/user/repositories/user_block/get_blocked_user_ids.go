@@ -0,0 +1,44 @@
+package userblock
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r postgresUserBlockRepo) GetBlockedUserIds(ctx context.Context, blockerId uuid.UUID) ([]uuid.UUID, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		SELECT blocked_id FROM user_blocks
+		WHERE blocker_id = $1
+		ORDER BY created_at DESC
+	`, blockerId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get blocked user ids: %s", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	ids, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (uuid.UUID, error) {
+		var id uuid.UUID
+		err := row.Scan(&id)
+		return id, err
+	})
+	if err != nil {
+		logger.Errorf(ctx, "failed to collect blocked user ids: %s", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,50 @@
+package userblock
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r postgresUserBlockRepo) IsBlocked(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, *response.Response[any]) {
+	var blocked bool
+	err := r.dbConn.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2
+		)
+	`, blockerId, blockedId).Scan(&blocked)
+	if err != nil {
+		logger.Errorf(ctx, "failed to check if user is blocked: %s", err)
+		return false, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return blocked, nil
+}
+
+func (r postgresUserBlockRepo) IsBlockedEitherWay(ctx context.Context, userId, otherUserId uuid.UUID) (bool, *response.Response[any]) {
+	var blocked bool
+	err := r.dbConn.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1)
+		)
+	`, userId, otherUserId).Scan(&blocked)
+	if err != nil {
+		logger.Errorf(ctx, "failed to check if users blocked each other: %s", err)
+		return false, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return blocked, nil
+}
@@ -0,0 +1,27 @@
+package userblock
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r postgresUserBlockRepo) Unblock(ctx context.Context, blockerId, blockedId uuid.UUID) *response.Response[any] {
+	_, err := r.dbConn.Exec(ctx, `
+		DELETE FROM user_blocks
+		WHERE blocker_id = $1 AND blocked_id = $2
+	`, blockerId, blockedId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to exec unblock user: %s", err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
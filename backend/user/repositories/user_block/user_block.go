@@ -0,0 +1,17 @@
+package userblock
+
+import (
+	"sen1or/letslive/user/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresUserBlockRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewUserBlockRepository(conn *pgxpool.Pool) domains.UserBlockRepository {
+	return &postgresUserBlockRepo{
+		dbConn: conn,
+	}
+}
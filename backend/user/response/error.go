@@ -14,6 +14,7 @@ const (
 	RES_ERR_NOTIFICATION_NOT_FOUND_CODE = 30002
 	RES_ERR_USERNAME_TAKEN_CODE              = 30003
 	RES_ERR_INSUFFICIENT_INVENTORY_CODE = 30004
+	RES_ERR_USER_BLOCKED_CODE           = 30005
 	RES_ERR_DATABASE_QUERY_CODE         = 20015
 	RES_ERR_DATABASE_ISSUE_CODE         = 20016
 	RES_ERR_INTERNAL_SERVER_CODE        = 20017
@@ -31,6 +32,7 @@ const (
 	RES_ERR_NOTIFICATION_NOT_FOUND_KEY = "res_err_notification_not_found"
 	RES_ERR_USERNAME_TAKEN_KEY              = "res_err_username_taken"
 	RES_ERR_INSUFFICIENT_INVENTORY_KEY = "res_err_insufficient_inventory"
+	RES_ERR_USER_BLOCKED_KEY           = "res_err_user_blocked"
 	RES_ERR_DATABASE_QUERY_KEY         = "res_err_database_query"
 	RES_ERR_DATABASE_ISSUE_KEY         = "res_err_database_issue"
 	RES_ERR_INTERNAL_SERVER_KEY        = "res_err_internal_server"
@@ -118,6 +120,14 @@ var (
 		Message:    "Not enough items in inventory.",
 	}
 
+	RES_ERR_USER_BLOCKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusForbidden,
+		Code:       RES_ERR_USER_BLOCKED_CODE,
+		Key:        RES_ERR_USER_BLOCKED_KEY,
+		Message:    "This user has blocked you or is blocked by you.",
+	}
+
 	RES_ERR_DATABASE_QUERY = ResponseTemplate{
 		Success:    false,
 		StatusCode: http.StatusInternalServerError,
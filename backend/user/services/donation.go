@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/domains"
+	"sen1or/letslive/user/dto"
+	chatgateway "sen1or/letslive/user/gateway/chat"
+)
+
+// DonationService turns the donations the finance service settled into a notification for the creator
+// and an alert on their stream
+type DonationService struct {
+	userRepo            domains.UserRepository
+	notificationService *NotificationService
+	chatGateway         chatgateway.ChatGateway
+}
+
+func NewDonationService(
+	userRepo domains.UserRepository,
+	notificationService *NotificationService,
+	chatGateway chatgateway.ChatGateway,
+) *DonationService {
+	return &DonationService{
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		chatGateway:         chatGateway,
+	}
+}
+
+// HandleEvent is the eventbus.EventHandler of the finance topic, returning an error has the event redelivered
+// so only a failure to record the notification is returned
+func (s *DonationService) HandleEvent(ctx context.Context, event eventbus.Event) error {
+	if event.Type != events.DonationSent {
+		return nil
+	}
+
+	donation, err := eventbus.ParseEventData[events.DonationSentEvent](event)
+	if err != nil {
+		logger.Errorf(ctx, "dropping malformed donation event (id=%s): %v", event.ID, err)
+		return nil
+	}
+
+	// name lookups are best-effort; the donation is already settled
+	senderName := "Someone"
+	if sender, errResp := s.userRepo.GetById(ctx, donation.SenderId); errResp == nil && sender.Username != "" {
+		senderName = sender.Username
+	}
+
+	actionURL := "/wallet"
+	refIDStr := donation.TipId.String()
+	senderIDStr := donation.SenderId.String()
+	if _, errResp := s.notificationService.CreateNotification(ctx, dto.CreateNotificationRequestDTO{
+		UserId:      donation.ReceiverId.String(),
+		ActorId:     &senderIDStr,
+		Type:        domains.NotificationTypeDonationReceived,
+		Title:       "You received a tip!",
+		Message:     fmt.Sprintf("%s tipped you %s %s", senderName, donation.Amount, donation.CurrencyCode),
+		ActionUrl:   &actionURL,
+		ReferenceId: &refIDStr,
+	}); errResp != nil {
+		return fmt.Errorf("failed to create the donation notification: %s", errResp.Message)
+	}
+
+	alert := chatgateway.DonationAlert{
+		UserId:       senderIDStr,
+		Username:     senderName,
+		Amount:       donation.Amount,
+		CurrencyCode: donation.CurrencyCode,
+		Text:         donation.Message,
+	}
+	if err := s.chatGateway.PostDonationAlert(ctx, donation.ReceiverId.String(), alert); err != nil {
+		logger.Warnf(ctx, "failed to raise the donation alert of tip %s: %v", donation.TipId, err)
+	}
+
+	return nil
+}
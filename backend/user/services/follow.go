@@ -3,20 +3,31 @@ package services
 import (
 	"context"
 	"sen1or/letslive/user/domains"
+	livestreamgateway "sen1or/letslive/user/gateway/livestream"
+	vodgateway "sen1or/letslive/user/gateway/vod"
 	"sen1or/letslive/user/response"
 
 	"github.com/gofrs/uuid/v5"
 )
 
 type FollowService struct {
-	followRepo domains.FollowRepository
+	followRepo        domains.FollowRepository
+	blockRepo         domains.UserBlockRepository
+	livestreamGateway livestreamgateway.LivestreamGateway
+	vodGateway        vodgateway.VODGateway
 }
 
 func NewFollowService(
 	followRepo domains.FollowRepository,
+	blockRepo domains.UserBlockRepository,
+	livestreamGateway livestreamgateway.LivestreamGateway,
+	vodGateway vodgateway.VODGateway,
 ) *FollowService {
 	return &FollowService{
-		followRepo: followRepo,
+		followRepo:        followRepo,
+		blockRepo:         blockRepo,
+		livestreamGateway: livestreamGateway,
+		vodGateway:        vodGateway,
 	}
 }
 
@@ -31,7 +42,21 @@ func (s FollowService) Follow(ctx context.Context, followId, followedId string)
 			nil,
 		)
 	}
-	err := s.followRepo.FollowUser(ctx, followUUID, followedUUID)
+
+	blocked, err := s.blockRepo.IsBlockedEitherWay(ctx, followUUID, followedUUID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_USER_BLOCKED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	err = s.followRepo.FollowUser(ctx, followUUID, followedUUID)
 	if err != nil {
 		return err
 	}
@@ -57,3 +82,26 @@ func (s FollowService) Unfollow(ctx context.Context, followId, followedId string
 
 	return nil
 }
+
+// GetViewerAffinity returns the channels the user follows and how much each category is streamed among them
+func (s FollowService) GetViewerAffinity(ctx context.Context, userId uuid.UUID) (*domains.ViewerAffinity, *response.Response[any]) {
+	followedUserIds, err := s.followRepo.GetFollowedUserIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCounts, err := s.followRepo.GetFollowedCategoryCounts(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]float64, len(categoryCounts))
+	for category, count := range categoryCounts {
+		categories[category] = float64(count) / float64(len(followedUserIds))
+	}
+
+	return &domains.ViewerAffinity{
+		FollowedUserIds: followedUserIds,
+		Categories:      categories,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/user/dto"
+	vodgateway "sen1or/letslive/user/gateway/vod"
+	"sen1or/letslive/user/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// followingFeedCursor points after the last item of a page of the following feed. The live livestreams come first,
+// the latest started first, then the vods, the newest first
+type followingFeedCursor struct {
+	Live bool      `json:"live"`
+	At   time.Time `json:"at"` // when the livestream started or the vod was created
+	Id   uuid.UUID `json:"id"`
+}
+
+func (c followingFeedCursor) encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeFollowingFeedCursor(encoded string) (*followingFeedCursor, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+
+	var cursor followingFeedCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.At.IsZero() {
+		return nil, false
+	}
+	return &cursor, true
+}
+
+// before tells whether an item at the position comes after the cursor in the newest first order
+func (c followingFeedCursor) before(at time.Time, id uuid.UUID) bool {
+	return at.Before(c.At) || (at.Equal(c.At) && bytes.Compare(id.Bytes(), c.Id.Bytes()) < 0)
+}
+
+// GetFollowingFeed returns a page of the live livestreams and then the vods of the channels the user follows. The
+// cursor of the next page is empty on the last page
+func (s FollowService) GetFollowingFeed(ctx context.Context, userId uuid.UUID, cursor string, limit int) ([]dto.FollowingFeedItemDTO, string, *response.Response[any]) {
+	if limit <= 0 {
+		limit = 20
+	}
+
+	if limit > 50 {
+		limit = 50
+	}
+
+	var after *followingFeedCursor
+	if len(cursor) > 0 {
+		decoded, ok := decodeFollowingFeedCursor(cursor)
+		if !ok {
+			return nil, "", response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+		}
+		after = decoded
+	}
+
+	followedUserIds, err := s.followRepo.GetFollowedUserIds(ctx, userId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := []dto.FollowingFeedItemDTO{}
+	if len(followedUserIds) == 0 {
+		return items, "", nil
+	}
+
+	var last followingFeedCursor
+	if after == nil || after.Live {
+		livestreams, gatewayErr := s.livestreamGateway.GetLiveLivestreamsOfUsers(ctx, followedUserIds)
+		if gatewayErr != nil {
+			logger.Errorf(ctx, "failed to get the live livestreams of the followed users: %v", gatewayErr)
+			return nil, "", response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+		}
+
+		for _, livestream := range livestreams {
+			if after != nil && !after.before(livestream.StartedAt, livestream.Id) {
+				continue
+			}
+			if len(items) == limit {
+				return items, last.encode(), nil
+			}
+
+			items = append(items, dto.FollowingFeedItemDTO{Type: dto.FollowingFeedLivestreamItem, Livestream: livestream.Raw})
+			last = followingFeedCursor{Live: true, At: livestream.StartedAt, Id: livestream.Id}
+		}
+	}
+
+	var before *vodgateway.VODPosition
+	if after != nil && !after.Live {
+		before = &vodgateway.VODPosition{CreatedAt: after.At, Id: after.Id}
+	}
+
+	// one more vod than the page needs tells whether there is a next page
+	vods, gatewayErr := s.vodGateway.GetRecentVODsOfUsers(ctx, followedUserIds, before, limit-len(items)+1)
+	if gatewayErr != nil {
+		logger.Errorf(ctx, "failed to get the recent vods of the followed users: %v", gatewayErr)
+		return nil, "", response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+
+	for _, vod := range vods {
+		if len(items) == limit {
+			return items, last.encode(), nil
+		}
+
+		items = append(items, dto.FollowingFeedItemDTO{Type: dto.FollowingFeedVODItem, VOD: vod.Raw})
+		last = followingFeedCursor{At: vod.CreatedAt, Id: vod.Id}
+	}
+	return items, "", nil
+}
@@ -16,6 +16,7 @@ type GiftService struct {
 	giftRepo            domains.GiftRepository
 	inventoryRepo       domains.InventoryRepository
 	userRepo            domains.UserRepository
+	blockRepo           domains.UserBlockRepository
 	financeGateway      financegateway.FinanceGateway
 	notificationService *NotificationService
 }
@@ -24,6 +25,7 @@ func NewGiftService(
 	giftRepo domains.GiftRepository,
 	inventoryRepo domains.InventoryRepository,
 	userRepo domains.UserRepository,
+	blockRepo domains.UserBlockRepository,
 	financeGateway financegateway.FinanceGateway,
 	notificationService *NotificationService,
 ) *GiftService {
@@ -31,6 +33,7 @@ func NewGiftService(
 		giftRepo:            giftRepo,
 		inventoryRepo:       inventoryRepo,
 		userRepo:            userRepo,
+		blockRepo:           blockRepo,
 		financeGateway:      financeGateway,
 		notificationService: notificationService,
 	}
@@ -47,6 +50,10 @@ func (s *GiftService) SendFromInventory(ctx context.Context, senderID uuid.UUID,
 		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
 	}
 
+	if serviceErr := s.checkNotBlocked(ctx, senderID, recipientID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	if _, serviceErr := s.inventoryRepo.Deduct(ctx, senderID, shopItemID); serviceErr != nil {
 		return nil, serviceErr
 	}
@@ -68,6 +75,11 @@ func (s *GiftService) SendFromInventory(ctx context.Context, senderID uuid.UUID,
 
 // CreateFromPurchase used by internal finance→user quick-send call.
 func (s *GiftService) CreateFromPurchase(ctx context.Context, senderID, recipientID, shopItemID uuid.UUID, quantity int, message *string) (*domains.Gift, *response.Response[any]) {
+	// the finance service refunds the purchase when the gift is rejected
+	if serviceErr := s.checkNotBlocked(ctx, senderID, recipientID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	gift, serviceErr := s.giftRepo.Create(ctx, domains.Gift{
 		SenderUserId:    senderID,
 		RecipientUserId: recipientID,
@@ -91,6 +103,18 @@ func (s *GiftService) GetSent(ctx context.Context, senderID uuid.UUID, page, lim
 	return s.giftRepo.ListBySender(ctx, senderID, page, limit)
 }
 
+// checkNotBlocked rejects a gift between users where one of them blocked the other
+func (s *GiftService) checkNotBlocked(ctx context.Context, senderID, recipientID uuid.UUID) *response.Response[any] {
+	blocked, serviceErr := s.blockRepo.IsBlockedEitherWay(ctx, senderID, recipientID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if blocked {
+		return response.NewResponseFromTemplate[any](response.RES_ERR_USER_BLOCKED, nil, nil, nil)
+	}
+	return nil
+}
+
 func (s *GiftService) notifyRecipient(ctx context.Context, gift *domains.Gift) {
 	// name lookups are best-effort; the notification must not fail the gift
 	senderName := "Someone"
@@ -109,8 +133,10 @@ func (s *GiftService) notifyRecipient(ctx context.Context, gift *domains.Gift) {
 
 	actionURL := "/user/me/gifts/received"
 	refIDStr := gift.Id.String()
+	senderIDStr := gift.SenderUserId.String()
 	s.notificationService.CreateNotification(ctx, dto.CreateNotificationRequestDTO{
 		UserId:      gift.RecipientUserId.String(),
+		ActorId:     &senderIDStr,
 		Type:        domains.NotificationTypeGiftReceived,
 		Title:       "You received a gift!",
 		Message:     message,
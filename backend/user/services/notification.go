@@ -11,13 +11,16 @@ import (
 
 type NotificationService struct {
 	notificationRepo domains.NotificationRepository
+	blockRepo        domains.UserBlockRepository
 }
 
 func NewNotificationService(
 	notificationRepo domains.NotificationRepository,
+	blockRepo domains.UserBlockRepository,
 ) *NotificationService {
 	return &NotificationService{
 		notificationRepo: notificationRepo,
+		blockRepo:        blockRepo,
 	}
 }
 
@@ -67,6 +70,25 @@ func (s NotificationService) CreateNotification(ctx context.Context, req dto.Cre
 		referenceId = &parsed
 	}
 
+	// users are not notified about what the users they blocked do, the notification is dropped without an error
+	if req.ActorId != nil {
+		actorUUID, err := uuid.FromString(*req.ActorId)
+		if err != nil {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_INVALID_INPUT,
+				nil, nil, nil,
+			)
+		}
+
+		blocked, errResp := s.blockRepo.IsBlocked(ctx, userUUID, actorUUID)
+		if errResp != nil {
+			return nil, errResp
+		}
+		if blocked {
+			return nil, nil
+		}
+	}
+
 	notification := domains.Notification{
 		UserId:      userUUID,
 		Type:        req.Type,
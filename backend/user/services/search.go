@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/search"
+	"sen1or/letslive/user/domains"
+	"sen1or/letslive/user/dto"
+	livestreamgateway "sen1or/letslive/user/gateway/livestream"
+	vodgateway "sen1or/letslive/user/gateway/vod"
+	"sen1or/letslive/user/response"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type SearchService struct {
+	userRepo          domains.UserRepository
+	livestreamGateway livestreamgateway.LivestreamGateway
+	vodGateway        vodgateway.VODGateway
+}
+
+func NewSearchService(
+	userRepo domains.UserRepository,
+	livestreamGateway livestreamgateway.LivestreamGateway,
+	vodGateway vodgateway.VODGateway,
+) *SearchService {
+	return &SearchService{
+		userRepo:          userRepo,
+		livestreamGateway: livestreamGateway,
+		vodGateway:        vodGateway,
+	}
+}
+
+// Search looks for the query in the channels, the livestreams and the vods at once, or in one of them. The query is
+// stemmed in the locale of the viewer, or in the one of the request when they are anonymous or never picked one.
+// A type whose service fails is left empty so the others are still shown
+func (s *SearchService) Search(ctx context.Context, query string, searchType dto.SearchType, viewerId *uuid.UUID, requestLocale string, page int, limit int) (*dto.SearchResultsDTO, *response.Response[any]) {
+	query, ok := search.NormalizeQuery(query)
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	switch searchType {
+	case "":
+		searchType = dto.SearchAll
+	case dto.SearchAll, dto.SearchChannels, dto.SearchLivestreams, dto.SearchVODs:
+	default:
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	language := s.viewerLanguage(ctx, viewerId, requestLocale)
+	results := dto.SearchResultsDTO{
+		Channels:    []dto.GetUserPublicResponseDTO{},
+		Livestreams: []json.RawMessage{},
+		VODs:        []json.RawMessage{},
+	}
+
+	var wg sync.WaitGroup
+	var channelsErr *response.Response[any]
+	if searchType == dto.SearchAll || searchType == dto.SearchChannels {
+		wg.Go(func() {
+			channels, err := s.userRepo.SearchUsers(ctx, query, language, viewerId, page, limit)
+			if err != nil {
+				channelsErr = err
+				return
+			}
+			results.Channels = channels
+		})
+	}
+	if searchType == dto.SearchAll || searchType == dto.SearchLivestreams {
+		wg.Go(func() {
+			livestreams, err := s.livestreamGateway.SearchLivestreams(ctx, query, language, page, limit)
+			if err != nil {
+				logger.Warnf(ctx, "failed to search livestreams, leaving them out: %v", err)
+				return
+			}
+			results.Livestreams = livestreams
+		})
+	}
+	if searchType == dto.SearchAll || searchType == dto.SearchVODs {
+		wg.Go(func() {
+			vods, err := s.vodGateway.SearchVODs(ctx, query, language, page, limit)
+			if err != nil {
+				logger.Warnf(ctx, "failed to search vods, leaving them out: %v", err)
+				return
+			}
+			results.VODs = vods
+		})
+	}
+	wg.Wait()
+
+	if channelsErr != nil {
+		return nil, channelsErr
+	}
+	return &results, nil
+}
+
+// viewerLanguage prefers the locale the viewer picked in their settings
+func (s *SearchService) viewerLanguage(ctx context.Context, viewerId *uuid.UUID, requestLocale string) search.Language {
+	if viewerId != nil {
+		viewer, err := s.userRepo.GetById(ctx, *viewerId)
+		if err == nil && viewer.Locale != nil {
+			return search.LanguageOfLocale(*viewer.Locale)
+		}
+	}
+	return search.LanguageOfLocale(requestLocale)
+}
@@ -5,6 +5,7 @@ import (
 	"mime/multipart"
 	"sen1or/letslive/user/domains"
 	"sen1or/letslive/user/dto"
+	financegateway "sen1or/letslive/user/gateway/finance"
 	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/user/response"
 	"sen1or/letslive/user/utils"
@@ -18,6 +19,7 @@ type UserService struct {
 	notificationRepo          domains.NotificationRepository
 	followRepo                domains.FollowRepository
 	minioService              MinIOService
+	financeGateway            financegateway.FinanceGateway
 }
 
 func NewUserService(
@@ -26,6 +28,7 @@ func NewUserService(
 	notificationRepo domains.NotificationRepository,
 	followRepo domains.FollowRepository,
 	minioService MinIOService,
+	financeGateway financegateway.FinanceGateway,
 ) *UserService {
 	return &UserService{
 		userRepo:                  userRepo,
@@ -33,6 +36,7 @@ func NewUserService(
 		notificationRepo:          notificationRepo,
 		followRepo:                followRepo,
 		minioService:              minioService,
+		financeGateway:            financeGateway,
 	}
 }
 
@@ -42,9 +46,33 @@ func (s *UserService) GetUserPublicInfoById(ctx context.Context, userUUID uuid.U
 		return nil, err
 	}
 
+	if authenticatedUserId != nil && *authenticatedUserId != userUUID {
+		user.Subscription = s.getSubscriberBadge(ctx, userUUID, *authenticatedUserId)
+	}
+
 	return user, nil
 }
 
+// getSubscriberBadge looks up the subscription of the viewer to the channel, the profile is still served
+// without it when the finance service is unavailable
+func (s *UserService) getSubscriberBadge(ctx context.Context, channelId uuid.UUID, viewerId uuid.UUID) *dto.SubscriberBadgeDTO {
+	badges, err := s.financeGateway.GetSubscriberBadges(ctx, channelId.String(), []string{viewerId.String()})
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the subscriber badge of %s on channel %s: %v", viewerId, channelId, err)
+		return nil
+	}
+	if len(badges) == 0 {
+		return nil
+	}
+
+	return &dto.SubscriberBadgeDTO{
+		TierId:   badges[0].TierId,
+		TierName: badges[0].TierName,
+		Months:   badges[0].Months,
+		Status:   badges[0].Status,
+	}
+}
+
 func (s *UserService) GetUserByStreamAPIKey(ctx context.Context, key uuid.UUID) (*domains.User, *response.Response[any]) {
 	user, err := s.userRepo.GetByAPIKey(ctx, key)
 	if err != nil {
@@ -136,6 +164,12 @@ func (s *UserService) CreateNewUser(ctx context.Context, data dto.CreateUserRequ
 	return createdUser, nil
 }
 
+// DeleteUser removes a user along with the rows cascading from it, the auth service uses it to roll back
+// a sign up it could not finish
+func (s *UserService) DeleteUser(ctx context.Context, userId uuid.UUID) *response.Response[any] {
+	return s.userRepo.Delete(ctx, userId)
+}
+
 func (s *UserService) UpdateUser(ctx context.Context, data dto.UpdateUserRequestDTO) (*domains.User, *response.Response[any]) {
 	if err := utils.Validator.Struct(&data); err != nil {
 		return nil, response.NewResponseFromTemplate[any](
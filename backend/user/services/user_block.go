@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"sen1or/letslive/user/domains"
+	"sen1or/letslive/user/dto"
+	"sen1or/letslive/user/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type UserBlockService struct {
+	blockRepo domains.UserBlockRepository
+	userRepo  domains.UserRepository
+}
+
+func NewUserBlockService(
+	blockRepo domains.UserBlockRepository,
+	userRepo domains.UserRepository,
+) *UserBlockService {
+	return &UserBlockService{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Block blocks the user and removes the follows between the two users
+func (s *UserBlockService) Block(ctx context.Context, blockerId uuid.UUID, blockedId string) *response.Response[any] {
+	blockedUUID, err := uuid.FromString(blockedId)
+	if err != nil || blockedUUID == blockerId {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, errResp := s.userRepo.GetById(ctx, blockedUUID); errResp != nil {
+		return errResp
+	}
+
+	return s.blockRepo.Block(ctx, blockerId, blockedUUID)
+}
+
+func (s *UserBlockService) Unblock(ctx context.Context, blockerId uuid.UUID, blockedId string) *response.Response[any] {
+	blockedUUID, err := uuid.FromString(blockedId)
+	if err != nil || blockedUUID == blockerId {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.blockRepo.Unblock(ctx, blockerId, blockedUUID)
+}
+
+func (s *UserBlockService) GetBlockedUsers(ctx context.Context, blockerId uuid.UUID) ([]dto.GetUserPublicResponseDTO, *response.Response[any]) {
+	ids, err := s.blockRepo.GetBlockedUserIds(ctx, blockerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []dto.GetUserPublicResponseDTO{}, nil
+	}
+
+	return s.userRepo.GetPublicInfosByIds(ctx, ids, &blockerId)
+}
+
+// IsBlocked tells other services whether the blocker blocked the user, e.g. before a comment on the blocker's vod
+func (s *UserBlockService) IsBlocked(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, *response.Response[any]) {
+	return s.blockRepo.IsBlocked(ctx, blockerId, blockedId)
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"sen1or/letslive/vod/config"
+	"sen1or/letslive/vod/handlers/clip"
 	"sen1or/letslive/vod/handlers/general"
+	transcodejob "sen1or/letslive/vod/handlers/transcode_job"
 	"sen1or/letslive/vod/handlers/vod"
 	vodcomment "sen1or/letslive/vod/handlers/vod_comment"
 	"sen1or/letslive/shared/middlewares"
@@ -23,19 +25,23 @@ type APIServer struct {
 	logger     *zap.SugaredLogger
 	config     *config.Config
 
-	generalHandler    *general.GeneralHandler
-	vodHandler        *vod.VODHandler
-	vodCommentHandler *vodcomment.VODCommentHandler
+	generalHandler      *general.GeneralHandler
+	vodHandler          *vod.VODHandler
+	vodCommentHandler   *vodcomment.VODCommentHandler
+	transcodeJobHandler *transcodejob.TranscodeJobHandler
+	clipHandler         *clip.ClipHandler
 }
 
-func NewAPIServer(vodHandler *vod.VODHandler, vodCommentHandler *vodcomment.VODCommentHandler, cfg *config.Config, db *pgxpool.Pool) *APIServer {
+func NewAPIServer(vodHandler *vod.VODHandler, vodCommentHandler *vodcomment.VODCommentHandler, transcodeJobHandler *transcodejob.TranscodeJobHandler, clipHandler *clip.ClipHandler, cfg *config.Config, db *pgxpool.Pool) *APIServer {
 	return &APIServer{
 		logger: logger.Logger,
 		config: cfg,
 
-		generalHandler:    general.NewGeneralHandler(db),
-		vodHandler:        vodHandler,
-		vodCommentHandler: vodCommentHandler,
+		generalHandler:      general.NewGeneralHandler(db),
+		vodHandler:          vodHandler,
+		vodCommentHandler:   vodCommentHandler,
+		transcodeJobHandler: transcodeJobHandler,
+		clipHandler:         clipHandler,
 	}
 }
 
@@ -49,6 +55,7 @@ func (a *APIServer) getHandler() http.Handler {
 	// Public VOD routes
 	wrap("GET /v1/vods", a.vodHandler.GetVODsOfUserPublicHandler)
 	wrap("GET /v1/vods/{vodId}", a.vodHandler.GetVODByIdPublicHandler)
+	wrap("GET /v1/vods/{vodId}/chapters.vtt", a.vodHandler.GetChaptersTrackPublicHandler)
 	wrap("POST /v1/vods/{vodId}/view", a.vodHandler.RegisterViewPublicHandler)
 	wrap("GET /v1/popular-vods", a.vodHandler.GetRecommendedVODsPublicHandler)
 
@@ -57,6 +64,20 @@ func (a *APIServer) getHandler() http.Handler {
 	wrap("POST /v1/vods/upload", a.vodHandler.UploadVODPrivateHandler)
 	wrap("PATCH /v1/vods/{vodId}", a.vodHandler.UpdateVODMetadataPrivateHandler)
 	wrap("DELETE /v1/vods/{vodId}", a.vodHandler.DeleteVODPrivateHandler)
+	wrap("GET /v1/vods/{vodId}/transcode-status", a.vodHandler.GetTranscodeStatusPrivateHandler)
+	wrap("GET /v1/vods/{vodId}/thumbnails", a.vodHandler.GetThumbnailsPrivateHandler)
+	wrap("PUT /v1/vods/{vodId}/thumbnail", a.vodHandler.SelectThumbnailPrivateHandler)
+	wrap("POST /v1/vods/{vodId}/thumbnail", a.vodHandler.UploadThumbnailPrivateHandler)
+	wrap("POST /v1/vods/{vodId}/trim", a.vodHandler.TrimVODPrivateHandler)
+	wrap("POST /v1/vods/{vodId}/split", a.vodHandler.SplitVODPrivateHandler)
+	wrap("PUT /v1/vods/{vodId}/chapters", a.vodHandler.UpdateChaptersPrivateHandler)
+
+	// Private resumable upload routes
+	wrap("POST /v1/vod-uploads", a.vodHandler.InitiateUploadPrivateHandler)
+	wrap("GET /v1/vod-uploads/{uploadId}", a.vodHandler.GetUploadPrivateHandler)
+	wrap("PUT /v1/vod-uploads/{uploadId}/parts/{partNumber}", a.vodHandler.UploadPartPrivateHandler)
+	wrap("POST /v1/vod-uploads/{uploadId}/complete", a.vodHandler.CompleteUploadPrivateHandler)
+	wrap("DELETE /v1/vod-uploads/{uploadId}", a.vodHandler.AbortUploadPrivateHandler)
 
 	// Public VOD comment routes
 	wrap("GET /v1/vods/{vodId}/comments", a.vodCommentHandler.GetCommentsPublicHandler)
@@ -69,9 +90,28 @@ func (a *APIServer) getHandler() http.Handler {
 	wrap("DELETE /v1/vod-comments/{commentId}/like", a.vodCommentHandler.UnlikeCommentPrivateHandler)
 	wrap("POST /v1/vod-comments/liked-ids", a.vodCommentHandler.GetUserLikedCommentIdsPrivateHandler)
 
+	// Public clip routes
+	wrap("GET /v1/clips", a.clipHandler.GetClipsPublicHandler)
+	wrap("GET /v1/clips/{clipId}", a.clipHandler.GetClipByIdPublicHandler)
+	wrap("POST /v1/clips/{clipId}/view", a.clipHandler.RegisterViewPublicHandler)
+
+	// Private clip routes
+	wrap("POST /v1/clips", a.clipHandler.CreateClipPrivateHandler)
+
 	// Internal routes (service-to-service, no JWT)
+	wrap("GET /v1/internal/vods/search", a.vodHandler.SearchVODsInternalHandler)
+	wrap("POST /v1/internal/vods/recent", a.vodHandler.GetRecentVODsOfUsersInternalHandler)
 	wrap("POST /v1/internal/vods", a.vodHandler.CreateVODInternalHandler)
 	wrap("PATCH /v1/internal/vods/{vodId}/status", a.vodHandler.UpdateVODStatusInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/lease", a.transcodeJobHandler.LeaseJobInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/{jobId}/heartbeat", a.transcodeJobHandler.HeartbeatJobInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/{jobId}/complete", a.transcodeJobHandler.CompleteJobInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/{jobId}/fail", a.transcodeJobHandler.FailJobInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/{jobId}/release", a.transcodeJobHandler.ReleaseJobInternalHandler)
+	wrap("POST /v1/internal/transcode-jobs/{jobId}/progress", a.transcodeJobHandler.ReportProgressInternalHandler)
+	wrap("POST /v1/internal/clip-jobs/lease", a.clipHandler.LeaseClipJobInternalHandler)
+	wrap("POST /v1/internal/clip-jobs/{clipId}/complete", a.clipHandler.CompleteClipJobInternalHandler)
+	wrap("POST /v1/internal/clip-jobs/{clipId}/fail", a.clipHandler.FailClipJobInternalHandler)
 
 	// Health check
 	wrap("GET /v1/health", a.generalHandler.RouteServiceHealth)
@@ -10,16 +10,27 @@ import (
 
 	"sen1or/letslive/vod/api"
 	cfg "sen1or/letslive/vod/config"
+	livestreamgatewayhttp "sen1or/letslive/vod/gateway/livestream/http"
 	usergatewayhttp "sen1or/letslive/vod/gateway/user/http"
+	clipHandler "sen1or/letslive/vod/handlers/clip"
+	transcodeJobHandler "sen1or/letslive/vod/handlers/transcode_job"
 	vodHandler "sen1or/letslive/vod/handlers/vod"
 	vodCommentHandler "sen1or/letslive/vod/handlers/vod_comment"
 	"sen1or/letslive/vod/repositories"
+	clipService "sen1or/letslive/vod/services/clip"
+	transcodeJobService "sen1or/letslive/vod/services/transcode_job"
 	vodService "sen1or/letslive/vod/services/vod"
 	vodCommentService "sen1or/letslive/vod/services/vod_comment"
+	"sen1or/letslive/vod/imaging"
+	"sen1or/letslive/vod/prober"
+	"sen1or/letslive/vod/publisher"
 	miniostorage "sen1or/letslive/vod/storage/minio"
 
 	sharedconfig "sen1or/letslive/shared/config"
 	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/eventbus/natsbus"
 	"sen1or/letslive/shared/pkg/logger"
 	"sen1or/letslive/shared/pkg/tracer"
 	sharedutils "sen1or/letslive/shared/utils"
@@ -66,7 +77,12 @@ func main() {
 	dbConn := sharedutils.ConnectDB(ctx, config.Database.ConnectionString)
 	defer dbConn.Close()
 
-	server := SetupServer(ctx, dbConn, registry, config)
+	producer := setupEventProducer(ctx, config.EventBus)
+	if producer != nil {
+		defer producer.Close()
+	}
+
+	server := SetupServer(ctx, dbConn, registry, producer, config)
 	go func() {
 		logger.Infof(ctx, "starting server on %s:%d...", config.Service.Hostname, config.Service.APIPort)
 		server.ListenAndServe(ctx, false)
@@ -109,20 +125,63 @@ func main() {
 	logger.Infof(shutdownCtx, "service shut down complete.")
 }
 
-func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, cfg *cfg.Config) *api.APIServer {
+// setupEventProducer returns nil when no event bus is configured or reachable, the service then runs without publishing
+func setupEventProducer(ctx context.Context, cfg cfg.EventBus) eventbus.Producer {
+	if cfg.URL == "" {
+		logger.Warnf(ctx, "eventBus.url is not set, events will not be published")
+		return nil
+	}
+
+	admin, err := natsbus.NewAdmin(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to connect to the event bus, events will not be published: %v", err)
+		return nil
+	}
+	defer admin.Close()
+
+	if err := admin.EnsureTopics(ctx, events.DefaultTopics()); err != nil {
+		logger.Errorf(ctx, "failed to ensure event bus topics: %v", err)
+	}
+
+	producer, err := natsbus.NewProducer(ctx, cfg.URL)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create the event producer, events will not be published: %v", err)
+		return nil
+	}
+
+	return producer
+}
+
+func SetupServer(ctx context.Context, dbConn *pgxpool.Pool, registry discovery.Registry, producer eventbus.Producer, cfg *cfg.Config) *api.APIServer {
 	var vodRepo = repositories.NewVODRepository(dbConn)
 	var vodCommentRepo = repositories.NewVODCommentRepository(dbConn)
 	var vodCommentLikeRepo = repositories.NewVODCommentLikeRepository(dbConn)
 	var transcodeJobRepo = repositories.NewTranscodeJobRepository(dbConn)
+	var vodDeletionRepo = repositories.NewVODDeletionRepository(dbConn)
+	var vodUploadRepo = repositories.NewVODUploadRepository(dbConn)
+	var vodEditRepo = repositories.NewVODEditRepository(dbConn)
+	var vodChapterRepo = repositories.NewVODChapterRepository(dbConn)
+	var clipRepo = repositories.NewClipRepository(dbConn)
 
 	var userGateway = usergatewayhttp.NewUserGateway(registry)
+	var livestreamGateway = livestreamgatewayhttp.NewLivestreamGateway(registry)
+	var eventPublisher = publisher.NewEventPublisher(producer)
 
 	var minio = miniostorage.NewMinIOStorage(ctx, cfg.MinIO)
+	var mediaProber = prober.NewFFProbe(cfg.MediaProbe.FFProbePath, time.Duration(cfg.MediaProbe.Timeout)*time.Second)
+	var imageResizer = imaging.NewFFMpegResizer(cfg.Thumbnail.FFMpegPath)
 
-	var vodService = vodService.NewVODService(vodRepo, transcodeJobRepo, minio)
+	var vodService = vodService.NewVODService(vodRepo, transcodeJobRepo, vodDeletionRepo, vodUploadRepo, vodEditRepo, vodChapterRepo, minio, eventPublisher, mediaProber, imageResizer, userGateway, cfg.MediaCleanup, cfg.Upload, cfg.MediaProbe, cfg.Thumbnail, cfg.VODEdit, cfg.MinIO.VODBucketName)
+	go vodService.RunMediaCleanup(ctx)
+	go vodService.RunUploadCleanup(ctx)
 	var vodCommentService = vodCommentService.NewVODCommentService(vodCommentRepo, vodCommentLikeRepo, vodRepo, userGateway, dbConn)
+	var transcodeJobService = transcodeJobService.NewTranscodeJobService(transcodeJobRepo, eventPublisher, cfg.TranscodeJob)
+	go transcodeJobService.RunReaper(ctx)
+	var clipService = clipService.NewClipService(clipRepo, vodRepo, livestreamGateway, eventPublisher, cfg.Clip, cfg.TranscodeJob)
 
 	var vodHandler = vodHandler.NewVODHandler(vodService)
 	var vodCommentHandler = vodCommentHandler.NewVODCommentHandler(vodCommentService)
-	return api.NewAPIServer(vodHandler, vodCommentHandler, cfg, dbConn)
+	var transcodeJobHandler = transcodeJobHandler.NewTranscodeJobHandler(transcodeJobService)
+	var clipHandler = clipHandler.NewClipHandler(clipService)
+	return api.NewAPIServer(vodHandler, vodCommentHandler, transcodeJobHandler, clipHandler, cfg, dbConn)
 }
@@ -38,13 +38,89 @@ type MinIO struct {
 	Port       int    `yaml:"port"`
 	BucketName string `yaml:"bucketName"`
 	ReturnURL  string `yaml:"returnURL"`
+	// bucket the transcoded hls files are stored in, when empty it is taken from the vod playback url
+	VODBucketName string `yaml:"vodBucketName"`
+}
+
+// MediaCleanup controls the background removal of deleted vods' objects
+type MediaCleanup struct {
+	Interval             int `yaml:"interval"`             // in seconds, how often due deletions are picked up
+	BatchSize            int `yaml:"batchSize"`            // deletions handled per run
+	MaxBackoff           int `yaml:"maxBackoff"`           // in seconds, upper bound of the delay between failed attempts
+	TranscodeGracePeriod int `yaml:"transcodeGracePeriod"` // in seconds, delay before cleaning a vod whose transcode was running
+}
+
+// Upload controls the resumable uploads of vod files
+type Upload struct {
+	PartSize         int64 `yaml:"partSize"`         // in bytes, every part but the last has this size, at least 5MiB
+	MaxFileSize      int64 `yaml:"maxFileSize"`      // in bytes
+	Expiry           int   `yaml:"expiry"`           // in seconds, an upload receiving no part for this long is aborted
+	CleanupInterval  int   `yaml:"cleanupInterval"`  // in seconds, how often expired uploads are aborted
+	CleanupBatchSize int   `yaml:"cleanupBatchSize"` // expired uploads handled per run
+}
+
+// MediaProbe controls the ffprobe check of uploaded files before their transcode is queued
+type MediaProbe struct {
+	FFProbePath string   `yaml:"ffprobePath"`
+	Timeout     int      `yaml:"timeout"`     // in seconds, a probe running longer fails the upload
+	MaxDuration int      `yaml:"maxDuration"` // in seconds
+	MaxWidth    int      `yaml:"maxWidth"`    // of a landscape video, swapped with MaxHeight for portrait ones
+	MaxHeight   int      `yaml:"maxHeight"`
+	VideoCodecs []string `yaml:"videoCodecs"` // codec names as reported by ffprobe
+}
+
+// Thumbnail controls the custom thumbnails uploaded by the owners of vods
+type Thumbnail struct {
+	FFMpegPath  string `yaml:"ffmpegPath"`
+	MaxFileSize int64  `yaml:"maxFileSize"` // in bytes
+	MaxWidth    int    `yaml:"maxWidth"`    // larger images are scaled down to fit, keeping their aspect ratio
+	MaxHeight   int    `yaml:"maxHeight"`
+}
+
+// TranscodeJob controls the leases of the transcode jobs handed to the workers
+type TranscodeJob struct {
+	LeaseDuration  int `yaml:"leaseDuration"`  // in seconds, a worker that sends no heartbeat for this long loses the job
+	RetryBaseDelay int `yaml:"retryBaseDelay"` // in seconds, delay before the second attempt, doubled for every further one
+	RetryMaxDelay  int `yaml:"retryMaxDelay"`  // in seconds, upper bound of the delay between attempts
+	ReapInterval   int `yaml:"reapInterval"`   // in seconds, how often expired leases are reclaimed
+	ReapBatchSize  int `yaml:"reapBatchSize"`  // expired jobs reclaimed per run
+}
+
+// Clip controls the clips cut out of vods and livestreams by the transcode workers
+type Clip struct {
+	MinDuration int `yaml:"minDuration"` // in seconds
+	MaxDuration int `yaml:"maxDuration"` // in seconds
+	// the live playlists of the transcode service, a livestream is read from {LivePlaylistURLPrefix}/{livestreamId}/index.m3u8
+	LivePlaylistURLPrefix string `yaml:"livePlaylistUrlPrefix"`
+	LeaseDuration         int    `yaml:"leaseDuration"` // in seconds, a clip whose worker is silent for this long is cut again
+	MaxAttempts           int    `yaml:"maxAttempts"`
+}
+
+// VODEdit controls the trims and splits of vods
+type VODEdit struct {
+	MinPartDuration int `yaml:"minPartDuration"` // in seconds, of the trimmed vod and of every part of a split
+	// in seconds, how long the segments an edit dropped are kept for the viewers still playing the previous playlists
+	CollectDelay int `yaml:"collectDelay"`
+}
+
+// EventBus is the NATS server the events of the service are published to, nothing is published when URL is empty
+type EventBus struct {
+	URL string `yaml:"url"`
 }
 
 type Config struct {
-	Service  `yaml:"service"`
-	Database `yaml:"database"`
-	Tracer   `yaml:"tracer"`
-	MinIO    `yaml:"minio"`
+	Service      `yaml:"service"`
+	Database     `yaml:"database"`
+	Tracer       `yaml:"tracer"`
+	MinIO        `yaml:"minio"`
+	MediaCleanup `yaml:"mediaCleanup"`
+	Upload       `yaml:"upload"`
+	MediaProbe   `yaml:"mediaProbe"`
+	Thumbnail    `yaml:"thumbnail"`
+	TranscodeJob `yaml:"transcodeJob"`
+	Clip         `yaml:"clip"`
+	VODEdit      `yaml:"vodEdit"`
+	EventBus     `yaml:"eventBus"`
 }
 
 // TracerConfig interface methods
@@ -53,7 +129,8 @@ func (c Config) GetTracerEndpoint() string  { return c.Tracer.Endpoint }
 func (c Config) GetTracerBatchTimeout() int { return c.Tracer.BatchTimeout }
 func (c Config) IsSecure() bool             { return c.Tracer.Secure }
 
-// PostProcess builds the database connection string from environment variables.
+// PostProcess builds the database connection string from environment variables
+// and fills the media cleanup, upload, media probe, thumbnail, transcode job, clip and vod edit defaults.
 func PostProcess(config *Config) error {
 	dbUser := os.Getenv("VOD_DB_USER")
 	dbPassword := os.Getenv("VOD_DB_PASSWORD")
@@ -72,5 +149,102 @@ func PostProcess(config *Config) error {
 	}
 	config.Database.ConnectionString = dbURL.String()
 
+	if config.MediaCleanup.Interval <= 0 {
+		config.MediaCleanup.Interval = 30
+	}
+	if config.MediaCleanup.BatchSize <= 0 {
+		config.MediaCleanup.BatchSize = 10
+	}
+	if config.MediaCleanup.MaxBackoff <= 0 {
+		config.MediaCleanup.MaxBackoff = 3600
+	}
+	if config.MediaCleanup.TranscodeGracePeriod <= 0 {
+		config.MediaCleanup.TranscodeGracePeriod = 600
+	}
+
+	// storage rejects multipart parts smaller than 5MiB, except the last one
+	if config.Upload.PartSize <= 0 {
+		config.Upload.PartSize = 16 << 20
+	}
+	config.Upload.PartSize = max(config.Upload.PartSize, 5<<20)
+	if config.Upload.MaxFileSize <= 0 {
+		config.Upload.MaxFileSize = 2 << 30
+	}
+	if config.Upload.Expiry <= 0 {
+		config.Upload.Expiry = 86400
+	}
+	if config.Upload.CleanupInterval <= 0 {
+		config.Upload.CleanupInterval = 300
+	}
+	if config.Upload.CleanupBatchSize <= 0 {
+		config.Upload.CleanupBatchSize = 20
+	}
+
+	if config.MediaProbe.FFProbePath == "" {
+		config.MediaProbe.FFProbePath = "ffprobe"
+	}
+	if config.MediaProbe.Timeout <= 0 {
+		config.MediaProbe.Timeout = 60
+	}
+	if config.MediaProbe.MaxDuration <= 0 {
+		config.MediaProbe.MaxDuration = 4 * 3600
+	}
+	if config.MediaProbe.MaxWidth <= 0 || config.MediaProbe.MaxHeight <= 0 {
+		config.MediaProbe.MaxWidth, config.MediaProbe.MaxHeight = 3840, 2160
+	}
+	if len(config.MediaProbe.VideoCodecs) == 0 {
+		config.MediaProbe.VideoCodecs = []string{"h264", "hevc", "vp8", "vp9", "av1", "mpeg4"}
+	}
+
+	if config.Thumbnail.FFMpegPath == "" {
+		config.Thumbnail.FFMpegPath = "ffmpeg"
+	}
+	if config.Thumbnail.MaxFileSize <= 0 {
+		config.Thumbnail.MaxFileSize = 5 << 20
+	}
+	if config.Thumbnail.MaxWidth <= 0 || config.Thumbnail.MaxHeight <= 0 {
+		config.Thumbnail.MaxWidth, config.Thumbnail.MaxHeight = 1280, 720
+	}
+
+	if config.TranscodeJob.LeaseDuration <= 0 {
+		config.TranscodeJob.LeaseDuration = 120
+	}
+	if config.TranscodeJob.RetryBaseDelay <= 0 {
+		config.TranscodeJob.RetryBaseDelay = 30
+	}
+	if config.TranscodeJob.RetryMaxDelay <= 0 {
+		config.TranscodeJob.RetryMaxDelay = 1800
+	}
+	if config.TranscodeJob.ReapInterval <= 0 {
+		config.TranscodeJob.ReapInterval = 60
+	}
+	if config.TranscodeJob.ReapBatchSize <= 0 {
+		config.TranscodeJob.ReapBatchSize = 20
+	}
+
+	if config.Clip.MinDuration <= 0 {
+		config.Clip.MinDuration = 5
+	}
+	if config.Clip.MaxDuration <= 0 {
+		config.Clip.MaxDuration = 120
+	}
+	if config.Clip.LivePlaylistURLPrefix == "" {
+		config.Clip.LivePlaylistURLPrefix = "http://transcode.service.consul:8889/static"
+	}
+	config.Clip.LivePlaylistURLPrefix = strings.TrimSuffix(config.Clip.LivePlaylistURLPrefix, "/")
+	if config.Clip.LeaseDuration <= 0 {
+		config.Clip.LeaseDuration = 600
+	}
+	if config.Clip.MaxAttempts <= 0 {
+		config.Clip.MaxAttempts = 3
+	}
+
+	if config.VODEdit.MinPartDuration <= 0 {
+		config.VODEdit.MinPartDuration = 5
+	}
+	if config.VODEdit.CollectDelay <= 0 {
+		config.VODEdit.CollectDelay = 6 * 3600
+	}
+
 	return nil
 }
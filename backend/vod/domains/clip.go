@@ -0,0 +1,92 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type ClipMode string
+
+const (
+	ClipModeCopy     ClipMode = "copy"     // the source segments covering the range are copied, the clip starts and ends on their boundaries
+	ClipModeReencode ClipMode = "reencode" // the range is cut at the exact frames and transcoded again
+)
+
+type ClipStatus string
+
+const (
+	ClipStatusPending    ClipStatus = "pending"
+	ClipStatusProcessing ClipStatus = "processing"
+	ClipStatusReady      ClipStatus = "ready"
+	ClipStatusFailed     ClipStatus = "failed"
+)
+
+// Clip is a range of a vod or of a livestream, StartSeconds and EndSeconds are offsets from the start
+// of the source, the beginning of the stream for a livestream
+type Clip struct {
+	Id                 uuid.UUID  `json:"id" db:"id"`
+	UserId             uuid.UUID  `json:"userId" db:"user_id"`       // the author of the clip
+	ChannelId          uuid.UUID  `json:"channelId" db:"channel_id"` // the owner of the source
+	SourceVodId        *uuid.UUID `json:"sourceVodId,omitempty" db:"source_vod_id"`
+	SourceLivestreamId *uuid.UUID `json:"sourceLivestreamId,omitempty" db:"source_livestream_id"`
+	Title              string     `json:"title" db:"title"`
+	StartSeconds       float64    `json:"startSeconds" db:"start_seconds"`
+	EndSeconds         float64    `json:"endSeconds" db:"end_seconds"`
+	Mode               ClipMode   `json:"mode" db:"mode"`
+	SourcePlaybackURL  string     `json:"-" db:"source_playback_url"`
+	Status             ClipStatus `json:"status" db:"status"`
+	PlaybackURL        *string    `json:"playbackUrl" db:"playback_url"`
+	ThumbnailURL       *string    `json:"thumbnailUrl" db:"thumbnail_url"`
+	Duration           int64      `json:"duration" db:"duration"`
+	ViewCount          int64      `json:"viewCount" db:"view_count"`
+	Attempts           int        `json:"-" db:"attempts"`
+	MaxAttempts        int        `json:"-" db:"max_attempts"`
+	ErrorMsg           *string    `json:"-" db:"error_message"`
+	CreatedAt          time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt          time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// ClipSource picks the clips listed by ClipRepository.GetBySource, exactly one of its fields is set
+type ClipSource struct {
+	VodId        *uuid.UUID
+	LivestreamId *uuid.UUID
+}
+
+// LeasedClipJob is a clip handed to a transcode worker, which owns it until LeaseExpiresAt,
+// the source is read from SourcePlaybackURL, the dvr window of the livestream when IsLive
+type LeasedClipJob struct {
+	Id                uuid.UUID `json:"id" db:"id"`
+	Mode              ClipMode  `json:"mode" db:"mode"`
+	SourcePlaybackURL string    `json:"sourcePlaybackUrl" db:"source_playback_url"`
+	IsLive            bool      `json:"isLive" db:"is_live"`
+	StartSeconds      float64   `json:"startSeconds" db:"start_seconds"`
+	EndSeconds        float64   `json:"endSeconds" db:"end_seconds"`
+	Attempts          int       `json:"attempts" db:"attempts"`
+	MaxAttempts       int       `json:"maxAttempts" db:"max_attempts"`
+	LeaseId           uuid.UUID `json:"leaseId" db:"lease_id"`
+	LeaseExpiresAt    time.Time `json:"leaseExpiresAt" db:"lease_expires_at"`
+}
+
+// ClipJobNotifier wakes the transcode workers up when a clip is queued
+type ClipJobNotifier interface {
+	NotifyClipQueued(ctx context.Context, clipId uuid.UUID)
+}
+
+type ClipRepository interface {
+	Create(ctx context.Context, clip Clip) (*Clip, *response.Response[any])
+	GetById(ctx context.Context, clipId uuid.UUID) (*Clip, *response.Response[any])
+	// GetByChannel and GetBySource only return the ready clips, newest first
+	GetByChannel(ctx context.Context, channelId uuid.UUID, page int, limit int) ([]Clip, *response.Response[any])
+	GetBySource(ctx context.Context, source ClipSource, page int, limit int) ([]Clip, *response.Response[any])
+	IncrementViewCount(ctx context.Context, clipId uuid.UUID) *response.Response[any]
+	// Lease marks the oldest due pending clip, or a processing one whose lease expired, as processing under a new lease
+	// and returns it, nil when there is none. Clips whose lease expired on their last attempt are failed on the way
+	Lease(ctx context.Context, lease time.Duration) (*LeasedClipJob, *response.Response[any])
+	// Complete and Fail fail with RES_ERR_CLIP_NOT_PROCESSING once the lease was taken from the worker
+	Complete(ctx context.Context, clipId uuid.UUID, leaseId uuid.UUID, playbackUrl string, thumbnailUrl *string, duration int64) *response.Response[any]
+	// Fail puts the clip back to pending after the backoff, or fails it when it is not retryable or has no attempt left
+	Fail(ctx context.Context, clipId uuid.UUID, leaseId uuid.UUID, errorMsg string, retryable bool, backoff RetryBackoff) (ClipStatus, *response.Response[any])
+}
@@ -2,6 +2,7 @@ package domains
 
 import (
 	"context"
+	"sen1or/letslive/shared/pkg/search"
 	response "sen1or/letslive/vod/response"
 	"time"
 
@@ -22,23 +23,54 @@ const (
 	VODStatusProcessing VODStatus = "processing"
 	VODStatusReady      VODStatus = "ready"
 	VODStatusFailed     VODStatus = "failed"
+	VODStatusDeleting   VODStatus = "deleting" // hidden everywhere, media is being removed before the row is deleted
 )
 
 type VOD struct {
-	Id              uuid.UUID     `json:"id" db:"id"`
-	LivestreamId    *uuid.UUID    `json:"livestreamId" db:"livestream_id"`
-	UserId          uuid.UUID     `json:"userId" db:"user_id"`
-	Title           string        `json:"title" db:"title"`
-	Description     *string       `json:"description" db:"description"`
-	ThumbnailURL    *string       `json:"thumbnailUrl" db:"thumbnail_url"`
-	Visibility      VODVisibility `json:"visibility" db:"visibility"`
-	ViewCount       int64         `json:"viewCount" db:"view_count"`
-	Duration        int64         `json:"duration" db:"duration"`
-	PlaybackURL     *string       `json:"playbackUrl" db:"playback_url"`
-	Status          VODStatus     `json:"status" db:"status"`
-	OriginalFileURL *string       `json:"originalFileUrl,omitempty" db:"original_file_url"`
-	CreatedAt       time.Time     `json:"createdAt" db:"created_at"`
-	UpdatedAt       time.Time     `json:"updatedAt" db:"updated_at"`
+	Id                  uuid.UUID     `json:"id" db:"id"`
+	LivestreamId        *uuid.UUID    `json:"livestreamId" db:"livestream_id"`
+	UserId              uuid.UUID     `json:"userId" db:"user_id"`
+	Title               string        `json:"title" db:"title"`
+	Description         *string       `json:"description" db:"description"`
+	ThumbnailURL        *string       `json:"thumbnailUrl" db:"thumbnail_url"`
+	ThumbnailCandidates []string      `json:"-" db:"thumbnail_candidates"` // frames the owner can pick the thumbnail from
+	Visibility          VODVisibility `json:"visibility" db:"visibility"`
+	Category            *string       `json:"category" db:"category"` // slug of the category of the livestream it was recorded from
+	Tags                []string      `json:"tags" db:"tags"`
+	ViewCount           int64         `json:"viewCount" db:"view_count"`
+	PeakViewerCount     *int          `json:"peakViewerCount,omitempty" db:"peak_viewer_count"` // viewers of the livestream it was recorded from, nil for the uploaded vods
+	AverageViewerCount  *int          `json:"averageViewerCount,omitempty" db:"average_viewer_count"`
+	Duration            int64         `json:"duration" db:"duration"`
+	PlaybackURL         *string       `json:"playbackUrl" db:"playback_url"`
+	PreviewTrackURL     *string       `json:"previewTrackUrl,omitempty" db:"preview_track_url"` // WebVTT track of the seek bar previews
+	Status              VODStatus     `json:"status" db:"status"`
+	OriginalFileURL     *string       `json:"originalFileUrl,omitempty" db:"original_file_url"`
+	Width               *int          `json:"width,omitempty" db:"width"`
+	Height              *int          `json:"height,omitempty" db:"height"`
+	FrameRate           *float64      `json:"frameRate,omitempty" db:"frame_rate"`
+	VideoCodec          *string       `json:"videoCodec,omitempty" db:"video_codec"`
+	AudioCodec          *string       `json:"audioCodec,omitempty" db:"audio_codec"`
+	MediaPrefix         *string       `json:"-" db:"media_prefix"`       // set on the vods split from another one, see MediaFolder
+	Chapters            []VODChapter  `json:"chapters,omitempty" db:"-"` // only filled when a single vod is read
+	CreatedAt           time.Time     `json:"createdAt" db:"created_at"`
+	UpdatedAt           time.Time     `json:"updatedAt" db:"updated_at"`
+}
+
+// MediaFolder is the folder of the vod bucket holding the segments the playlists of the vod reference
+func (v VOD) MediaFolder() string {
+	return mediaFolder(v.Id, v.LivestreamId, v.MediaPrefix)
+}
+
+// mediaFolder is the folder a vod was transcoded or recorded into unless it was split from another vod,
+// it then shares the folder of that vod
+func mediaFolder(vodId uuid.UUID, livestreamId *uuid.UUID, mediaPrefix *string) string {
+	if mediaPrefix != nil {
+		return *mediaPrefix
+	}
+	if livestreamId != nil {
+		return livestreamId.String()
+	}
+	return vodId.String()
 }
 
 type TranscodeJobStatus string
@@ -48,6 +80,7 @@ const (
 	TranscodeJobProcessing TranscodeJobStatus = "processing"
 	TranscodeJobCompleted  TranscodeJobStatus = "completed"
 	TranscodeJobFailed     TranscodeJobStatus = "failed"
+	TranscodeJobCancelled  TranscodeJobStatus = "cancelled"
 )
 
 type TranscodeJob struct {
@@ -61,23 +94,90 @@ type TranscodeJob struct {
 	UpdatedAt   time.Time          `json:"updatedAt" db:"updated_at"`
 	StartedAt   *time.Time         `json:"startedAt,omitempty" db:"started_at"`
 	CompletedAt *time.Time         `json:"completedAt,omitempty" db:"completed_at"`
+
+	LeaseId        *uuid.UUID `json:"-" db:"lease_id"`
+	LeaseExpiresAt *time.Time `json:"leaseExpiresAt,omitempty" db:"lease_expires_at"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt" db:"next_attempt_at"`
+
+	Progress          float64    `json:"progress" db:"progress"` // in percent of the running attempt
+	EtaSeconds        *int       `json:"etaSeconds,omitempty" db:"eta_seconds"`
+	ProgressUpdatedAt *time.Time `json:"progressUpdatedAt,omitempty" db:"progress_updated_at"`
+}
+
+// VODFilter narrows a listing of vods, a nil field does not filter
+type VODFilter struct {
+	Category *string
+	Tag      *string
+}
+
+// VODPosition is a place in a listing of vods ordered by creation, the id breaks the ties
+type VODPosition struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        uuid.UUID `json:"id"`
 }
 
 type VODRepository interface {
 	GetById(ctx context.Context, id uuid.UUID) (*VOD, *response.Response[any])
 	GetByUser(ctx context.Context, userId uuid.UUID, page int, limit int) ([]VOD, *response.Response[any])
 	GetPublicVODsByUser(ctx context.Context, userId uuid.UUID, page int, limit int) ([]VOD, *response.Response[any])
-	GetPopular(ctx context.Context, page int, limit int) ([]VOD, *response.Response[any])
+	// GetRecommendationCandidates returns the public ready vods among the recentLimit newest and the popularLimit most viewed
+	GetRecommendationCandidates(ctx context.Context, filter VODFilter, recentLimit int, popularLimit int) ([]VOD, *response.Response[any])
+	// GetRecentPublicByUsers returns the public ready vods of the users created before the position, newest first
+	GetRecentPublicByUsers(ctx context.Context, userIds []uuid.UUID, before *VODPosition, limit int) ([]VOD, *response.Response[any])
+	// Search returns the public ready vods whose title or description match the query, the best match first
+	Search(ctx context.Context, query string, language search.Language, page int, limit int) ([]VOD, *response.Response[any])
 	IncrementViewCount(ctx context.Context, id uuid.UUID) *response.Response[any]
 	Create(ctx context.Context, vod VOD) (*VOD, *response.Response[any])
 	Update(ctx context.Context, vod VOD) (*VOD, *response.Response[any])
 	UpdateStatus(ctx context.Context, vodId uuid.UUID, status VODStatus, playbackUrl *string, thumbnailUrl *string) *response.Response[any]
+	// UpdateThumbnail only sets the thumbnail so it never races with the transcode completing the vod
+	UpdateThumbnail(ctx context.Context, vodId uuid.UUID, thumbnailUrl string) (*VOD, *response.Response[any])
 	Delete(ctx context.Context, id uuid.UUID) *response.Response[any]
+	// GetByMediaFolder returns the vods, apart from the ones being deleted, whose playlists reference segments of the folder
+	GetByMediaFolder(ctx context.Context, mediaFolder string) ([]VOD, *response.Response[any])
+}
+
+// LeasedTranscodeJob is a job handed to a transcode worker with what it needs to process it
+// LeasedTranscodeJob is a job handed to a worker, which owns it as long as it keeps extending the lease
+type LeasedTranscodeJob struct {
+	Id              uuid.UUID `json:"id" db:"id"`
+	VodId           uuid.UUID `json:"vodId" db:"vod_id"`
+	Attempts        int       `json:"attempts" db:"attempts"`
+	MaxAttempts     int       `json:"maxAttempts" db:"max_attempts"`
+	OriginalFileURL *string   `json:"originalFileUrl" db:"original_file_url"`
+	LeaseId         uuid.UUID `json:"leaseId" db:"lease_id"`
+	LeaseExpiresAt  time.Time `json:"leaseExpiresAt" db:"lease_expires_at"`
+}
+
+// TranscodeJobNotifier wakes the transcode workers up when a job becomes pending
+type TranscodeJobNotifier interface {
+	NotifyQueued(ctx context.Context, vodId uuid.UUID)
+}
+
+// RetryBackoff is the delay before a failed job is attempted again, doubling from Base with every attempt up to Max
+type RetryBackoff struct {
+	Base time.Duration
+	Max  time.Duration
 }
 
 type TranscodeJobRepository interface {
 	Create(ctx context.Context, job TranscodeJob) (*TranscodeJob, *response.Response[any])
-	GetPendingJob(ctx context.Context) (*TranscodeJob, *response.Response[any])
-	UpdateStatus(ctx context.Context, jobId uuid.UUID, status TranscodeJobStatus, errorMsg *string) *response.Response[any]
-	IncrementAttempts(ctx context.Context, jobId uuid.UUID) *response.Response[any]
+	// Lease marks the oldest due pending job as processing under a new lease and returns it, nil when there is no due job
+	Lease(ctx context.Context, lease time.Duration) (*LeasedTranscodeJob, *response.Response[any])
+	// Heartbeat extends the lease and returns its new expiry, the methods taking a leaseId fail with
+	// RES_ERR_TRANSCODE_JOB_NOT_PROCESSING once the job was cancelled or its lease expired and was taken from the worker
+	Heartbeat(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, lease time.Duration) (*time.Time, *response.Response[any])
+	// Complete marks the job completed and its vod ready in one transaction, a thumbnail the owner already set is kept
+	Complete(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, playbackUrl *string, thumbnailUrl *string, thumbnailCandidates []string, previewTrackUrl *string, duration *int64) *response.Response[any]
+	// Fail puts the job back to pending after the backoff, or fails it together with its vod when it is not retryable or has no attempt left
+	Fail(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, errorMsg string, retryable bool, backoff RetryBackoff) (TranscodeJobStatus, *response.Response[any])
+	// GetLatestByVodId returns the last job queued for the vod, nil when it never had one (e.g. it was recorded from a livestream)
+	GetLatestByVodId(ctx context.Context, vodId uuid.UUID) (*TranscodeJob, *response.Response[any])
+	// ReportProgress records the progress of the running attempt, etaSeconds is nil until it can be estimated
+	ReportProgress(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, progress float64, etaSeconds *int) *response.Response[any]
+	// Release puts a job its worker gave up on while stopping back to pending right away, without counting the attempt,
+	// and returns the id of its vod
+	Release(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID) (*uuid.UUID, *response.Response[any])
+	// ReapExpired does the same as Fail for at most limit jobs whose lease expired, e.g. their worker crashed
+	ReapExpired(ctx context.Context, limit int, backoff RetryBackoff) ([]TranscodeJob, *response.Response[any])
 }
@@ -0,0 +1,22 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// VODChapter starts at StartSeconds and runs until the start of the next chapter of the vod or its end
+type VODChapter struct {
+	VodId        uuid.UUID `json:"-" db:"vod_id"`
+	StartSeconds float64   `json:"startSeconds" db:"start_seconds"`
+	Title        string    `json:"title" db:"title"`
+}
+
+type VODChapterRepository interface {
+	// GetByVodId returns the chapters of the vod ordered by their start
+	GetByVodId(ctx context.Context, vodId uuid.UUID) ([]VODChapter, *response.Response[any])
+	// Replace sets the chapters of the vod in one transaction, an empty list removes them
+	Replace(ctx context.Context, vodId uuid.UUID, chapters []VODChapter) *response.Response[any]
+}
@@ -0,0 +1,40 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// VODDeletion tracks the removal of a deleted vod's media from storage,
+// the vod row (and this one with it) is only deleted once every object is gone
+type VODDeletion struct {
+	VodId         uuid.UUID `json:"vodId" db:"vod_id"`
+	Attempts      int       `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time `json:"nextAttemptAt" db:"next_attempt_at"`
+	LastError     *string   `json:"lastError,omitempty" db:"last_error"`
+	CreatedAt     time.Time `json:"createdAt" db:"created_at"`
+
+	// copied from the vod, needed to locate its objects
+	LivestreamId    *uuid.UUID `json:"livestreamId" db:"livestream_id"`
+	PlaybackURL     *string    `json:"playbackUrl" db:"playback_url"`
+	ThumbnailURL    *string    `json:"thumbnailUrl" db:"thumbnail_url"`
+	OriginalFileURL *string    `json:"originalFileUrl" db:"original_file_url"`
+	MediaPrefix     *string    `json:"-" db:"media_prefix"`
+}
+
+// MediaFolder is the folder of the vod bucket holding the segments of the deleted vod, see VOD.MediaFolder
+func (d VODDeletion) MediaFolder() string {
+	return mediaFolder(d.VodId, d.LivestreamId, d.MediaPrefix)
+}
+
+type VODDeletionRepository interface {
+	// MarkDeleting hides the vod, cancels its unfinished transcode jobs and schedules the media cleanup,
+	// the cleanup is delayed by transcodeGracePeriod when a job was being processed so the worker can notice the cancellation
+	MarkDeleting(ctx context.Context, vodId uuid.UUID, transcodeGracePeriod time.Duration) *response.Response[any]
+	// ClaimDue returns the deletions whose next attempt is due, pushing their next attempt by lease so other replicas skip them
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]VODDeletion, *response.Response[any])
+	RecordFailure(ctx context.Context, vodId uuid.UUID, errorMsg string, nextAttemptAt time.Time) *response.Response[any]
+}
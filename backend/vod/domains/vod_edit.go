@@ -0,0 +1,56 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// VODRange is a range of a vod, in seconds from its start
+type VODRange struct {
+	Start float64
+	End   float64
+}
+
+// VODEdit replaces the playlist of a trimmed or split vod, the vod keeps its first part and a new vod is
+// created for each other part. The new playlists reference the segments of the vod, nothing is uploaded again
+type VODEdit struct {
+	VodId uuid.UUID
+	// the playlist the edit was made from, the edit is rejected when the vod was edited meanwhile
+	PreviousPlaybackURL string
+	PlaybackURL         string
+	Duration            int64
+	// the chapters of the vod moved to the edited timeline, they replace the previous ones
+	Chapters []VODChapter
+	// the vods split from the vod, created ready and sharing its media folder, with their Chapters
+	Parts []VOD
+
+	// the media folder is collected at CollectAt, once no viewer can still be playing the previous playlists
+	MediaFolder string
+	Bucket      string
+	CollectAt   time.Time
+}
+
+// VODMediaCollection removes the segments of a media folder that no playlist references anymore
+type VODMediaCollection struct {
+	MediaPrefix   string    `json:"mediaPrefix" db:"media_prefix"`
+	Bucket        string    `json:"bucket" db:"bucket"`
+	Attempts      int       `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time `json:"nextAttemptAt" db:"next_attempt_at"`
+	LastError     *string   `json:"lastError,omitempty" db:"last_error"`
+	ScheduledAt   time.Time `json:"scheduledAt" db:"scheduled_at"`
+}
+
+type VODEditRepository interface {
+	// Apply updates the vod and its chapters, creates its parts and schedules the collection of its media folder in one transaction,
+	// it returns the vod followed by its parts and fails with RES_ERR_VOD_EDIT_CONFLICT when the vod is no longer ready
+	// or its playlist changed since PreviousPlaybackURL
+	Apply(ctx context.Context, edit VODEdit) ([]VOD, *response.Response[any])
+	// ClaimDueCollections returns the collections whose next attempt is due, pushing their next attempt by lease so other replicas skip them
+	ClaimDueCollections(ctx context.Context, limit int, lease time.Duration) ([]VODMediaCollection, *response.Response[any])
+	// CompleteCollection removes the collection unless another edit scheduled it again after scheduledAt
+	CompleteCollection(ctx context.Context, mediaPrefix string, scheduledAt time.Time) *response.Response[any]
+	RecordCollectionFailure(ctx context.Context, mediaPrefix string, errorMsg string, nextAttemptAt time.Time) *response.Response[any]
+}
@@ -0,0 +1,47 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrUnreadableMedia is returned by a MediaProber when the file holds no decodable video stream
+var ErrUnreadableMedia = errors.New("no decodable video stream")
+
+// ErrUnreadableImage is returned by an ImageResizer when the image can't be decoded
+var ErrUnreadableImage = errors.New("image can not be decoded")
+
+// VODMediaInfo is what was read from an uploaded file before queuing its transcode
+type VODMediaInfo struct {
+	Duration   time.Duration
+	Width      int
+	Height     int
+	FrameRate  float64 // 0 when the container does not tell
+	VideoCodec string
+	AudioCodec string // empty when the file has no audio
+}
+
+type MediaProber interface {
+	// Probe reads the streams of the file at url, it fails with ErrUnreadableMedia when there is no video to transcode
+	Probe(ctx context.Context, url string) (*VODMediaInfo, error)
+}
+
+type ImageResizer interface {
+	// ResizeToJPEG scales the image down to fit maxWidth x maxHeight, keeping its aspect ratio, and encodes it as jpeg
+	ResizeToJPEG(ctx context.Context, image []byte, maxWidth int, maxHeight int) ([]byte, error)
+}
+
+// ApplyMediaInfo records the probed metadata on the vod
+func (v *VOD) ApplyMediaInfo(info VODMediaInfo) {
+	v.Duration = int64(info.Duration.Round(time.Second) / time.Second)
+	v.Width = &info.Width
+	v.Height = &info.Height
+	if info.FrameRate > 0 {
+		v.FrameRate = &info.FrameRate
+	}
+	v.VideoCodec = &info.VideoCodec
+	if info.AudioCodec != "" {
+		v.AudioCodec = &info.AudioCodec
+	}
+}
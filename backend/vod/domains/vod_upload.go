@@ -0,0 +1,82 @@
+package domains
+
+import (
+	"context"
+	response "sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type VODUploadStatus string
+
+const (
+	VODUploadUploading  VODUploadStatus = "uploading"
+	VODUploadCompleting VODUploadStatus = "completing" // the multipart upload is being assembled in storage
+	VODUploadExpired    VODUploadStatus = "expired"    // claimed by the cleanup, the parts are being aborted
+)
+
+// VODUpload is a resumable upload of a vod's raw file, backed by a multipart upload in storage,
+// the vod row exists with status 'uploading' until the upload is completed
+type VODUpload struct {
+	Id                uuid.UUID       `json:"id" db:"id"`
+	VodId             uuid.UUID       `json:"vodId" db:"vod_id"`
+	UserId            uuid.UUID       `json:"userId" db:"user_id"`
+	Filename          string          `json:"filename" db:"filename"`
+	ObjectName        string          `json:"-" db:"object_name"`
+	MultipartUploadId string          `json:"-" db:"multipart_upload_id"`
+	ContentType       string          `json:"contentType" db:"content_type"`
+	TotalSize         int64           `json:"totalSize" db:"total_size"`
+	PartSize          int64           `json:"partSize" db:"part_size"`
+	Status            VODUploadStatus `json:"status" db:"status"`
+	ExpiresAt         time.Time       `json:"expiresAt" db:"expires_at"`
+	CreatedAt         time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt         time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+type VODUploadPart struct {
+	UploadId   uuid.UUID `json:"uploadId" db:"upload_id"`
+	PartNumber int       `json:"partNumber" db:"part_number"`
+	ETag       string    `json:"etag" db:"etag"`
+	Size       int64     `json:"size" db:"size"`
+	CreatedAt  time.Time `json:"createdAt" db:"created_at"`
+}
+
+// PartCount is the number of parts the file is split in, only the last one may be smaller than PartSize
+func (u VODUpload) PartCount() int {
+	if u.PartSize <= 0 {
+		return 0
+	}
+	return int((u.TotalSize + u.PartSize - 1) / u.PartSize)
+}
+
+// ExpectedPartSize is the exact size the given 1-based part must have
+func (u VODUpload) ExpectedPartSize(partNumber int) int64 {
+	count := u.PartCount()
+	if partNumber < 1 || partNumber > count {
+		return 0
+	}
+	if partNumber < count {
+		return u.PartSize
+	}
+	return u.TotalSize - int64(count-1)*u.PartSize
+}
+
+type VODUploadRepository interface {
+	// Create inserts the vod with status 'uploading' together with its upload
+	Create(ctx context.Context, vod VOD, upload VODUpload) (*VODUpload, *response.Response[any])
+	GetById(ctx context.Context, uploadId uuid.UUID) (*VODUpload, *response.Response[any])
+	GetParts(ctx context.Context, uploadId uuid.UUID) ([]VODUploadPart, *response.Response[any])
+	// SavePart records an uploaded part and pushes the upload's expiry to expiresAt,
+	// it fails with RES_ERR_VOD_UPLOAD_NOT_FOUND once the upload is no longer accepting parts
+	SavePart(ctx context.Context, part VODUploadPart, expiresAt time.Time) *response.Response[any]
+	// MarkCompleting locks the upload against the cleanup while storage assembles it for at most lease
+	MarkCompleting(ctx context.Context, uploadId uuid.UUID, lease time.Duration) *response.Response[any]
+	// ReleaseCompleting puts an upload whose assembly failed back to 'uploading' until expiresAt
+	ReleaseCompleting(ctx context.Context, uploadId uuid.UUID, expiresAt time.Time) *response.Response[any]
+	// Complete sets the raw file and the probed metadata of the vod, queues its transcode job and removes the upload in one transaction
+	Complete(ctx context.Context, uploadId uuid.UUID, originalFileURL string, media VODMediaInfo, job TranscodeJob) (*VOD, *response.Response[any])
+	// ClaimExpired returns the uploads past their expiry, including ones stuck while completing or being cleaned up,
+	// and pushes their expiry by lease so other replicas skip them
+	ClaimExpired(ctx context.Context, limit int, lease time.Duration) ([]VODUpload, *response.Response[any])
+}
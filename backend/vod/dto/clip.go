@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateClipRequestDTO clips either a vod or a livestream that is live, the offsets are in seconds from the start of the source
+type CreateClipRequestDTO struct {
+	VodId        *uuid.UUID `json:"vodId,omitempty" validate:"required_without=LivestreamId,excluded_with=LivestreamId"`
+	LivestreamId *uuid.UUID `json:"livestreamId,omitempty" validate:"required_without=VodId"`
+	Title        string     `json:"title" validate:"required,gte=3,lte=100"`
+	StartSeconds float64    `json:"startSeconds" validate:"gte=0"`
+	EndSeconds   float64    `json:"endSeconds" validate:"gtfield=StartSeconds"`
+	Mode         string     `json:"mode,omitempty" validate:"omitempty,oneof=copy reencode"`
+}
+
+type CompleteClipJobRequestDTO struct {
+	LeaseId      uuid.UUID `json:"leaseId" validate:"required"`
+	PlaybackUrl  string    `json:"playbackUrl" validate:"required,url,lte=2048"`
+	ThumbnailUrl *string   `json:"thumbnailUrl,omitempty" validate:"omitempty,url,lte=2048"`
+	Duration     int64     `json:"duration" validate:"gte=0"`
+}
+
+// FailClipJobRequestDTO reports a failed attempt, a clip that is not retryable
+// (e.g. its range left the dvr window) is failed right away
+type FailClipJobRequestDTO struct {
+	LeaseId      uuid.UUID `json:"leaseId" validate:"required"`
+	ErrorMessage string    `json:"errorMessage" validate:"required"`
+	Retryable    bool      `json:"retryable"`
+}
+
+type FailClipJobResponseDTO struct {
+	Status string `json:"status"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetRecentVODsOfUsersRequestDTO pages through the vods of the users in one request, callers with more users send
+// them in batches
+type GetRecentVODsOfUsersRequestDTO struct {
+	UserIds []uuid.UUID `json:"userIds" validate:"max=1000"`
+	// the vods created before this one, nil starts from the newest
+	Before *domains.VODPosition `json:"before"`
+	Limit  int                  `json:"limit" validate:"min=1,max=100"`
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// the requests about a leased job carry the lease id, they are rejected once the worker lost the job
+
+type HeartbeatTranscodeJobRequestDTO struct {
+	LeaseId uuid.UUID `json:"leaseId" validate:"required"`
+}
+
+type HeartbeatTranscodeJobResponseDTO struct {
+	LeaseExpiresAt time.Time `json:"leaseExpiresAt"`
+}
+
+// ReportTranscodeJobProgressRequestDTO is the progress of the running attempt in percent, EtaSeconds is left out until it can be estimated
+type ReportTranscodeJobProgressRequestDTO struct {
+	LeaseId    uuid.UUID `json:"leaseId" validate:"required"`
+	Progress   float64   `json:"progress" validate:"gte=0,lte=100"`
+	EtaSeconds *int      `json:"etaSeconds,omitempty" validate:"omitempty,gte=0"`
+}
+
+type ReleaseTranscodeJobRequestDTO struct {
+	LeaseId uuid.UUID `json:"leaseId" validate:"required"`
+}
+
+type CompleteTranscodeJobRequestDTO struct {
+	LeaseId                uuid.UUID `json:"leaseId" validate:"required"`
+	PlaybackUrl            *string   `json:"playbackUrl,omitempty" validate:"omitempty,url,lte=2048"`
+	ThumbnailUrl           *string   `json:"thumbnailUrl,omitempty" validate:"omitempty,url,lte=2048"`
+	ThumbnailCandidateUrls []string  `json:"thumbnailCandidateUrls,omitempty" validate:"omitempty,max=10,dive,url,lte=2048"`
+	PreviewTrackUrl        *string   `json:"previewTrackUrl,omitempty" validate:"omitempty,url,lte=2048"`
+	Duration               *int64    `json:"duration,omitempty" validate:"omitempty,gte=0"`
+}
+
+// FailTranscodeJobRequestDTO reports a failed attempt, a job that is not retryable
+// (e.g. its raw file is missing) is failed right away instead of waiting for another attempt
+type FailTranscodeJobRequestDTO struct {
+	LeaseId      uuid.UUID `json:"leaseId" validate:"required"`
+	ErrorMessage string    `json:"errorMessage" validate:"required"`
+	Retryable    bool      `json:"retryable"`
+}
+
+type FailTranscodeJobResponseDTO struct {
+	Status string `json:"status"`
+}
+
+// VODTranscodeStatusResponseDTO is the processing state of a vod shown to its owner,
+// the job fields are left empty when no transcode job was queued for the vod
+type VODTranscodeStatusResponseDTO struct {
+	VodId         uuid.UUID  `json:"vodId"`
+	Status        string     `json:"status"`
+	JobStatus     *string    `json:"jobStatus,omitempty"`
+	Attempts      int        `json:"attempts"`
+	MaxAttempts   int        `json:"maxAttempts"`
+	Progress      float64    `json:"progress"`
+	EtaSeconds    *int       `json:"etaSeconds,omitempty"`
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"` // set while a failed attempt waits to be retried
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type VODChapterDTO struct {
+	StartSeconds float64 `json:"startSeconds" validate:"gte=0"`
+	Title        string  `json:"title" validate:"required,lte=100"`
+}
+
+// UpdateVODChaptersRequestDTO replaces the chapters of a vod, sorted by their start, an empty list removes them
+type UpdateVODChaptersRequestDTO struct {
+	Chapters []VODChapterDTO `json:"chapters" validate:"max=100,dive"`
+}
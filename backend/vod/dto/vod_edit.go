@@ -0,0 +1,18 @@
+package dto
+
+// VODRangeDTO is a range of a vod in seconds from its start
+type VODRangeDTO struct {
+	StartSeconds float64 `json:"startSeconds" validate:"gte=0"`
+	EndSeconds   float64 `json:"endSeconds" validate:"gtfield=StartSeconds"`
+}
+
+// TrimVODRequestDTO lists the ranges of the vod to keep, sorted and not overlapping,
+// the ranges are joined in one playlist with a discontinuity between them
+type TrimVODRequestDTO struct {
+	Ranges []VODRangeDTO `json:"ranges" validate:"required,min=1,max=20,dive"`
+}
+
+// SplitVODRequestDTO lists the offsets to split the vod at, sorted, the vod keeps the part before the first one
+type SplitVODRequestDTO struct {
+	AtSeconds []float64 `json:"atSeconds" validate:"required,min=1,max=10,dive,gt=0"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+// VODThumbnailsResponseDTO lists the thumbnail of a vod with the frames its owner can pick instead
+type VODThumbnailsResponseDTO struct {
+	ThumbnailUrl *string  `json:"thumbnailUrl"`
+	Candidates   []string `json:"candidates"`
+}
+
+// SelectVODThumbnailRequestDTO picks one of the candidates listed by VODThumbnailsResponseDTO
+type SelectVODThumbnailRequestDTO struct {
+	CandidateIndex *int `json:"candidateIndex" validate:"required,gte=0"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type InitiateVODUploadRequestDTO struct {
+	Filename    string `json:"filename" validate:"required,lte=255"`
+	FileSize    int64  `json:"fileSize" validate:"required,gt=0"`
+	Title       string `json:"title" validate:"omitempty,lte=100"`
+	Description string `json:"description" validate:"omitempty,lte=500"`
+	Visibility  string `json:"visibility" validate:"omitempty,oneof=public private"`
+}
+
+// VODUploadResponseDTO is what a client needs to (re)start sending parts,
+// Offset is the number of bytes received without a gap from the start of the file
+type VODUploadResponseDTO struct {
+	Id            uuid.UUID `json:"id"`
+	VodId         uuid.UUID `json:"vodId"`
+	Filename      string    `json:"filename"`
+	Status        string    `json:"status"`
+	TotalSize     int64     `json:"totalSize"`
+	PartSize      int64     `json:"partSize"`
+	PartCount     int       `json:"partCount"`
+	UploadedParts []int     `json:"uploadedParts"`
+	Offset        int64     `json:"offset"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sen1or/letslive/shared/pkg/discovery"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/gateway"
+	livestreamgateway "sen1or/letslive/vod/gateway/livestream"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type livestreamHTTPGateway struct {
+	registry discovery.Registry
+}
+
+func NewLivestreamGateway(registry discovery.Registry) livestreamgateway.LivestreamGateway {
+	return &livestreamHTTPGateway{
+		registry: registry,
+	}
+}
+
+type livestreamServiceResponse struct {
+	Success bool                          `json:"success"`
+	Data    *livestreamgateway.Livestream `json:"data,omitempty"`
+}
+
+func (g *livestreamHTTPGateway) GetLivestream(ctx context.Context, livestreamId uuid.UUID) (*livestreamgateway.Livestream, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "livestream")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get livestream service address: %v", err)
+		return nil, fmt.Errorf("livestream service unavailable")
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/livestreams/%s", addr, livestreamId.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request")
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call livestream service: %v", err)
+		return nil, fmt.Errorf("failed to call livestream service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("livestream service returned status %d", resp.StatusCode)
+	}
+
+	var result livestreamServiceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		logger.Errorf(ctx, "failed to decode livestream service response: %v", err)
+		return nil, fmt.Errorf("failed to decode livestream service response")
+	}
+
+	return result.Data, nil
+}
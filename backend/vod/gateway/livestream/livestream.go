@@ -0,0 +1,27 @@
+package livestream
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type Livestream struct {
+	Id         uuid.UUID  `json:"id"`
+	UserId     uuid.UUID  `json:"userId"`
+	Title      string     `json:"title"`
+	Visibility string     `json:"visibility"`
+	StartedAt  time.Time  `json:"startedAt"`
+	EndedAt    *time.Time `json:"endedAt"`
+}
+
+// IsLive is true until the livestream is ended
+func (l Livestream) IsLive() bool {
+	return l.EndedAt == nil
+}
+
+type LivestreamGateway interface {
+	// GetLivestream returns nil when the livestream does not exist
+	GetLivestream(ctx context.Context, livestreamId uuid.UUID) (*Livestream, error)
+}
@@ -65,3 +65,91 @@ func (g *userHTTPGateway) GetUserPublicInfo(ctx context.Context, userId uuid.UUI
 
 	return result.Data, nil
 }
+
+type viewerAffinityResponse struct {
+	Success bool                        `json:"success"`
+	Data    *usergateway.ViewerAffinity `json:"data,omitempty"`
+}
+
+func (g *userHTTPGateway) GetViewerAffinity(ctx context.Context, userId uuid.UUID) (*usergateway.ViewerAffinity, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get user service address: %v", err)
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/users/%s/affinity", addr, userId.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request")
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call user service: %v", err)
+		return nil, fmt.Errorf("failed to call user service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("user service returned status %d", resp.StatusCode)
+	}
+
+	var result viewerAffinityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || result.Data == nil {
+		logger.Errorf(ctx, "failed to decode user service response: %v", err)
+		return nil, fmt.Errorf("failed to decode user service response")
+	}
+
+	return result.Data, nil
+}
+
+type isBlockedResponse struct {
+	Success bool `json:"success"`
+	Data    *struct {
+		Blocked bool `json:"blocked"`
+	} `json:"data,omitempty"`
+}
+
+func (g *userHTTPGateway) IsBlocked(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error) {
+	addr, err := g.registry.ServiceAddress(ctx, "user")
+	if err != nil {
+		logger.Errorf(ctx, "failed to get user service address: %v", err)
+		return false, fmt.Errorf("user service unavailable")
+	}
+
+	url := fmt.Sprintf("http://%s/v1/internal/users/%s/blocks/%s", addr, blockerId.String(), blockedId.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		logger.Errorf(ctx, "failed to create request: %v", err)
+		return false, fmt.Errorf("failed to create request")
+	}
+
+	if err := gateway.SetRequestIDHeader(ctx, req); err != nil {
+		logger.Warnf(ctx, "failed to set request id header: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Errorf(ctx, "failed to call user service: %v", err)
+		return false, fmt.Errorf("failed to call user service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return false, fmt.Errorf("user service returned status %d", resp.StatusCode)
+	}
+
+	var result isBlockedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || result.Data == nil {
+		logger.Errorf(ctx, "failed to decode user service response: %v", err)
+		return false, fmt.Errorf("failed to decode user service response")
+	}
+
+	return result.Data.Blocked, nil
+}
@@ -12,6 +12,15 @@ type UserPublicInfo struct {
 	ProfilePicture *string   `json:"profilePicture,omitempty"`
 }
 
+// ViewerAffinity is what the user service knows about the taste of a viewer
+type ViewerAffinity struct {
+	FollowedUserIds []uuid.UUID        `json:"followedUserIds"`
+	Categories      map[string]float64 `json:"categories"`
+}
+
 type UserGateway interface {
 	GetUserPublicInfo(ctx context.Context, userId uuid.UUID) (*UserPublicInfo, error)
+	GetViewerAffinity(ctx context.Context, userId uuid.UUID) (*ViewerAffinity, error)
+	// IsBlocked is true when the blocker blocked the other user
+	IsBlocked(ctx context.Context, blockerId, blockedId uuid.UUID) (bool, error)
 }
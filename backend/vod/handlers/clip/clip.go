@@ -0,0 +1,17 @@
+package clip
+
+import (
+	"sen1or/letslive/vod/handlers/basehandler"
+	clipservice "sen1or/letslive/vod/services/clip"
+)
+
+type ClipHandler struct {
+	basehandler.BaseHandler
+	clipService *clipservice.ClipService
+}
+
+func NewClipHandler(clipService *clipservice.ClipService) *ClipHandler {
+	return &ClipHandler{
+		clipService: clipService,
+	}
+}
@@ -0,0 +1,41 @@
+package clip
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/dto"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *ClipHandler) CompleteClipJobInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	clipId, err := uuid.FromString(r.PathValue("clipId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+	defer r.Body.Close()
+
+	var requestBody dto.CompleteClipJobRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "complete_clip_job_internal_handler.clip_service.complete_job")
+	serviceErr := h.clipService.CompleteJob(ctx, clipId, requestBody)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_SUCC_OK, nil, nil, nil))
+}
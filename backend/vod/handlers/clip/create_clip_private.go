@@ -0,0 +1,40 @@
+package clip
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/handlers/utils"
+	response "sen1or/letslive/vod/response"
+)
+
+func (h *ClipHandler) CreateClipPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	userId, err := utils.GetUserIdFromCookie(r)
+	if err != nil {
+		h.WriteResponse(w, ctx, err)
+		return
+	}
+	defer r.Body.Close()
+
+	var requestBody dto.CreateClipRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "create_clip_private_handler.clip_service.create")
+	clip, serviceErr := h.clipService.Create(ctx, requestBody, *userId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, clip, nil, nil))
+}
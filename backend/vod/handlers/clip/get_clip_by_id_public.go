@@ -0,0 +1,32 @@
+package clip
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *ClipHandler) GetClipByIdPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	clipId, err := uuid.FromString(r.PathValue("clipId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_clip_by_id_public_handler.clip_service.get_by_id")
+	clip, serviceErr := h.clipService.GetById(ctx, clipId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, clip, nil, nil))
+}
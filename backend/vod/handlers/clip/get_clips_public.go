@@ -0,0 +1,66 @@
+package clip
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/handlers/utils"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetClipsPublicHandler lists the ready clips of a channel (channelId) or of a source (vodId or livestreamId),
+// exactly one of the queries must be given
+func (h *ClipHandler) GetClipsPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	channelId, channelErr := optionalUUIDQuery(r, "channelId")
+	vodId, vodErr := optionalUUIDQuery(r, "vodId")
+	livestreamId, livestreamErr := optionalUUIDQuery(r, "livestreamId")
+	given := 0
+	for _, id := range []*uuid.UUID{channelId, vodId, livestreamId} {
+		if id != nil {
+			given++
+		}
+	}
+	if channelErr != nil || vodErr != nil || livestreamErr != nil || given != 1 {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	page, limit := utils.GetPageAndLimitQuery(r)
+
+	var clips []domains.Clip
+	var serviceErr *response.Response[any]
+	if channelId != nil {
+		ctx, span := tracer.MyTracer.Start(ctx, "get_clips_public_handler.clip_service.get_by_channel")
+		clips, serviceErr = h.clipService.GetByChannel(ctx, *channelId, page, limit)
+		span.End()
+	} else {
+		ctx, span := tracer.MyTracer.Start(ctx, "get_clips_public_handler.clip_service.get_by_source")
+		clips, serviceErr = h.clipService.GetBySource(ctx, domains.ClipSource{VodId: vodId, LivestreamId: livestreamId}, page, limit)
+		span.End()
+	}
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &clips, nil, nil))
+}
+
+// optionalUUIDQuery returns nil when the query is not given
+func optionalUUIDQuery(r *http.Request, key string) (*uuid.UUID, error) {
+	if !r.URL.Query().Has(key) {
+		return nil, nil
+	}
+	id, err := uuid.FromString(r.URL.Query().Get(key))
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
@@ -0,0 +1,30 @@
+package clip
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	response "sen1or/letslive/vod/response"
+)
+
+// LeaseClipJobInternalHandler responds with no content when there is no clip to cut
+func (h *ClipHandler) LeaseClipJobInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	ctx, span := tracer.MyTracer.Start(ctx, "lease_clip_job_internal_handler.clip_service.lease_job")
+	job, serviceErr := h.clipService.LeaseJob(ctx)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	if job == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, job, nil, nil))
+}
@@ -0,0 +1,47 @@
+package clip
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/dto"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *ClipHandler) RegisterViewPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	clipId, err := uuid.FromString(r.PathValue("clipId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	var body dto.RegisterViewRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(body); err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseWithValidationErrors[any](nil, nil, err))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "register_view_public_handler.clip_service.register_view")
+	serviceErr := h.clipService.RegisterView(ctx, clipId, body.WatchedSeconds)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_SUCC_OK, nil, nil, nil))
+}
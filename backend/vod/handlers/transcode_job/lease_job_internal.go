@@ -0,0 +1,30 @@
+package transcodejob
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	response "sen1or/letslive/vod/response"
+)
+
+// LeaseJobInternalHandler responds with no content when there is no pending job
+func (h *TranscodeJobHandler) LeaseJobInternalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	ctx, span := tracer.MyTracer.Start(ctx, "lease_job_internal_handler.transcode_job_service.lease")
+	job, serviceErr := h.transcodeJobService.Lease(ctx)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	if job == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, job, nil, nil))
+}
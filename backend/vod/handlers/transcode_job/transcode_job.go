@@ -0,0 +1,17 @@
+package transcodejob
+
+import (
+	"sen1or/letslive/vod/handlers/basehandler"
+	transcodejobservice "sen1or/letslive/vod/services/transcode_job"
+)
+
+type TranscodeJobHandler struct {
+	basehandler.BaseHandler
+	transcodeJobService *transcodejobservice.TranscodeJobService
+}
+
+func NewTranscodeJobHandler(transcodeJobService *transcodejobservice.TranscodeJobService) *TranscodeJobHandler {
+	return &TranscodeJobHandler{
+		transcodeJobService: transcodeJobService,
+	}
+}
@@ -0,0 +1,39 @@
+package vod
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/handlers/utils"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (h *VODHandler) CompleteUploadPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	userId, err := utils.GetUserIdFromCookie(r)
+	if err != nil {
+		h.WriteResponse(w, ctx, err)
+		return
+	}
+
+	uploadId, er := uuid.FromString(r.PathValue("uploadId"))
+	if er != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "complete_upload_private_handler.vod_service.complete_upload")
+	vod, serviceErr := h.vodService.CompleteUpload(ctx, uploadId, *userId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, vod, nil, nil))
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/shared/pkg/tags"
 	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
 	response "sen1or/letslive/vod/response"
 	"time"
 
@@ -13,13 +15,20 @@ import (
 )
 
 type CreateVODInternalRequest struct {
-	LivestreamId string `json:"livestreamId"`
-	UserId       string `json:"userId"`
-	Title        string `json:"title"`
-	Description  string `json:"description,omitempty"`
-	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
-	PlaybackURL  string `json:"playbackUrl,omitempty"`
-	Duration     int64  `json:"duration"`
+	LivestreamId string   `json:"livestreamId"`
+	UserId       string   `json:"userId"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description,omitempty"`
+	ThumbnailURL string   `json:"thumbnailUrl,omitempty"`
+	PlaybackURL  string   `json:"playbackUrl,omitempty"`
+	Duration     int64    `json:"duration"`
+	Category     string   `json:"category,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	// viewers of the livestream, the average is over its whole length
+	PeakViewerCount    int `json:"peakViewerCount"`
+	AverageViewerCount int `json:"averageViewerCount"`
+	// e.g. the titles the livestream went through, fitted to the vod instead of being validated
+	Chapters []dto.VODChapterDTO `json:"chapters,omitempty"`
 }
 
 func (h *VODHandler) CreateVODInternalHandler(w http.ResponseWriter, r *http.Request) {
@@ -61,6 +70,21 @@ func (h *VODHandler) CreateVODInternalHandler(w http.ResponseWriter, r *http.Req
 		playURL = nil
 	}
 
+	var category *string
+	if tags.ValidSlug(reqBody.Category) {
+		category = &reqBody.Category
+	}
+	// the livestream already normalized them, tags that do not fit anymore are dropped instead of failing the vod
+	vodTags, ok := tags.Normalize(reqBody.Tags)
+	if !ok {
+		vodTags = []string{}
+	}
+
+	chapters := make([]domains.VODChapter, len(reqBody.Chapters))
+	for i, chapter := range reqBody.Chapters {
+		chapters[i] = domains.VODChapter{StartSeconds: chapter.StartSeconds, Title: chapter.Title}
+	}
+
 	vodData := domains.VOD{
 		LivestreamId: &livestreamId,
 		UserId:       userId,
@@ -69,13 +93,19 @@ func (h *VODHandler) CreateVODInternalHandler(w http.ResponseWriter, r *http.Req
 		ThumbnailURL: thumbURL,
 		PlaybackURL:  playURL,
 		Visibility:   domains.VODPublicVisibility,
+		Category:     category,
+		Tags:         vodTags,
 		Status:       domains.VODStatusReady,
 		ViewCount:    0,
 		Duration:     reqBody.Duration,
+		Chapters:     chapters,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
 
+	vodData.PeakViewerCount = &reqBody.PeakViewerCount
+	vodData.AverageViewerCount = &reqBody.AverageViewerCount
+
 	ctx, span := tracer.MyTracer.Start(ctx, "create_vod_internal_handler.vod_service.create")
 	createdVOD, serviceErr := h.vodService.Create(ctx, vodData)
 	span.End()
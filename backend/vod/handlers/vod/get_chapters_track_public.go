@@ -0,0 +1,38 @@
+package vod
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetChaptersTrackPublicHandler serves the chapters of the vod as a WebVTT track, to be used as the src of a
+// <track kind="chapters"> element. Errors are still answered in JSON
+func (h *VODHandler) GetChaptersTrackPublicHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	vodId, err := uuid.FromString(r.PathValue("vodId"))
+	if err != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	ctx, span := tracer.MyTracer.Start(ctx, "get_chapters_track_public_handler.vod_service.get_chapters_track")
+	track, serviceErr := h.vodService.GetChaptersTrack(ctx, vodId)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, track)
+}
@@ -3,20 +3,51 @@ package vod
 import (
 	"context"
 	"net/http"
-	"sen1or/letslive/vod/handlers/utils"
+	"sen1or/letslive/shared/pkg/ranking"
+	"sen1or/letslive/shared/pkg/tags"
 	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/handlers/utils"
 	response "sen1or/letslive/vod/response"
 )
 
-// TODO: recommendation system
 func (h *VODHandler) GetRecommendedVODsPublicHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancelCtx := context.WithCancel(r.Context())
 	defer cancelCtx()
 
-	page, limit := utils.GetPageAndLimitQuery(r)
+	_, limit := utils.GetPageAndLimitQuery(r)
+
+	var cursor *ranking.Cursor
+	if encoded := r.URL.Query().Get("cursor"); len(encoded) > 0 {
+		decoded, err := ranking.DecodeCursor(encoded)
+		if err != nil {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		cursor = decoded
+	}
+
+	var filter domains.VODFilter
+	if category := r.URL.Query().Get("category"); len(category) > 0 {
+		if !tags.ValidSlug(category) {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		filter.Category = &category
+	}
+	if tag := r.URL.Query().Get("tag"); len(tag) > 0 {
+		normalized, ok := tags.Normalize([]string{tag})
+		if !ok {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+			return
+		}
+		filter.Tag = &normalized[0]
+	}
+
+	viewerId, _ := utils.GetUserIdFromCookie(r)
 
 	ctx, span := tracer.MyTracer.Start(ctx, "get_recommended_vods_public_handler.vod_service.get_recommended_vods")
-	vods, serviceErr := h.vodService.GetRecommendedVODs(ctx, page, limit)
+	vods, next, serviceErr := h.vodService.GetRecommendedVODs(ctx, filter, viewerId, cursor, limit)
 	span.End()
 
 	if serviceErr != nil {
@@ -24,5 +55,10 @@ func (h *VODHandler) GetRecommendedVODsPublicHandler(w http.ResponseWriter, r *h
 		return
 	}
 
-	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &vods, nil, nil))
+	var meta *response.Meta
+	if next != nil {
+		meta = &response.Meta{NextCursor: next.Encode()}
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, &vods, meta, nil))
 }
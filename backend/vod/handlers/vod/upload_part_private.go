@@ -0,0 +1,55 @@
+package vod
+
+import (
+	"context"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/handlers/utils"
+	response "sen1or/letslive/vod/response"
+	"strconv"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// UploadPartPrivateHandler takes the raw bytes of one part as the request body,
+// the Content-Length must be the exact size of the part
+func (h *VODHandler) UploadPartPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	userId, err := utils.GetUserIdFromCookie(r)
+	if err != nil {
+		h.WriteResponse(w, ctx, err)
+		return
+	}
+
+	uploadId, er := uuid.FromString(r.PathValue("uploadId"))
+	if er != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	partNumber, er := strconv.Atoi(r.PathValue("partNumber"))
+	if er != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	if r.ContentLength <= 0 {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_UPLOAD_INVALID_PART, nil, nil, nil))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, r.ContentLength)
+	defer r.Body.Close()
+
+	ctx, span := tracer.MyTracer.Start(ctx, "upload_part_private_handler.vod_service.upload_part")
+	upload, serviceErr := h.vodService.UploadPart(ctx, uploadId, *userId, partNumber, r.ContentLength, r.Body)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, upload, nil, nil))
+}
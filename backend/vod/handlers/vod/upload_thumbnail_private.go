@@ -0,0 +1,62 @@
+package vod
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sen1or/letslive/shared/pkg/tracer"
+	"sen1or/letslive/vod/handlers/utils"
+	response "sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// the whole multipart request, the size of the image itself is checked by the service
+const maxThumbnailRequestSize = 16 << 20 // 16MB
+
+func (h *VODHandler) UploadThumbnailPrivateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelCtx := context.WithCancel(r.Context())
+	defer cancelCtx()
+
+	userId, err := utils.GetUserIdFromCookie(r)
+	if err != nil {
+		h.WriteResponse(w, ctx, err)
+		return
+	}
+
+	vodId, er := uuid.FromString(r.PathValue("vodId"))
+	if er != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxThumbnailRequestSize)
+
+	if parseErr := r.ParseMultipartForm(maxThumbnailRequestSize); parseErr != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(parseErr, &maxBytesErr) {
+			h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_THUMBNAIL_TOO_LARGE, nil, nil, nil))
+			return
+		}
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+
+	file, _, fileErr := r.FormFile("image")
+	if fileErr != nil {
+		h.WriteResponse(w, ctx, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil))
+		return
+	}
+	defer file.Close()
+
+	ctx, span := tracer.MyTracer.Start(ctx, "upload_thumbnail_private_handler.vod_service.upload_thumbnail")
+	updatedVOD, serviceErr := h.vodService.UploadThumbnail(ctx, vodId, *userId, file)
+	span.End()
+
+	if serviceErr != nil {
+		h.WriteResponse(w, ctx, serviceErr)
+		return
+	}
+
+	h.WriteResponse(w, ctx, response.NewResponseFromTemplate(response.RES_SUCC_OK, updatedVOD, nil, nil))
+}
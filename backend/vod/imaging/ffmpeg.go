@@ -0,0 +1,70 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sen1or/letslive/vod/domains"
+	"strings"
+	"time"
+)
+
+// how long converting one image may take, images are small so this is only hit by hostile files
+const resizeTimeout = 30 * time.Second
+
+// FFMpegResizer converts uploaded images with the ffmpeg binary, it reads every format ffmpeg knows (jpeg, png, webp, ...)
+type FFMpegResizer struct {
+	path string
+}
+
+func NewFFMpegResizer(path string) *FFMpegResizer {
+	return &FFMpegResizer{
+		path: path,
+	}
+}
+
+func (r *FFMpegResizer) ResizeToJPEG(ctx context.Context, image []byte, maxWidth int, maxHeight int) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, resizeTimeout)
+	defer cancel()
+
+	tempDir, err := os.MkdirTemp("", "vod-thumbnail-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	inputPath := filepath.Join(tempDir, "input")
+	outputPath := filepath.Join(tempDir, "output.jpg")
+	if err := os.WriteFile(inputPath, image, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write the image: %v", err)
+	}
+
+	output, err := exec.CommandContext(ctx, r.path,
+		"-hide_banner",
+		"-loglevel", "error",
+		"-y",
+		"-i", inputPath,
+		"-frames:v", "1",
+		// only scales down, smaller images keep their size
+		"-vf", fmt.Sprintf("scale='min(%d,iw)':'min(%d,ih)':force_original_aspect_ratio=decrease", maxWidth, maxHeight),
+		"-pix_fmt", "yuvj420p",
+		"-q:v", "3",
+		outputPath,
+	).CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("ffmpeg did not finish: %w", ctx.Err())
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("%w: %s", domains.ErrUnreadableImage, strings.TrimSpace(string(output)))
+		}
+		return nil, fmt.Errorf("failed to run ffmpeg: %v", err)
+	}
+
+	return os.ReadFile(outputPath)
+}
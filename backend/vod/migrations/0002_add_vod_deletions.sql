@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a vod being deleted keeps its row with status 'deleting' until its media is removed from storage,
+-- the cleanup is retried with backoff until it succeeds
+CREATE TABLE IF NOT EXISTS vod_deletions (
+    vod_id UUID PRIMARY KEY REFERENCES vods(id) ON DELETE CASCADE,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_vod_deletions_next_attempt_at ON vod_deletions(next_attempt_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE vods SET status = 'failed' WHERE status = 'deleting';
+UPDATE transcode_jobs SET status = 'failed' WHERE status = 'cancelled';
+
+DROP INDEX IF EXISTS idx_vod_deletions_next_attempt_at;
+DROP TABLE IF EXISTS vod_deletions;
+
+-- +goose StatementEnd
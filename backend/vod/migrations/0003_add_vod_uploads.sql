@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a resumable upload of a vod's raw file, the parts are uploaded to a multipart upload in storage
+-- and the vod stays in 'uploading' until the upload is completed or expires
+CREATE TABLE IF NOT EXISTS vod_uploads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vod_id UUID NOT NULL UNIQUE REFERENCES vods(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    object_name VARCHAR(1024) NOT NULL,
+    multipart_upload_id VARCHAR(1024) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    total_size BIGINT NOT NULL CHECK (total_size > 0),
+    part_size BIGINT NOT NULL CHECK (part_size > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'uploading',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_vod_uploads_expires_at ON vod_uploads(expires_at);
+
+CREATE TABLE IF NOT EXISTS vod_upload_parts (
+    upload_id UUID NOT NULL REFERENCES vod_uploads(id) ON DELETE CASCADE,
+    part_number INT NOT NULL CHECK (part_number > 0),
+    etag VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (upload_id, part_number)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS vod_upload_parts;
+DROP INDEX IF EXISTS idx_vod_uploads_expires_at;
+DROP TABLE IF EXISTS vod_uploads;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a processing job is owned by the worker holding lease_id until lease_expires_at, the worker extends the lease
+-- with heartbeats and an expired lease is returned to the queue (or failed at max_attempts) by the reaper,
+-- a retried job waits until next_attempt_at
+ALTER TABLE transcode_jobs
+    ADD COLUMN IF NOT EXISTS lease_id UUID,
+    ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- jobs left processing by a crashed worker before leases existed are reaped right away
+UPDATE transcode_jobs SET lease_expires_at = now() WHERE status = 'processing';
+
+CREATE INDEX IF NOT EXISTS idx_transcode_jobs_pending ON transcode_jobs(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_transcode_jobs_lease_expires_at ON transcode_jobs(lease_expires_at) WHERE status = 'processing';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_transcode_jobs_lease_expires_at;
+DROP INDEX IF EXISTS idx_transcode_jobs_pending;
+ALTER TABLE transcode_jobs
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS lease_expires_at,
+    DROP COLUMN IF EXISTS lease_id;
+
+-- +goose StatementEnd
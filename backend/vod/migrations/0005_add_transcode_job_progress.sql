@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- progress of the running attempt reported by the worker, in percent of the probed input duration,
+-- with the estimated seconds left
+ALTER TABLE transcode_jobs
+    ADD COLUMN IF NOT EXISTS progress REAL NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS eta_seconds INT,
+    ADD COLUMN IF NOT EXISTS progress_updated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_transcode_jobs_vod_id ON transcode_jobs(vod_id, created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_transcode_jobs_vod_id;
+ALTER TABLE transcode_jobs
+    DROP COLUMN IF EXISTS progress_updated_at,
+    DROP COLUMN IF EXISTS eta_seconds,
+    DROP COLUMN IF EXISTS progress;
+
+-- +goose StatementEnd
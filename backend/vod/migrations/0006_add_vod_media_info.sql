@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- metadata probed from the uploaded file before its transcode is queued, null for vods recorded from livestreams
+ALTER TABLE vods
+    ADD COLUMN IF NOT EXISTS width INT,
+    ADD COLUMN IF NOT EXISTS height INT,
+    ADD COLUMN IF NOT EXISTS frame_rate REAL,
+    ADD COLUMN IF NOT EXISTS video_codec VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS audio_codec VARCHAR(32);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE vods
+    DROP COLUMN IF EXISTS audio_codec,
+    DROP COLUMN IF EXISTS video_codec,
+    DROP COLUMN IF EXISTS frame_rate,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width;
+
+-- +goose StatementEnd
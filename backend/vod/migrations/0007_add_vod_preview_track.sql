@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- WebVTT track mapping time ranges to the sprite sheets of the seek bar previews
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS preview_track_url VARCHAR(2048);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE vods DROP COLUMN IF EXISTS preview_track_url;
+
+-- +goose StatementEnd
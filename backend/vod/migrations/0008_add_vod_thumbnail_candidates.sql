@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- frames taken by the transcode at 10/30/50/70/90% of the vod, the owner can pick one as the thumbnail
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS thumbnail_candidates TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE vods DROP COLUMN IF EXISTS thumbnail_candidates;
+
+-- +goose StatementEnd
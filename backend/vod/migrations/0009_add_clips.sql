@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a clip is a short range cut out of a vod or out of the dvr window of a livestream while it is live,
+-- its media is copied under clips/{id} so it outlives its source, the row doubles as the job of the
+-- transcode worker cutting it (leased the same way as the transcode jobs, an expired lease is taken again)
+CREATE TABLE IF NOT EXISTS clips (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    channel_id UUID NOT NULL,
+    source_vod_id UUID REFERENCES vods(id) ON DELETE SET NULL,
+    source_livestream_id UUID,
+    title VARCHAR(100) NOT NULL,
+    start_seconds DOUBLE PRECISION NOT NULL CHECK (start_seconds >= 0),
+    end_seconds DOUBLE PRECISION NOT NULL,
+    mode VARCHAR(20) NOT NULL DEFAULT 'copy',
+    source_playback_url VARCHAR(2048) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    playback_url VARCHAR(2048),
+    thumbnail_url VARCHAR(2048),
+    duration BIGINT NOT NULL DEFAULT 0,
+    view_count BIGINT NOT NULL DEFAULT 0,
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL DEFAULT 3,
+    error_message TEXT,
+    lease_id UUID,
+    lease_expires_at TIMESTAMPTZ,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_clip_range CHECK (end_seconds > start_seconds)
+);
+
+CREATE INDEX IF NOT EXISTS idx_clips_channel_id ON clips(channel_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_clips_source_vod_id ON clips(source_vod_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_clips_source_livestream_id ON clips(source_livestream_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_clips_pending ON clips(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_clips_lease_expires_at ON clips(lease_expires_at) WHERE status = 'processing';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_clips_lease_expires_at;
+DROP INDEX IF EXISTS idx_clips_pending;
+DROP INDEX IF EXISTS idx_clips_source_livestream_id;
+DROP INDEX IF EXISTS idx_clips_source_vod_id;
+DROP INDEX IF EXISTS idx_clips_channel_id;
+DROP TABLE IF EXISTS clips;
+
+-- +goose StatementEnd
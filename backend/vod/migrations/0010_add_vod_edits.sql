@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- folder of the vod bucket holding the segments the playlists of the vod reference, shared by the vods
+-- split from one another. Null for a vod that was never split from another one, its folder is then the one
+-- it was transcoded or recorded into: the livestream id for a livestream vod, its own id otherwise
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS media_prefix TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_vods_media_prefix ON vods ((coalesce(media_prefix, coalesce(livestream_id, id)::text)));
+
+-- segments no playlist references anymore after a trim or a split, collected once no viewer can still be
+-- playing the previous playlists. A new edit of the same folder pushes the collection back
+CREATE TABLE IF NOT EXISTS vod_media_collections (
+    media_prefix TEXT PRIMARY KEY,
+    bucket TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT,
+    scheduled_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_vod_media_collections_next_attempt_at ON vod_media_collections(next_attempt_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_vod_media_collections_next_attempt_at;
+DROP TABLE IF EXISTS vod_media_collections;
+DROP INDEX IF EXISTS idx_vods_media_prefix;
+ALTER TABLE vods DROP COLUMN IF EXISTS media_prefix;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- chapters of a vod, ordered by their start. A chapter runs until the start of the next one or the end of the vod
+CREATE TABLE IF NOT EXISTS vod_chapters (
+    vod_id UUID NOT NULL REFERENCES vods(id) ON DELETE CASCADE,
+    start_seconds DOUBLE PRECISION NOT NULL CHECK (start_seconds >= 0),
+    title VARCHAR(100) NOT NULL,
+    PRIMARY KEY (vod_id, start_seconds)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS vod_chapters;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- copied from the livestream a vod was recorded from, the slug refers to a category of the livestream service
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS category VARCHAR(64);
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_vods_category ON vods(category);
+CREATE INDEX IF NOT EXISTS idx_vods_tags ON vods USING GIN (tags);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_vods_tags;
+DROP INDEX IF EXISTS idx_vods_category;
+ALTER TABLE vods DROP COLUMN IF EXISTS tags;
+ALTER TABLE vods DROP COLUMN IF EXISTS category;
+
+-- +goose StatementEnd
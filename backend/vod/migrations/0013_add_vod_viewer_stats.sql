@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- viewers of the livestream a vod was recorded from, null for the uploaded vods
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS peak_viewer_count INTEGER;
+ALTER TABLE vods ADD COLUMN IF NOT EXISTS average_viewer_count INTEGER;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE vods DROP COLUMN IF EXISTS average_viewer_count;
+ALTER TABLE vods DROP COLUMN IF EXISTS peak_viewer_count;
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- one index per text search configuration of shared/pkg/search, the expression is the one the search queries use
+CREATE INDEX IF NOT EXISTS idx_vods_search_english ON vods USING GIN (to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')));
+CREATE INDEX IF NOT EXISTS idx_vods_search_simple ON vods USING GIN (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(description, '')));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_vods_search_simple;
+DROP INDEX IF EXISTS idx_vods_search_english;
+
+-- +goose StatementEnd
@@ -0,0 +1,145 @@
+package prober
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sen1or/letslive/vod/domains"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FFProbe reads uploaded files with the ffprobe binary, the files are read over http so they are not downloaded first
+type FFProbe struct {
+	path    string
+	timeout time.Duration
+}
+
+func NewFFProbe(path string, timeout time.Duration) *FFProbe {
+	return &FFProbe{
+		path:    path,
+		timeout: timeout,
+	}
+}
+
+type ffprobeOutput struct {
+	Streams []struct {
+		CodecType    string `json:"codec_type"`
+		CodecName    string `json:"codec_name"`
+		Width        int    `json:"width"`
+		Height       int    `json:"height"`
+		AvgFrameRate string `json:"avg_frame_rate"`
+		Duration     string `json:"duration"`
+		Disposition  struct {
+			AttachedPic int `json:"attached_pic"`
+		} `json:"disposition"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func (p *FFProbe) Probe(ctx context.Context, url string) (*domains.VODMediaInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	output, err := p.run(ctx, "-print_format", "json", "-show_format", "-show_streams", url)
+	if err != nil {
+		return nil, err
+	}
+
+	var probed ffprobeOutput
+	if err := json.Unmarshal(output, &probed); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %v", err)
+	}
+
+	info := &domains.VODMediaInfo{}
+	streamDuration := ""
+	for _, stream := range probed.Streams {
+		switch stream.CodecType {
+		case "video":
+			// cover art of audio files is reported as a video stream
+			if info.VideoCodec != "" || stream.Disposition.AttachedPic == 1 || stream.Width <= 0 || stream.Height <= 0 {
+				continue
+			}
+			info.VideoCodec = stream.CodecName
+			info.Width = stream.Width
+			info.Height = stream.Height
+			info.FrameRate = parseFrameRate(stream.AvgFrameRate)
+			streamDuration = stream.Duration
+		case "audio":
+			if info.AudioCodec == "" {
+				info.AudioCodec = stream.CodecName
+			}
+		}
+	}
+	if info.VideoCodec == "" {
+		return nil, domains.ErrUnreadableMedia
+	}
+
+	// some containers, e.g. webm recorded by browsers, only carry the duration on the stream or not at all
+	info.Duration = parseSeconds(probed.Format.Duration)
+	if info.Duration == 0 {
+		info.Duration = parseSeconds(streamDuration)
+	}
+
+	// the headers can be fine while the stream itself is garbage, decode the first frame to be sure
+	frame, err := p.run(ctx, "-select_streams", "v:0", "-read_intervals", "%+#1", "-show_entries", "frame=width", "-of", "csv=p=0", url)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(frame)) == 0 {
+		return nil, domains.ErrUnreadableMedia
+	}
+
+	return info, nil
+}
+
+// run returns the stdout of ffprobe, a file ffprobe rejects is reported as ErrUnreadableMedia
+func (p *FFProbe) run(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.path, append([]string{"-v", "error"}, args...)...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("ffprobe did not finish: %w", ctx.Err())
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("%w: %s", domains.ErrUnreadableMedia, strings.TrimSpace(stderr.String()))
+		}
+		return nil, fmt.Errorf("failed to run ffprobe: %v", err)
+	}
+
+	return output, nil
+}
+
+// parseFrameRate parses the "num/den" rate of ffprobe, 0 when it is unknown
+func parseFrameRate(rate string) float64 {
+	num, den, ok := strings.Cut(rate, "/")
+	if !ok {
+		return 0
+	}
+
+	n, errN := strconv.ParseFloat(num, 64)
+	d, errD := strconv.ParseFloat(den, 64)
+	if errN != nil || errD != nil || d == 0 {
+		return 0
+	}
+	return n / d
+}
+
+func parseSeconds(value string) time.Duration {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
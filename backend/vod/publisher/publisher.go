@@ -0,0 +1,60 @@
+package publisher
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/eventbus"
+	"sen1or/letslive/shared/pkg/eventbus/events"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const eventSource = "vod"
+
+// EventPublisher publishes the events of the vod service, a failed publish is only logged
+// since every consumer has another way to catch up (e.g. the transcode workers still poll)
+type EventPublisher struct {
+	producer eventbus.Producer
+}
+
+// NewEventPublisher returns a publisher dropping every event when producer is nil,
+// which is the case when no event bus is configured
+func NewEventPublisher(producer eventbus.Producer) *EventPublisher {
+	return &EventPublisher{
+		producer: producer,
+	}
+}
+
+var (
+	_ domains.TranscodeJobNotifier = (*EventPublisher)(nil)
+	_ domains.ClipJobNotifier      = (*EventPublisher)(nil)
+)
+
+func (p *EventPublisher) NotifyQueued(ctx context.Context, vodId uuid.UUID) {
+	p.publish(ctx, events.TopicVOD, vodId.String(), events.VODTranscodeJobQueued, events.VODTranscodeJobQueuedEvent{
+		VODId: vodId,
+	})
+}
+
+func (p *EventPublisher) NotifyClipQueued(ctx context.Context, clipId uuid.UUID) {
+	p.publish(ctx, events.TopicVOD, clipId.String(), events.VODClipJobQueued, events.VODClipJobQueuedEvent{
+		ClipId: clipId,
+	})
+}
+
+func (p *EventPublisher) publish(ctx context.Context, topic string, key string, eventType string, data any) {
+	if p.producer == nil {
+		return
+	}
+
+	event, err := eventbus.NewEvent(eventType, eventSource, data)
+	if err != nil {
+		logger.Errorf(ctx, "failed to build event %s: %v", eventType, err)
+		return
+	}
+
+	if err := p.producer.Publish(ctx, topic, key, event); err != nil {
+		logger.Warnf(ctx, "failed to publish event %s: %v", eventType, err)
+	}
+}
@@ -0,0 +1,21 @@
+package clip
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// clipColumns are the columns of domains.Clip
+const clipColumns = `id, user_id, channel_id, source_vod_id, source_livestream_id, title, start_seconds, end_seconds, mode, source_playback_url,
+	status, playback_url, thumbnail_url, duration, view_count, attempts, max_attempts, error_message, created_at, updated_at`
+
+type postgresClipRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewClipRepository(conn *pgxpool.Pool) domains.ClipRepository {
+	return &postgresClipRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,38 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresClipRepo) Complete(ctx context.Context, clipId uuid.UUID, leaseId uuid.UUID, playbackUrl string, thumbnailUrl *string, duration int64) *response.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		update clips
+		set status = 'ready', playback_url = $3, thumbnail_url = $4, duration = $5, error_message = null,
+			lease_id = null, lease_expires_at = null, updated_at = now()
+		where id = $1 and lease_id = $2 and status = 'processing'
+	`, clipId, leaseId, playbackUrl, thumbnailUrl, duration)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [completeclip id=%s: %v]", clipId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_CLIP_NOT_PROCESSING,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
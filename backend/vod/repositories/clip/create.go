@@ -0,0 +1,40 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) Create(ctx context.Context, clip domains.Clip) (*domains.Clip, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		insert into clips (user_id, channel_id, source_vod_id, source_livestream_id, title, start_seconds, end_seconds, mode, source_playback_url, max_attempts)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		returning `+clipColumns,
+		clip.UserId, clip.ChannelId, clip.SourceVodId, clip.SourceLivestreamId, clip.Title, clip.StartSeconds, clip.EndSeconds, clip.Mode, clip.SourcePlaybackURL, clip.MaxAttempts)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	createdClip, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.Clip])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [createclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &createdClip, nil
+}
@@ -0,0 +1,43 @@
+package clip
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) Fail(ctx context.Context, clipId uuid.UUID, leaseId uuid.UUID, errorMsg string, retryable bool, backoff domains.RetryBackoff) (domains.ClipStatus, *response.Response[any]) {
+	var status domains.ClipStatus
+	err := r.dbConn.QueryRow(ctx, `
+		update clips
+		set status = case when $4 and attempts < max_attempts then 'pending' else 'failed' end,
+			error_message = $3, updated_at = now(), lease_id = null, lease_expires_at = null,
+			next_attempt_at = now() + make_interval(secs => least($5 * power(2, greatest(attempts - 1, 0)), $6))
+		where id = $1 and lease_id = $2 and status = 'processing'
+		returning status
+	`, clipId, leaseId, errorMsg, retryable, backoff.Base.Seconds(), backoff.Max.Seconds()).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", response.NewResponseFromTemplate[any](
+				response.RES_ERR_CLIP_NOT_PROCESSING,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db query error [failclip id=%s: %v]", clipId, err)
+		return "", response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return status, nil
+}
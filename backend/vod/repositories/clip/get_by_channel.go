@@ -0,0 +1,43 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) GetByChannel(ctx context.Context, channelId uuid.UUID, page int, limit int) ([]domains.Clip, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select `+clipColumns+`
+		from clips
+		where channel_id = $1 and status = 'ready'
+		order by created_at desc
+		offset $2 limit $3
+	`, channelId, page*limit, limit)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getclipsbychannel: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	clips, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.Clip])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getclipsbychannel: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return clips, nil
+}
@@ -0,0 +1,50 @@
+package clip
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) GetById(ctx context.Context, clipId uuid.UUID) (*domains.Clip, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select `+clipColumns+`
+		from clips
+		where id = $1
+	`, clipId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getclipbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	clip, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.Clip])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_CLIP_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getclipbyid: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &clip, nil
+}
@@ -0,0 +1,42 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) GetBySource(ctx context.Context, source domains.ClipSource, page int, limit int) ([]domains.Clip, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select `+clipColumns+`
+		from clips
+		where (source_vod_id = $1 or source_livestream_id = $2) and status = 'ready'
+		order by created_at desc
+		offset $3 limit $4
+	`, source.VodId, source.LivestreamId, page*limit, limit)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getclipsbysource: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	clips, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.Clip])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getclipsbysource: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return clips, nil
+}
@@ -0,0 +1,37 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresClipRepo) IncrementViewCount(ctx context.Context, clipId uuid.UUID) *response.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		update clips
+		set view_count = view_count + 1
+		where id = $1 and status = 'ready'
+	`, clipId)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [incrementclipviewcount id=%s: %v]", clipId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_CLIP_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
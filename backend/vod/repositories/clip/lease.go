@@ -0,0 +1,96 @@
+package clip
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresClipRepo) Lease(ctx context.Context, lease time.Duration) (*domains.LeasedClipJob, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [leaseclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	// clips are short so there is no heartbeat, a lease outliving its worker is reclaimed here instead of by a reaper
+	if _, err := tx.Exec(ctx, `
+		update clips
+		set status = 'failed', error_message = 'lease expired on the last attempt', updated_at = now(),
+			lease_id = null, lease_expires_at = null
+		where status = 'processing' and lease_expires_at <= now() and attempts >= max_attempts
+	`); err != nil {
+		logger.Errorf(ctx, "db exec error [leaseclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	rows, err := tx.Query(ctx, `
+		with next as (
+			select id
+			from clips
+			where attempts < max_attempts
+				and ((status = 'pending' and next_attempt_at <= now()) or (status = 'processing' and lease_expires_at <= now()))
+			order by next_attempt_at
+			limit 1
+			for update skip locked
+		)
+		update clips c
+		set status = 'processing', attempts = c.attempts + 1, updated_at = now(),
+			lease_id = gen_random_uuid(), lease_expires_at = now() + make_interval(secs => $1)
+		from next
+		where c.id = next.id
+		returning c.id, c.mode, c.source_playback_url, c.source_livestream_id is not null as is_live,
+			c.start_seconds, c.end_seconds, c.attempts, c.max_attempts, c.lease_id, c.lease_expires_at
+	`, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [leaseclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	job, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.LeasedClipJob])
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Errorf(ctx, "db scan error [leaseclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [leaseclip: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return &job, nil
+}
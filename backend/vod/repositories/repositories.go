@@ -2,10 +2,15 @@ package repositories
 
 import (
 	"sen1or/letslive/vod/domains"
+	cliprepo "sen1or/letslive/vod/repositories/clip"
 	transcodejobrepo "sen1or/letslive/vod/repositories/transcode_job"
 	vodrepo "sen1or/letslive/vod/repositories/vod"
+	vodchapterrepo "sen1or/letslive/vod/repositories/vod_chapter"
 	vodcommentrepo "sen1or/letslive/vod/repositories/vod_comment"
 	vodcommentlikerepo "sen1or/letslive/vod/repositories/vod_comment_like"
+	voddeletionrepo "sen1or/letslive/vod/repositories/vod_deletion"
+	vodeditrepo "sen1or/letslive/vod/repositories/vod_edit"
+	voduploadrepo "sen1or/letslive/vod/repositories/vod_upload"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -25,3 +30,23 @@ func NewVODCommentLikeRepository(conn *pgxpool.Pool) domains.VODCommentLikeRepos
 func NewTranscodeJobRepository(conn *pgxpool.Pool) domains.TranscodeJobRepository {
 	return transcodejobrepo.NewTranscodeJobRepository(conn)
 }
+
+func NewVODDeletionRepository(conn *pgxpool.Pool) domains.VODDeletionRepository {
+	return voddeletionrepo.NewVODDeletionRepository(conn)
+}
+
+func NewVODUploadRepository(conn *pgxpool.Pool) domains.VODUploadRepository {
+	return voduploadrepo.NewVODUploadRepository(conn)
+}
+
+func NewClipRepository(conn *pgxpool.Pool) domains.ClipRepository {
+	return cliprepo.NewClipRepository(conn)
+}
+
+func NewVODEditRepository(conn *pgxpool.Pool) domains.VODEditRepository {
+	return vodeditrepo.NewVODEditRepository(conn)
+}
+
+func NewVODChapterRepository(conn *pgxpool.Pool) domains.VODChapterRepository {
+	return vodchapterrepo.NewVODChapterRepository(conn)
+}
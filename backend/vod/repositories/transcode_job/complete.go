@@ -0,0 +1,79 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) Complete(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, playbackUrl *string, thumbnailUrl *string, thumbnailCandidates []string, previewTrackUrl *string, duration *int64) *response.Response[any] {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [completetranscodejob id=%s: %v]", jobId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	var vodId uuid.UUID
+	err = tx.QueryRow(ctx, `
+		update transcode_jobs
+		set status = 'completed', completed_at = now(), updated_at = now(), lease_id = null, lease_expires_at = null,
+			progress = 100, eta_seconds = 0, progress_updated_at = now()
+		where id = $1 and lease_id = $2 and status = 'processing'
+		returning vod_id
+	`, jobId, leaseId).Scan(&vodId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return response.NewResponseFromTemplate[any](
+				response.RES_ERR_TRANSCODE_JOB_NOT_PROCESSING,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db query error [completetranscodejob id=%s: %v]", jobId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		update vods
+		set status = 'ready', playback_url = coalesce($2, playback_url), thumbnail_url = coalesce(thumbnail_url, $3),
+			thumbnail_candidates = coalesce($4, thumbnail_candidates), preview_track_url = coalesce($5, preview_track_url),
+			duration = coalesce($6, duration), updated_at = now()
+		where id = $1 and status <> 'deleting'
+	`, vodId, playbackUrl, thumbnailUrl, thumbnailCandidates, previewTrackUrl, duration); err != nil {
+		logger.Errorf(ctx, "db exec error [completetranscodejob vod_id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [completetranscodejob id=%s: %v]", jobId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
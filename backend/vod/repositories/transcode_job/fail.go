@@ -0,0 +1,82 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) Fail(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, errorMsg string, retryable bool, backoff domains.RetryBackoff) (domains.TranscodeJobStatus, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [failtranscodejob id=%s: %v]", jobId, err)
+		return "", response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	var vodId uuid.UUID
+	var status domains.TranscodeJobStatus
+	err = tx.QueryRow(ctx, `
+		update transcode_jobs
+		set status = case when $4 and attempts < max_attempts then 'pending' else 'failed' end,
+			error_message = $3, updated_at = now(), lease_id = null, lease_expires_at = null,
+			next_attempt_at = now() + make_interval(secs => least($5 * power(2, greatest(attempts - 1, 0)), $6))
+		where id = $1 and lease_id = $2 and status = 'processing'
+		returning vod_id, status
+	`, jobId, leaseId, errorMsg, retryable, backoff.Base.Seconds(), backoff.Max.Seconds()).Scan(&vodId, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", response.NewResponseFromTemplate[any](
+				response.RES_ERR_TRANSCODE_JOB_NOT_PROCESSING,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db query error [failtranscodejob id=%s: %v]", jobId, err)
+		return "", response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if status == domains.TranscodeJobFailed {
+		if _, err := tx.Exec(ctx, `
+			update vods
+			set status = 'failed', updated_at = now()
+			where id = $1 and status <> 'deleting'
+		`, vodId); err != nil {
+			logger.Errorf(ctx, "db exec error [failtranscodejob vod_id=%s: %v]", vodId, err)
+			return "", response.NewResponseFromTemplate[any](
+				response.RES_ERR_DATABASE_QUERY,
+				nil,
+				nil,
+				nil,
+			)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [failtranscodejob id=%s: %v]", jobId, err)
+		return "", response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return status, nil
+}
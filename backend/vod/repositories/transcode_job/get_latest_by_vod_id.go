@@ -0,0 +1,48 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) GetLatestByVodId(ctx context.Context, vodId uuid.UUID) (*domains.TranscodeJob, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select id, vod_id, status, attempts, max_attempts, error_message, created_at, updated_at, started_at, completed_at,
+			next_attempt_at, progress, eta_seconds, progress_updated_at
+		from transcode_jobs
+		where vod_id = $1
+		order by created_at desc
+		limit 1
+	`, vodId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getlatesttranscodejob vod_id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	job, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.TranscodeJob])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [getlatesttranscodejob vod_id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,41 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) Heartbeat(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, lease time.Duration) (*time.Time, *response.Response[any]) {
+	var leaseExpiresAt time.Time
+	err := r.dbConn.QueryRow(ctx, `
+		update transcode_jobs
+		set lease_expires_at = now() + make_interval(secs => $3), updated_at = now()
+		where id = $1 and lease_id = $2 and status = 'processing'
+		returning lease_expires_at
+	`, jobId, leaseId, lease.Seconds()).Scan(&leaseExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_TRANSCODE_JOB_NOT_PROCESSING,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db query error [heartbeattranscodejob id=%s: %v]", jobId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &leaseExpiresAt, nil
+}
@@ -0,0 +1,57 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) Lease(ctx context.Context, lease time.Duration) (*domains.LeasedTranscodeJob, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		with next as (
+			select id
+			from transcode_jobs
+			where status = 'pending' and attempts < max_attempts and next_attempt_at <= now()
+			order by next_attempt_at
+			limit 1
+			for update skip locked
+		)
+		update transcode_jobs tj
+		set status = 'processing', started_at = now(), attempts = tj.attempts + 1, updated_at = now(),
+			lease_id = gen_random_uuid(), lease_expires_at = now() + make_interval(secs => $1),
+			progress = 0, eta_seconds = null, progress_updated_at = null
+		from next, vods v
+		where tj.id = next.id and v.id = tj.vod_id
+		returning tj.id, tj.vod_id, tj.attempts, tj.max_attempts, v.original_file_url, tj.lease_id, tj.lease_expires_at
+	`, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [leasetranscodejob: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	job, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.LeasedTranscodeJob])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Errorf(ctx, "db scan error [leasetranscodejob: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,100 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) ReapExpired(ctx context.Context, limit int, backoff domains.RetryBackoff) ([]domains.TranscodeJob, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [reapexpiredtranscodejobs: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		with expired as (
+			select id
+			from transcode_jobs
+			where status = 'processing' and lease_expires_at < now()
+			order by lease_expires_at
+			limit $1
+			for update skip locked
+		)
+		update transcode_jobs tj
+		set status = case when tj.attempts < tj.max_attempts then 'pending' else 'failed' end,
+			error_message = 'the lease expired without a heartbeat from the worker', updated_at = now(),
+			lease_id = null, lease_expires_at = null,
+			next_attempt_at = now() + make_interval(secs => least($2 * power(2, greatest(tj.attempts - 1, 0)), $3))
+		from expired
+		where tj.id = expired.id
+		returning tj.id, tj.vod_id, tj.status, tj.attempts, tj.max_attempts, tj.error_message,
+			tj.created_at, tj.updated_at, tj.started_at, tj.completed_at, tj.next_attempt_at
+	`, limit, backoff.Base.Seconds(), backoff.Max.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [reapexpiredtranscodejobs: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	jobs, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.TranscodeJob])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [reapexpiredtranscodejobs: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	failedVodIds := []uuid.UUID{}
+	for _, job := range jobs {
+		if job.Status == domains.TranscodeJobFailed {
+			failedVodIds = append(failedVodIds, job.VodId)
+		}
+	}
+
+	if len(failedVodIds) > 0 {
+		if _, err := tx.Exec(ctx, `
+			update vods
+			set status = 'failed', updated_at = now()
+			where id = any($1) and status <> 'deleting'
+		`, failedVodIds); err != nil {
+			logger.Errorf(ctx, "db exec error [reapexpiredtranscodejobs: %v]", err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_DATABASE_QUERY,
+				nil,
+				nil,
+				nil,
+			)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [reapexpiredtranscodejobs: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return jobs, nil
+}
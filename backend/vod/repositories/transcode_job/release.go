@@ -0,0 +1,41 @@
+package transcodejob
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresTranscodeJobRepo) Release(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID) (*uuid.UUID, *response.Response[any]) {
+	var vodId uuid.UUID
+	err := r.dbConn.QueryRow(ctx, `
+		update transcode_jobs
+		set status = 'pending', attempts = greatest(attempts - 1, 0), updated_at = now(),
+			lease_id = null, lease_expires_at = null, next_attempt_at = now()
+		where id = $1 and lease_id = $2 and status = 'processing'
+		returning vod_id
+	`, jobId, leaseId).Scan(&vodId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_TRANSCODE_JOB_NOT_PROCESSING,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db query error [releasetranscodejob id=%s: %v]", jobId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &vodId, nil
+}
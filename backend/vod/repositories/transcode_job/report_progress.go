@@ -0,0 +1,36 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresTranscodeJobRepo) ReportProgress(ctx context.Context, jobId uuid.UUID, leaseId uuid.UUID, progress float64, etaSeconds *int) *response.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		update transcode_jobs
+		set progress = $3, eta_seconds = $4, progress_updated_at = now()
+		where id = $1 and lease_id = $2 and status = 'processing'
+	`, jobId, leaseId, progress, etaSeconds)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [reporttranscodejobprogress id=%s: %v]", jobId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_TRANSCODE_JOB_NOT_PROCESSING,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
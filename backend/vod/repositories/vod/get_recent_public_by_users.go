@@ -0,0 +1,49 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODRepo) GetRecentPublicByUsers(ctx context.Context, userIds []uuid.UUID, before *domains.VODPosition, limit int) ([]domains.VOD, *response.Response[any]) {
+	var beforeCreatedAt, beforeId any
+	if before != nil {
+		beforeCreatedAt, beforeId = before.CreatedAt, before.Id
+	}
+
+	query := `
+        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
+        from vods
+        where user_id = any($1) and visibility = 'public' and status = 'ready'
+            and ($2::timestamptz is null or (created_at, id) < ($2, $3::uuid))
+        order by created_at desc, id desc
+        limit $4
+    `
+	rows, err := r.dbConn.Query(ctx, query, userIds, beforeCreatedAt, beforeId, limit)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getrecentpublicbyusers: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vods, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getrecentpublicbyusers: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return vods, nil
+}
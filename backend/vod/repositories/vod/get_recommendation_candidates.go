@@ -0,0 +1,47 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODRepo) GetRecommendationCandidates(ctx context.Context, filter domains.VODFilter, recentLimit int, popularLimit int) ([]domains.VOD, *response.Response[any]) {
+	query := `
+        with candidates as (
+            select id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
+            from vods
+            where visibility = 'public' and status = 'ready'
+                and ($3::text is null or category = $3)
+                and ($4::text is null or tags @> array[$4::text])
+        )
+        (select * from candidates order by created_at desc limit $1)
+        union
+        (select * from candidates order by view_count desc limit $2)
+    `
+	rows, err := r.dbConn.Query(ctx, query, recentLimit, popularLimit, filter.Category, filter.Tag)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getrecommendationcandidates: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vods, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getrecommendationcandidates: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return vods, nil
+}
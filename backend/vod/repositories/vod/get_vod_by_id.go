@@ -13,9 +13,9 @@ import (
 
 func (r postgresVODRepo) GetById(ctx context.Context, id uuid.UUID) (*domains.VOD, *response.Response[any]) {
 	query := `
-        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, view_count, duration, playback_url, status, original_file_url, created_at, updated_at
+        select id, livestream_id, user_id, title, description, thumbnail_url, thumbnail_candidates, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, media_prefix, created_at, updated_at
         from vods
-        where id = $1
+        where id = $1 and status <> 'deleting'
     `
 	rows, err := r.dbConn.Query(ctx, query, id)
 	if err != nil {
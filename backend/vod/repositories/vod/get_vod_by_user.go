@@ -13,9 +13,9 @@ import (
 func (r *postgresVODRepo) GetByUser(ctx context.Context, userId uuid.UUID, page int, limit int) ([]domains.VOD, *response.Response[any]) {
 	offset := limit * page
 	query := `
-        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, view_count, duration, playback_url, status, original_file_url, created_at, updated_at
+        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
         from vods
-        where user_id = $1
+        where user_id = $1 and status <> 'deleting'
         order by created_at desc
         offset $2 limit $3
     `
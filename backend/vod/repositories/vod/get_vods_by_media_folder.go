@@ -0,0 +1,41 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODRepo) GetByMediaFolder(ctx context.Context, mediaFolder string) ([]domains.VOD, *response.Response[any]) {
+	// the folder expression matches the one of idx_vods_media_prefix and domains.VOD.MediaFolder
+	query := `
+        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, media_prefix, created_at, updated_at
+        from vods
+        where coalesce(media_prefix, coalesce(livestream_id, id)::text) = $1 and status <> 'deleting'
+    `
+	rows, err := r.dbConn.Query(ctx, query, mediaFolder)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getvodsbymediafolder: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vods, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getvodsbymediafolder: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return vods, nil
+}
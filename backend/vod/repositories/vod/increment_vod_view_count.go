@@ -12,7 +12,7 @@ func (r *postgresVODRepo) IncrementViewCount(ctx context.Context, id uuid.UUID)
 	query := `
         update vods
         set view_count = view_count + 1
-        where id = $1
+        where id = $1 and status <> 'deleting'
     `
 	result, err := r.dbConn.Exec(ctx, query, id)
 	if err != nil {
@@ -0,0 +1,47 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/search"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// searchableText must stay the expression the search indexes of migration 0014 are built on
+const searchableText = `coalesce(title, '') || ' ' || coalesce(description, '')`
+
+func (r *postgresVODRepo) Search(ctx context.Context, query string, language search.Language, page int, limit int) ([]domains.VOD, *response.Response[any]) {
+	document, tsquery := language.Document(searchableText), language.Query("$1")
+	sql := `
+        select id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
+        from vods
+        where visibility = 'public' and status = 'ready' and ` + document + ` @@ ` + tsquery + `
+        order by ts_rank_cd(` + document + `, ` + tsquery + `) desc, view_count desc, id
+        offset $2 limit $3
+    `
+	rows, err := r.dbConn.Query(ctx, sql, query, page*limit, limit)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [searchvods: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vods, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [searchvods: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return vods, nil
+}
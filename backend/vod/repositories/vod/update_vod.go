@@ -14,8 +14,8 @@ func (r *postgresVODRepo) Update(ctx context.Context, vod domains.VOD) (*domains
 	query := `
         update vods
         set title = $1, description = $2, thumbnail_url = $3, visibility = $4, duration = $5, playback_url = $6, status = $7, updated_at = now()
-        where id = $8
-        returning id, livestream_id, user_id, title, description, thumbnail_url, visibility, view_count, duration, playback_url, status, original_file_url, created_at, updated_at
+        where id = $8 and status <> 'deleting'
+        returning id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
     `
 	rows, err := r.dbConn.Query(ctx, query,
 		vod.Title, vod.Description, vod.ThumbnailURL, vod.Visibility,
@@ -13,7 +13,7 @@ func (r *postgresVODRepo) UpdateStatus(ctx context.Context, vodId uuid.UUID, sta
 	query := `
         update vods
         set status = $1, playback_url = COALESCE($2, playback_url), thumbnail_url = COALESCE($3, thumbnail_url), updated_at = now()
-        where id = $4
+        where id = $4 and status <> 'deleting'
     `
 	result, err := r.dbConn.Exec(ctx, query, status, playbackUrl, thumbnailUrl, vodId)
 	if err != nil {
@@ -0,0 +1,51 @@
+package vod
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODRepo) UpdateThumbnail(ctx context.Context, vodId uuid.UUID, thumbnailUrl string) (*domains.VOD, *response.Response[any]) {
+	query := `
+        update vods
+        set thumbnail_url = $1, updated_at = now()
+        where id = $2 and status <> 'deleting'
+        returning id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, created_at, updated_at
+    `
+	rows, err := r.dbConn.Query(ctx, query, thumbnailUrl, vodId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [updatevodthumbnail id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPDATE_FAILED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	updatedVod, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [updatevodthumbnail id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return &updatedVod, nil
+}
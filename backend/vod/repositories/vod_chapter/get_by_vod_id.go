@@ -0,0 +1,42 @@
+package vodchapter
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODChapterRepo) GetByVodId(ctx context.Context, vodId uuid.UUID) ([]domains.VODChapter, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select vod_id, start_seconds, title
+		from vod_chapters
+		where vod_id = $1
+		order by start_seconds
+	`, vodId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getvodchapters id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	chapters, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.VODChapter])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getvodchapters id=%s: %v]", vodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return chapters, nil
+}
@@ -0,0 +1,69 @@
+package vodchapter
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODChapterRepo) Replace(ctx context.Context, vodId uuid.UUID, chapters []domains.VODChapter) *response.Response[any] {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [replacevodchapters id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ReplaceInTx(ctx, tx, vodId, chapters); err != nil {
+		logger.Errorf(ctx, "db exec error [replacevodchapters id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [replacevodchapters id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// ReplaceInTx sets the chapters of the vod within tx, for the repositories changing the timeline of a vod
+func ReplaceInTx(ctx context.Context, tx pgx.Tx, vodId uuid.UUID, chapters []domains.VODChapter) error {
+	if _, err := tx.Exec(ctx, `delete from vod_chapters where vod_id = $1`, vodId); err != nil {
+		return err
+	}
+	if len(chapters) == 0 {
+		return nil
+	}
+
+	starts := make([]float64, len(chapters))
+	titles := make([]string, len(chapters))
+	for i, chapter := range chapters {
+		starts[i], titles[i] = chapter.StartSeconds, chapter.Title
+	}
+	_, err := tx.Exec(ctx, `
+		insert into vod_chapters (vod_id, start_seconds, title)
+		select $1, start_seconds, title
+		from unnest($2::double precision[], $3::text[]) as c(start_seconds, title)
+	`, vodId, starts, titles)
+	return err
+}
@@ -0,0 +1,17 @@
+package vodchapter
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresVODChapterRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewVODChapterRepository(conn *pgxpool.Pool) domains.VODChapterRepository {
+	return &postgresVODChapterRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,56 @@
+package voddeletion
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODDeletionRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domains.VODDeletion, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		with due as (
+			select vod_id
+			from vod_deletions
+			where next_attempt_at <= now()
+			order by next_attempt_at
+			limit $1
+			for update skip locked
+		), claimed as (
+			update vod_deletions d
+			set attempts = d.attempts + 1, next_attempt_at = now() + make_interval(secs => $2)
+			from due
+			where d.vod_id = due.vod_id
+			returning d.vod_id, d.attempts, d.next_attempt_at, d.last_error, d.created_at
+		)
+		select c.vod_id, c.attempts, c.next_attempt_at, c.last_error, c.created_at,
+			v.livestream_id, v.playback_url, v.thumbnail_url, v.original_file_url, v.media_prefix
+		from claimed c
+		join vods v on v.id = c.vod_id
+	`, limit, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [claimduevoddeletions: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	deletions, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.VODDeletion])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [claimduevoddeletions: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return deletions, nil
+}
@@ -0,0 +1,99 @@
+package voddeletion
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODDeletionRepo) MarkDeleting(ctx context.Context, vodId uuid.UUID, transcodeGracePeriod time.Duration) *response.Response[any] {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [markvoddeleting id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		update vods
+		set status = 'deleting', updated_at = now()
+		where id = $1 and status <> 'deleting'
+	`, vodId)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [markvoddeleting id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	var processingJobs int
+	err = tx.QueryRow(ctx, `
+		with cancelled as (
+			update transcode_jobs
+			set status = 'cancelled', updated_at = now()
+			where vod_id = $1 and status in ('pending', 'processing')
+			returning started_at
+		)
+		select count(*) from cancelled where started_at is not null
+	`, vodId).Scan(&processingJobs)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [canceltranscodejobs vod_id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	nextAttemptAt := time.Now()
+	if processingJobs > 0 {
+		nextAttemptAt = nextAttemptAt.Add(transcodeGracePeriod)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		insert into vod_deletions (vod_id, next_attempt_at)
+		values ($1, $2)
+		on conflict (vod_id) do nothing
+	`, vodId, nextAttemptAt); err != nil {
+		logger.Errorf(ctx, "db exec error [insertvoddeletion vod_id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [markvoddeleting id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package voddeletion
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresVODDeletionRepo) RecordFailure(ctx context.Context, vodId uuid.UUID, errorMsg string, nextAttemptAt time.Time) *response.Response[any] {
+	_, err := r.dbConn.Exec(ctx, `
+		update vod_deletions
+		set last_error = $1, next_attempt_at = $2
+		where vod_id = $3
+	`, errorMsg, nextAttemptAt, vodId)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [recordvoddeletionfailure vod_id=%s: %v]", vodId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
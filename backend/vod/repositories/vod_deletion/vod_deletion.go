@@ -0,0 +1,17 @@
+package voddeletion
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresVODDeletionRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewVODDeletionRepository(conn *pgxpool.Pool) domains.VODDeletionRepository {
+	return &postgresVODDeletionRepo{
+		dbConn: conn,
+	}
+}
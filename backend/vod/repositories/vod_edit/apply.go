@@ -0,0 +1,142 @@
+package vodedit
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	vodchapter "sen1or/letslive/vod/repositories/vod_chapter"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODEditRepo) Apply(ctx context.Context, edit domains.VODEdit) ([]domains.VOD, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [applyvodedit id=%s: %v]", edit.VodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	// the previews follow the timeline of the transcoded file, they no longer match the edited one
+	rows, err := tx.Query(ctx, `
+		update vods
+		set playback_url = $1, duration = $2, preview_track_url = null, updated_at = now()
+		where id = $3 and playback_url = $4 and status = 'ready'
+		returning `+vodColumns, edit.PlaybackURL, edit.Duration, edit.VodId, edit.PreviousPlaybackURL)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [applyvodedit id=%s: %v]", edit.VodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	vod, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_EDIT_CONFLICT,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [applyvodedit id=%s: %v]", edit.VodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := vodchapter.ReplaceInTx(ctx, tx, vod.Id, edit.Chapters); err != nil {
+		logger.Errorf(ctx, "db exec error [replacevodchapters id=%s: %v]", edit.VodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	vod.Chapters = edit.Chapters
+
+	vods := []domains.VOD{vod}
+	for _, part := range edit.Parts {
+		rows, err := tx.Query(ctx, `
+			insert into vods (user_id, title, description, thumbnail_url, visibility, duration, playback_url, status, width, height, frame_rate, video_codec, audio_codec, media_prefix, category, tags, peak_viewer_count, average_viewer_count)
+			values ($1, $2, $3, $4, $5, $6, $7, 'ready', $8, $9, $10, $11, $12, $13, $14, coalesce($15::text[], '{}'), $16, $17)
+			returning `+vodColumns,
+			part.UserId, part.Title, part.Description, part.ThumbnailURL, part.Visibility, part.Duration, part.PlaybackURL,
+			part.Width, part.Height, part.FrameRate, part.VideoCodec, part.AudioCodec, edit.MediaFolder, part.Category, part.Tags,
+			part.PeakViewerCount, part.AverageViewerCount,
+		)
+		if err != nil {
+			logger.Errorf(ctx, "db query error [createvodpart id=%s: %v]", edit.VodId, err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_CREATE_FAILED,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		createdPart, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.VOD])
+		if err != nil {
+			logger.Errorf(ctx, "db scan error [createvodpart id=%s: %v]", edit.VodId, err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_DATABASE_ISSUE,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		if err := vodchapter.ReplaceInTx(ctx, tx, createdPart.Id, part.Chapters); err != nil {
+			logger.Errorf(ctx, "db exec error [replacevodchapters id=%s: %v]", createdPart.Id, err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_DATABASE_QUERY,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		createdPart.Chapters = part.Chapters
+		vods = append(vods, createdPart)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		insert into vod_media_collections (media_prefix, bucket, next_attempt_at)
+		values ($1, $2, $3)
+		on conflict (media_prefix) do update
+		set bucket = excluded.bucket,
+			next_attempt_at = greatest(vod_media_collections.next_attempt_at, excluded.next_attempt_at),
+			attempts = 0, last_error = null, scheduled_at = now()
+	`, edit.MediaFolder, edit.Bucket, edit.CollectAt); err != nil {
+		logger.Errorf(ctx, "db exec error [schedulevodmediacollection prefix=%s: %v]", edit.MediaFolder, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [applyvodedit id=%s: %v]", edit.VodId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return vods, nil
+}
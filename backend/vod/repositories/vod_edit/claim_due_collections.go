@@ -0,0 +1,51 @@
+package vodedit
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODEditRepo) ClaimDueCollections(ctx context.Context, limit int, lease time.Duration) ([]domains.VODMediaCollection, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		with due as (
+			select media_prefix
+			from vod_media_collections
+			where next_attempt_at <= now()
+			order by next_attempt_at
+			limit $1
+			for update skip locked
+		)
+		update vod_media_collections c
+		set attempts = c.attempts + 1, next_attempt_at = now() + make_interval(secs => $2)
+		from due
+		where c.media_prefix = due.media_prefix
+		returning c.media_prefix, c.bucket, c.attempts, c.next_attempt_at, c.last_error, c.scheduled_at
+	`, limit, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [claimduevodmediacollections: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	collections, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.VODMediaCollection])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [claimduevodmediacollections: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return collections, nil
+}
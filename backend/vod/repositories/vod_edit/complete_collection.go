@@ -0,0 +1,26 @@
+package vodedit
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+)
+
+func (r *postgresVODEditRepo) CompleteCollection(ctx context.Context, mediaPrefix string, scheduledAt time.Time) *response.Response[any] {
+	_, err := r.dbConn.Exec(ctx, `
+		delete from vod_media_collections
+		where media_prefix = $1 and scheduled_at = $2
+	`, mediaPrefix, scheduledAt)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [completevodmediacollection prefix=%s: %v]", mediaPrefix, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package vodedit
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+)
+
+func (r *postgresVODEditRepo) RecordCollectionFailure(ctx context.Context, mediaPrefix string, errorMsg string, nextAttemptAt time.Time) *response.Response[any] {
+	_, err := r.dbConn.Exec(ctx, `
+		update vod_media_collections
+		set last_error = $1, next_attempt_at = greatest(next_attempt_at, $2)
+		where media_prefix = $3
+	`, errorMsg, nextAttemptAt, mediaPrefix)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [recordvodmediacollectionfailure prefix=%s: %v]", mediaPrefix, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package vodedit
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const vodColumns = `id, livestream_id, user_id, title, description, thumbnail_url, visibility, category, tags, view_count, peak_viewer_count, average_viewer_count, duration, playback_url, preview_track_url, status, original_file_url, width, height, frame_rate, video_codec, audio_codec, media_prefix, created_at, updated_at`
+
+type postgresVODEditRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewVODEditRepository(conn *pgxpool.Pool) domains.VODEditRepository {
+	return &postgresVODEditRepo{
+		dbConn: conn,
+	}
+}
@@ -0,0 +1,52 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODUploadRepo) ClaimExpired(ctx context.Context, limit int, lease time.Duration) ([]domains.VODUpload, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		with due as (
+			select id
+			from vod_uploads
+			where expires_at <= now()
+			order by expires_at
+			limit $1
+			for update skip locked
+		)
+		update vod_uploads u
+		set status = 'expired', expires_at = now() + make_interval(secs => $2), updated_at = now()
+		from due
+		where u.id = due.id
+		returning u.id, u.vod_id, u.user_id, u.filename, u.object_name, u.multipart_upload_id, u.content_type,
+			u.total_size, u.part_size, u.status, u.expires_at, u.created_at, u.updated_at
+	`, limit, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db query error [claimexpiredvoduploads: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	uploads, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.VODUpload])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [claimexpiredvoduploads: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return uploads, nil
+}
@@ -0,0 +1,103 @@
+package vodupload
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODUploadRepo) Complete(ctx context.Context, uploadId uuid.UUID, originalFileURL string, media domains.VODMediaInfo, job domains.TranscodeJob) (*domains.VOD, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [completevodupload id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	var mediaVOD domains.VOD
+	mediaVOD.ApplyMediaInfo(media)
+
+	// the vod may have been deleted while its upload was being assembled
+	rows, err := tx.Query(ctx, `
+		update vods v
+		set status = 'processing', original_file_url = $2, duration = $3, width = $4, height = $5,
+			frame_rate = $6, video_codec = $7, audio_codec = $8, updated_at = now()
+		from vod_uploads u
+		where u.id = $1 and u.status = 'completing' and v.id = u.vod_id and v.status = 'uploading'
+		returning v.id, v.livestream_id, v.user_id, v.title, v.description, v.thumbnail_url, v.visibility, v.category, v.tags, v.view_count, v.peak_viewer_count, v.average_viewer_count, v.duration, v.playback_url, v.preview_track_url, v.status, v.original_file_url, v.width, v.height, v.frame_rate, v.video_codec, v.audio_codec, v.created_at, v.updated_at
+	`, uploadId, originalFileURL, mediaVOD.Duration, mediaVOD.Width, mediaVOD.Height,
+		mediaVOD.FrameRate, mediaVOD.VideoCodec, mediaVOD.AudioCodec)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [completevodupload id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vod, err := pgx.CollectOneRow(rows, pgx.RowToStructByNameLax[domains.VOD])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [completevodupload id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		insert into transcode_jobs (vod_id, status, attempts, max_attempts)
+		values ($1, $2, $3, $4)
+	`, vod.Id, job.Status, job.Attempts, job.MaxAttempts); err != nil {
+		logger.Errorf(ctx, "db exec error [completevodupload create_transcode_job vod_id=%s: %v]", vod.Id, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if _, err := tx.Exec(ctx, `delete from vod_uploads where id = $1`, uploadId); err != nil {
+		logger.Errorf(ctx, "db exec error [completevodupload delete id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [completevodupload id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &vod, nil
+}
@@ -0,0 +1,77 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODUploadRepo) Create(ctx context.Context, vod domains.VOD, upload domains.VODUpload) (*domains.VODUpload, *response.Response[any]) {
+	tx, err := r.dbConn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		logger.Errorf(ctx, "failed to begin transaction [createvodupload: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		insert into vods (id, user_id, title, description, visibility, status)
+		values ($1, $2, $3, $4, $5, $6)
+	`, vod.Id, vod.UserId, vod.Title, vod.Description, vod.Visibility, domains.VODStatusUploading); err != nil {
+		logger.Errorf(ctx, "db exec error [createvodupload vod: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_CREATE_FAILED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	rows, err := tx.Query(ctx, `
+		insert into vod_uploads (vod_id, user_id, filename, object_name, multipart_upload_id, content_type, total_size, part_size, status, expires_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		returning `+uploadColumns,
+		vod.Id, upload.UserId, upload.Filename, upload.ObjectName, upload.MultipartUploadId, upload.ContentType,
+		upload.TotalSize, upload.PartSize, domains.VODUploadUploading, upload.ExpiresAt,
+	)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [createvodupload: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	createdUpload, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.VODUpload])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [createvodupload: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(ctx, "failed to commit transaction [createvodupload: %v]", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &createdUpload, nil
+}
@@ -0,0 +1,46 @@
+package vodupload
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODUploadRepo) GetById(ctx context.Context, uploadId uuid.UUID) (*domains.VODUpload, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `select `+uploadColumns+` from vod_uploads where id = $1`, uploadId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getvoduploadbyid id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	upload, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domains.VODUpload])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_UPLOAD_NOT_FOUND,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		logger.Errorf(ctx, "db scan error [getvoduploadbyid id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return &upload, nil
+}
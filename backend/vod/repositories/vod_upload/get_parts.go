@@ -0,0 +1,42 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *postgresVODUploadRepo) GetParts(ctx context.Context, uploadId uuid.UUID) ([]domains.VODUploadPart, *response.Response[any]) {
+	rows, err := r.dbConn.Query(ctx, `
+		select upload_id, part_number, etag, size, created_at
+		from vod_upload_parts
+		where upload_id = $1
+		order by part_number
+	`, uploadId)
+	if err != nil {
+		logger.Errorf(ctx, "db query error [getvoduploadparts upload_id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	parts, err := pgx.CollectRows(rows, pgx.RowToStructByName[domains.VODUploadPart])
+	if err != nil {
+		logger.Errorf(ctx, "db scan error [getvoduploadparts upload_id=%s: %v]", uploadId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_ISSUE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return parts, nil
+}
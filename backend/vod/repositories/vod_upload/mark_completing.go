@@ -0,0 +1,37 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresVODUploadRepo) MarkCompleting(ctx context.Context, uploadId uuid.UUID, lease time.Duration) *response.Response[any] {
+	result, err := r.dbConn.Exec(ctx, `
+		update vod_uploads
+		set status = 'completing', expires_at = now() + make_interval(secs => $2), updated_at = now()
+		where id = $1 and status = 'uploading' and expires_at > now()
+	`, uploadId, lease.Seconds())
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [markvoduploadcompleting id=%s: %v]", uploadId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_BUSY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *postgresVODUploadRepo) ReleaseCompleting(ctx context.Context, uploadId uuid.UUID, expiresAt time.Time) *response.Response[any] {
+	_, err := r.dbConn.Exec(ctx, `
+		update vod_uploads
+		set status = 'uploading', expires_at = $2, updated_at = now()
+		where id = $1 and status = 'completing'
+	`, uploadId, expiresAt)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [releasevoduploadcompleting id=%s: %v]", uploadId, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package vodupload
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+)
+
+func (r *postgresVODUploadRepo) SavePart(ctx context.Context, part domains.VODUploadPart, expiresAt time.Time) *response.Response[any] {
+	// a re-uploaded part replaces the previous one, storage keeps only the latest upload of a part number
+	result, err := r.dbConn.Exec(ctx, `
+		with upload as (
+			update vod_uploads
+			set expires_at = greatest(expires_at, $4), updated_at = now()
+			where id = $1 and status = 'uploading' and expires_at > now()
+			returning id
+		)
+		insert into vod_upload_parts (upload_id, part_number, etag, size)
+		select id, $2, $3, $5 from upload
+		on conflict (upload_id, part_number) do update
+		set etag = excluded.etag, size = excluded.size, created_at = now()
+	`, part.UploadId, part.PartNumber, part.ETag, expiresAt, part.Size)
+	if err != nil {
+		logger.Errorf(ctx, "db exec error [savevoduploadpart upload_id=%s part=%d: %v]", part.UploadId, part.PartNumber, err)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_DATABASE_QUERY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if result.RowsAffected() == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package vodupload
+
+import (
+	"sen1or/letslive/vod/domains"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uploadColumns = "id, vod_id, user_id, filename, object_name, multipart_upload_id, content_type, total_size, part_size, status, expires_at, created_at, updated_at"
+
+type postgresVODUploadRepo struct {
+	dbConn *pgxpool.Pool
+}
+
+func NewVODUploadRepository(conn *pgxpool.Pool) domains.VODUploadRepository {
+	return &postgresVODUploadRepo{
+		dbConn: conn,
+	}
+}
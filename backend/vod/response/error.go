@@ -14,17 +14,39 @@ const (
 	RES_ERR_DATABASE_ISSUE_CODE  = 20016
 	RES_ERR_INTERNAL_SERVER_CODE = 20017
 
-	RES_ERR_VOD_NOT_FOUND_CODE             = 40002
-	RES_ERR_QUERY_SCAN_FAILED_CODE         = 40004
-	RES_ERR_VOD_CREATE_FAILED_CODE         = 40007
-	RES_ERR_VOD_UPDATE_FAILED_CODE         = 40008
-	RES_ERR_VOD_COMMENT_NOT_FOUND_CODE     = 40009
-	RES_ERR_VOD_COMMENT_CREATE_FAILED_CODE = 40010
-	RES_ERR_VOD_COMMENT_ALREADY_LIKED_CODE = 40011
-	RES_ERR_VOD_COMMENT_NOT_LIKED_CODE     = 40012
-	RES_ERR_VOD_COMMENT_DELETE_FAILED_CODE = 40013
-	RES_ERR_VOD_VIEW_THRESHOLD_CODE        = 40015
-	RES_ERR_VIDEO_TOO_LARGE_CODE           = 40016
+	RES_ERR_VOD_NOT_FOUND_CODE                = 40002
+	RES_ERR_QUERY_SCAN_FAILED_CODE            = 40004
+	RES_ERR_VOD_CREATE_FAILED_CODE            = 40007
+	RES_ERR_VOD_UPDATE_FAILED_CODE            = 40008
+	RES_ERR_VOD_COMMENT_NOT_FOUND_CODE        = 40009
+	RES_ERR_VOD_COMMENT_CREATE_FAILED_CODE    = 40010
+	RES_ERR_VOD_COMMENT_ALREADY_LIKED_CODE    = 40011
+	RES_ERR_VOD_COMMENT_NOT_LIKED_CODE        = 40012
+	RES_ERR_VOD_COMMENT_DELETE_FAILED_CODE    = 40013
+	RES_ERR_VOD_VIEW_THRESHOLD_CODE           = 40015
+	RES_ERR_VIDEO_TOO_LARGE_CODE              = 40016
+	RES_ERR_VOD_UPLOAD_NOT_FOUND_CODE         = 40017
+	RES_ERR_VOD_UPLOAD_EXPIRED_CODE           = 40018
+	RES_ERR_VOD_UPLOAD_INVALID_PART_CODE      = 40019
+	RES_ERR_VOD_UPLOAD_INCOMPLETE_CODE        = 40020
+	RES_ERR_VOD_UPLOAD_BUSY_CODE              = 40021
+	RES_ERR_TRANSCODE_JOB_NOT_PROCESSING_CODE = 40022
+	RES_ERR_VOD_UNREADABLE_MEDIA_CODE         = 40023
+	RES_ERR_VOD_DURATION_TOO_LONG_CODE        = 40024
+	RES_ERR_VOD_RESOLUTION_TOO_HIGH_CODE      = 40025
+	RES_ERR_VOD_UNSUPPORTED_CODEC_CODE        = 40026
+	RES_ERR_VOD_INVALID_THUMBNAIL_CODE        = 40027
+	RES_ERR_VOD_THUMBNAIL_TOO_LARGE_CODE      = 40028
+	RES_ERR_VOD_THUMBNAIL_NOT_FOUND_CODE      = 40029
+	RES_ERR_CLIP_NOT_FOUND_CODE               = 40030
+	RES_ERR_CLIP_INVALID_RANGE_CODE           = 40031
+	RES_ERR_CLIP_SOURCE_UNAVAILABLE_CODE      = 40032
+	RES_ERR_CLIP_NOT_PROCESSING_CODE          = 40033
+	RES_ERR_VOD_INVALID_EDIT_CODE             = 40034
+	RES_ERR_VOD_EDIT_CONFLICT_CODE            = 40035
+	RES_ERR_VOD_NOT_EDITABLE_CODE             = 40036
+	RES_ERR_VOD_INVALID_CHAPTERS_CODE         = 40037
+	RES_ERR_VOD_COMMENT_BLOCKED_CODE          = 40038
 )
 
 // Error keys
@@ -39,16 +61,38 @@ const (
 	RES_ERR_DATABASE_ISSUE_KEY  = "res_err_database_issue"
 	RES_ERR_INTERNAL_SERVER_KEY = "res_err_internal_server"
 
-	RES_ERR_VOD_NOT_FOUND_KEY             = "res_err_vod_not_found"
-	RES_ERR_VOD_CREATE_FAILED_KEY         = "res_err_vod_create_failed"
-	RES_ERR_VOD_UPDATE_FAILED_KEY         = "res_err_vod_update_failed"
-	RES_ERR_VOD_COMMENT_NOT_FOUND_KEY     = "res_err_vod_comment_not_found"
-	RES_ERR_VOD_COMMENT_CREATE_FAILED_KEY = "res_err_vod_comment_create_failed"
-	RES_ERR_VOD_COMMENT_ALREADY_LIKED_KEY = "res_err_vod_comment_already_liked"
-	RES_ERR_VOD_COMMENT_NOT_LIKED_KEY     = "res_err_vod_comment_not_liked"
-	RES_ERR_VOD_COMMENT_DELETE_FAILED_KEY = "res_err_vod_comment_delete_failed"
-	RES_ERR_VOD_VIEW_THRESHOLD_KEY        = "res_err_vod_view_threshold"
-	RES_ERR_VIDEO_TOO_LARGE_KEY           = "err_video_too_large"
+	RES_ERR_VOD_NOT_FOUND_KEY                = "res_err_vod_not_found"
+	RES_ERR_VOD_CREATE_FAILED_KEY            = "res_err_vod_create_failed"
+	RES_ERR_VOD_UPDATE_FAILED_KEY            = "res_err_vod_update_failed"
+	RES_ERR_VOD_COMMENT_NOT_FOUND_KEY        = "res_err_vod_comment_not_found"
+	RES_ERR_VOD_COMMENT_CREATE_FAILED_KEY    = "res_err_vod_comment_create_failed"
+	RES_ERR_VOD_COMMENT_ALREADY_LIKED_KEY    = "res_err_vod_comment_already_liked"
+	RES_ERR_VOD_COMMENT_NOT_LIKED_KEY        = "res_err_vod_comment_not_liked"
+	RES_ERR_VOD_COMMENT_DELETE_FAILED_KEY    = "res_err_vod_comment_delete_failed"
+	RES_ERR_VOD_VIEW_THRESHOLD_KEY           = "res_err_vod_view_threshold"
+	RES_ERR_VIDEO_TOO_LARGE_KEY              = "err_video_too_large"
+	RES_ERR_VOD_UPLOAD_NOT_FOUND_KEY         = "res_err_vod_upload_not_found"
+	RES_ERR_VOD_UPLOAD_EXPIRED_KEY           = "res_err_vod_upload_expired"
+	RES_ERR_VOD_UPLOAD_INVALID_PART_KEY      = "res_err_vod_upload_invalid_part"
+	RES_ERR_VOD_UPLOAD_INCOMPLETE_KEY        = "res_err_vod_upload_incomplete"
+	RES_ERR_VOD_UPLOAD_BUSY_KEY              = "res_err_vod_upload_busy"
+	RES_ERR_TRANSCODE_JOB_NOT_PROCESSING_KEY = "res_err_transcode_job_not_processing"
+	RES_ERR_VOD_UNREADABLE_MEDIA_KEY         = "res_err_vod_unreadable_media"
+	RES_ERR_VOD_DURATION_TOO_LONG_KEY        = "res_err_vod_duration_too_long"
+	RES_ERR_VOD_RESOLUTION_TOO_HIGH_KEY      = "res_err_vod_resolution_too_high"
+	RES_ERR_VOD_UNSUPPORTED_CODEC_KEY        = "res_err_vod_unsupported_codec"
+	RES_ERR_VOD_INVALID_THUMBNAIL_KEY        = "res_err_vod_invalid_thumbnail"
+	RES_ERR_VOD_THUMBNAIL_TOO_LARGE_KEY      = "res_err_vod_thumbnail_too_large"
+	RES_ERR_VOD_THUMBNAIL_NOT_FOUND_KEY      = "res_err_vod_thumbnail_not_found"
+	RES_ERR_CLIP_NOT_FOUND_KEY               = "res_err_clip_not_found"
+	RES_ERR_CLIP_INVALID_RANGE_KEY           = "res_err_clip_invalid_range"
+	RES_ERR_CLIP_SOURCE_UNAVAILABLE_KEY      = "res_err_clip_source_unavailable"
+	RES_ERR_CLIP_NOT_PROCESSING_KEY          = "res_err_clip_not_processing"
+	RES_ERR_VOD_INVALID_EDIT_KEY             = "res_err_vod_invalid_edit"
+	RES_ERR_VOD_EDIT_CONFLICT_KEY            = "res_err_vod_edit_conflict"
+	RES_ERR_VOD_NOT_EDITABLE_KEY             = "res_err_vod_not_editable"
+	RES_ERR_VOD_INVALID_CHAPTERS_KEY         = "res_err_vod_invalid_chapters"
+	RES_ERR_VOD_COMMENT_BLOCKED_KEY          = "res_err_vod_comment_blocked"
 )
 
 // Error templates
@@ -204,4 +248,180 @@ var (
 		Key:        RES_ERR_VIDEO_TOO_LARGE_KEY,
 		Message:    "Video exceeds upload size limit.",
 	}
+
+	RES_ERR_VOD_UPLOAD_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_VOD_UPLOAD_NOT_FOUND_CODE,
+		Key:        RES_ERR_VOD_UPLOAD_NOT_FOUND_KEY,
+		Message:    "Upload not found.",
+	}
+
+	RES_ERR_VOD_UPLOAD_EXPIRED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusGone,
+		Code:       RES_ERR_VOD_UPLOAD_EXPIRED_CODE,
+		Key:        RES_ERR_VOD_UPLOAD_EXPIRED_KEY,
+		Message:    "Upload has expired, please start a new one.",
+	}
+
+	RES_ERR_VOD_UPLOAD_INVALID_PART = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusBadRequest,
+		Code:       RES_ERR_VOD_UPLOAD_INVALID_PART_CODE,
+		Key:        RES_ERR_VOD_UPLOAD_INVALID_PART_KEY,
+		Message:    "Part number or size does not match the upload.",
+	}
+
+	RES_ERR_VOD_UPLOAD_INCOMPLETE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_VOD_UPLOAD_INCOMPLETE_CODE,
+		Key:        RES_ERR_VOD_UPLOAD_INCOMPLETE_KEY,
+		Message:    "Some parts of the upload are missing.",
+	}
+
+	RES_ERR_VOD_UPLOAD_BUSY = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_VOD_UPLOAD_BUSY_CODE,
+		Key:        RES_ERR_VOD_UPLOAD_BUSY_KEY,
+		Message:    "Upload is being completed.",
+	}
+
+	RES_ERR_TRANSCODE_JOB_NOT_PROCESSING = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_TRANSCODE_JOB_NOT_PROCESSING_CODE,
+		Key:        RES_ERR_TRANSCODE_JOB_NOT_PROCESSING_KEY,
+		Message:    "Transcode job is no longer being processed.",
+	}
+
+	RES_ERR_VOD_UNREADABLE_MEDIA = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_UNREADABLE_MEDIA_CODE,
+		Key:        RES_ERR_VOD_UNREADABLE_MEDIA_KEY,
+		Message:    "File is not a playable video.",
+	}
+
+	RES_ERR_VOD_DURATION_TOO_LONG = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_DURATION_TOO_LONG_CODE,
+		Key:        RES_ERR_VOD_DURATION_TOO_LONG_KEY,
+		Message:    "Video is longer than allowed.",
+	}
+
+	RES_ERR_VOD_RESOLUTION_TOO_HIGH = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_RESOLUTION_TOO_HIGH_CODE,
+		Key:        RES_ERR_VOD_RESOLUTION_TOO_HIGH_KEY,
+		Message:    "Video resolution is higher than allowed.",
+	}
+
+	RES_ERR_VOD_UNSUPPORTED_CODEC = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_UNSUPPORTED_CODEC_CODE,
+		Key:        RES_ERR_VOD_UNSUPPORTED_CODEC_KEY,
+		Message:    "Video codec is not supported.",
+	}
+
+	RES_ERR_VOD_INVALID_THUMBNAIL = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_INVALID_THUMBNAIL_CODE,
+		Key:        RES_ERR_VOD_INVALID_THUMBNAIL_KEY,
+		Message:    "Thumbnail must be a JPEG, PNG or WebP image of at least 320x180.",
+	}
+
+	RES_ERR_VOD_THUMBNAIL_TOO_LARGE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       RES_ERR_VOD_THUMBNAIL_TOO_LARGE_CODE,
+		Key:        RES_ERR_VOD_THUMBNAIL_TOO_LARGE_KEY,
+		Message:    "Thumbnail exceeds upload size limit.",
+	}
+
+	RES_ERR_VOD_THUMBNAIL_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_VOD_THUMBNAIL_NOT_FOUND_CODE,
+		Key:        RES_ERR_VOD_THUMBNAIL_NOT_FOUND_KEY,
+		Message:    "Thumbnail candidate not found.",
+	}
+
+	RES_ERR_CLIP_NOT_FOUND = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusNotFound,
+		Code:       RES_ERR_CLIP_NOT_FOUND_CODE,
+		Key:        RES_ERR_CLIP_NOT_FOUND_KEY,
+		Message:    "Clip not found.",
+	}
+
+	RES_ERR_CLIP_INVALID_RANGE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_CLIP_INVALID_RANGE_CODE,
+		Key:        RES_ERR_CLIP_INVALID_RANGE_KEY,
+		Message:    "Clip range is outside of the source or longer than allowed.",
+	}
+
+	RES_ERR_CLIP_SOURCE_UNAVAILABLE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_CLIP_SOURCE_UNAVAILABLE_CODE,
+		Key:        RES_ERR_CLIP_SOURCE_UNAVAILABLE_KEY,
+		Message:    "Source cannot be clipped, it is not ready, not public or no longer live.",
+	}
+
+	RES_ERR_CLIP_NOT_PROCESSING = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_CLIP_NOT_PROCESSING_CODE,
+		Key:        RES_ERR_CLIP_NOT_PROCESSING_KEY,
+		Message:    "Clip is no longer being processed.",
+	}
+
+	RES_ERR_VOD_INVALID_EDIT = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_INVALID_EDIT_CODE,
+		Key:        RES_ERR_VOD_INVALID_EDIT_KEY,
+		Message:    "Edit ranges must be sorted, must not overlap and must stay within the VOD.",
+	}
+
+	RES_ERR_VOD_EDIT_CONFLICT = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_VOD_EDIT_CONFLICT_CODE,
+		Key:        RES_ERR_VOD_EDIT_CONFLICT_KEY,
+		Message:    "VOD was changed while it was being edited, please try again.",
+	}
+
+	RES_ERR_VOD_NOT_EDITABLE = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusConflict,
+		Code:       RES_ERR_VOD_NOT_EDITABLE_CODE,
+		Key:        RES_ERR_VOD_NOT_EDITABLE_KEY,
+		Message:    "Only ready VODs can be trimmed or split.",
+	}
+
+	RES_ERR_VOD_INVALID_CHAPTERS = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       RES_ERR_VOD_INVALID_CHAPTERS_CODE,
+		Key:        RES_ERR_VOD_INVALID_CHAPTERS_KEY,
+		Message:    "Chapters must be sorted by their start, must not share a start and must start within the VOD.",
+	}
+
+	RES_ERR_VOD_COMMENT_BLOCKED = ResponseTemplate{
+		Success:    false,
+		StatusCode: http.StatusForbidden,
+		Code:       RES_ERR_VOD_COMMENT_BLOCKED_CODE,
+		Key:        RES_ERR_VOD_COMMENT_BLOCKED_KEY,
+		Message:    "You can not comment on the VODs of a user who blocked you.",
+	}
 )
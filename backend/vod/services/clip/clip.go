@@ -0,0 +1,45 @@
+package clip
+
+import (
+	"sen1or/letslive/vod/config"
+	"sen1or/letslive/vod/domains"
+	livestreamgateway "sen1or/letslive/vod/gateway/livestream"
+	"time"
+)
+
+// ClipService creates the clips of vods and livestreams and hands them to the transcode workers cutting them
+type ClipService struct {
+	clipRepo          domains.ClipRepository
+	vodRepo           domains.VODRepository
+	livestreamGateway livestreamgateway.LivestreamGateway
+	jobNotifier       domains.ClipJobNotifier
+
+	config    config.Clip
+	jobConfig config.TranscodeJob
+}
+
+func NewClipService(
+	clipRepo domains.ClipRepository,
+	vodRepo domains.VODRepository,
+	livestreamGateway livestreamgateway.LivestreamGateway,
+	jobNotifier domains.ClipJobNotifier,
+	cfg config.Clip,
+	jobConfig config.TranscodeJob,
+) *ClipService {
+	return &ClipService{
+		clipRepo:          clipRepo,
+		vodRepo:           vodRepo,
+		livestreamGateway: livestreamGateway,
+		jobNotifier:       jobNotifier,
+		config:            cfg,
+		jobConfig:         jobConfig,
+	}
+}
+
+// retryBackoff is the one of the transcode jobs, a failed clip is retried the same way
+func (s *ClipService) retryBackoff() domains.RetryBackoff {
+	return domains.RetryBackoff{
+		Base: time.Duration(s.jobConfig.RetryBaseDelay) * time.Second,
+		Max:  time.Duration(s.jobConfig.RetryMaxDelay) * time.Second,
+	}
+}
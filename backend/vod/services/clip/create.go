@@ -0,0 +1,113 @@
+package clip
+
+import (
+	"context"
+	"fmt"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"sen1or/letslive/vod/utils"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Create queues a clip of a ready vod or of a livestream while it is live, a private source can only be clipped by its owner
+func (s *ClipService) Create(ctx context.Context, data dto.CreateClipRequestDTO, authorId uuid.UUID) (*domains.Clip, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	clip := domains.Clip{
+		UserId:       authorId,
+		Title:        data.Title,
+		StartSeconds: data.StartSeconds,
+		EndSeconds:   data.EndSeconds,
+		Mode:         domains.ClipModeCopy,
+		MaxAttempts:  s.config.MaxAttempts,
+	}
+	if data.Mode != "" {
+		clip.Mode = domains.ClipMode(data.Mode)
+	}
+
+	var sourceDuration float64
+	if data.VodId != nil {
+		vod, err := s.vodRepo.GetById(ctx, *data.VodId)
+		if err != nil {
+			return nil, err
+		}
+		if vod.Status != domains.VODStatusReady || vod.PlaybackURL == nil ||
+			(vod.Visibility != domains.VODPublicVisibility && vod.UserId != authorId) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_CLIP_SOURCE_UNAVAILABLE,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		clip.ChannelId = vod.UserId
+		clip.SourceVodId = &vod.Id
+		clip.SourcePlaybackURL = *vod.PlaybackURL
+		sourceDuration = float64(vod.Duration)
+	} else {
+		livestream, err := s.livestreamGateway.GetLivestream(ctx, *data.LivestreamId)
+		if err != nil {
+			logger.Errorf(ctx, "failed to get livestream %s to clip: %v", *data.LivestreamId, err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_INTERNAL_SERVER,
+				nil,
+				nil,
+				nil,
+			)
+		}
+		if livestream == nil || !livestream.IsLive() ||
+			(livestream.Visibility != string(domains.VODPublicVisibility) && livestream.UserId != authorId) {
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_CLIP_SOURCE_UNAVAILABLE,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		clip.ChannelId = livestream.UserId
+		clip.SourceLivestreamId = &livestream.Id
+		clip.SourcePlaybackURL = fmt.Sprintf("%s/%s/index.m3u8", s.config.LivePlaylistURLPrefix, livestream.Id)
+		sourceDuration = time.Since(livestream.StartedAt).Seconds()
+	}
+
+	if err := s.checkClipRange(data.StartSeconds, data.EndSeconds, sourceDuration); err != nil {
+		return nil, err
+	}
+
+	createdClip, err := s.clipRepo.Create(ctx, clip)
+	if err != nil {
+		return nil, err
+	}
+
+	s.jobNotifier.NotifyClipQueued(ctx, createdClip.Id)
+	return createdClip, nil
+}
+
+// checkClipRange rejects a range that is shorter or longer than allowed or ends after the source,
+// sourceDuration is not checked when it is unknown (0)
+func (s *ClipService) checkClipRange(startSeconds float64, endSeconds float64, sourceDuration float64) *response.Response[any] {
+	length := endSeconds - startSeconds
+	if startSeconds < 0 || length < float64(s.config.MinDuration) || length > float64(s.config.MaxDuration) ||
+		(sourceDuration > 0 && endSeconds > sourceDuration) {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_CLIP_INVALID_RANGE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package clip
+
+import (
+	"sen1or/letslive/vod/config"
+	"testing"
+)
+
+func TestCheckClipRange(t *testing.T) {
+	s := &ClipService{config: config.Clip{MinDuration: 5, MaxDuration: 60}}
+
+	tests := []struct {
+		name           string
+		start, end     float64
+		sourceDuration float64
+		accept         bool
+	}{
+		{"inside the source", 10, 40, 120, true},
+		{"up to the end of the source", 90, 120, 120, true},
+		{"unknown source duration", 1000, 1030, 0, true},
+		{"shortest", 0, 5, 120, true},
+		{"longest", 0, 60, 120, true},
+		{"too short", 10, 14.5, 120, false},
+		{"too long", 0, 60.5, 120, false},
+		{"past the end of the source", 100, 121, 120, false},
+		{"negative start", -5, 10, 120, false},
+	}
+
+	for _, test := range tests {
+		if err := s.checkClipRange(test.start, test.end, test.sourceDuration); (err == nil) != test.accept {
+			t.Errorf("%s: checkClipRange() accepted = %v, want %v", test.name, err == nil, test.accept)
+		}
+	}
+}
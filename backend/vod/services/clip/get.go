@@ -0,0 +1,22 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetById also returns the clips that are not ready so their author can follow them
+func (s *ClipService) GetById(ctx context.Context, clipId uuid.UUID) (*domains.Clip, *response.Response[any]) {
+	return s.clipRepo.GetById(ctx, clipId)
+}
+
+func (s *ClipService) GetByChannel(ctx context.Context, channelId uuid.UUID, page int, limit int) ([]domains.Clip, *response.Response[any]) {
+	return s.clipRepo.GetByChannel(ctx, channelId, page, limit)
+}
+
+func (s *ClipService) GetBySource(ctx context.Context, source domains.ClipSource, page int, limit int) ([]domains.Clip, *response.Response[any]) {
+	return s.clipRepo.GetBySource(ctx, source, page, limit)
+}
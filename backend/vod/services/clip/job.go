@@ -0,0 +1,53 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// LeaseJob returns the next clip to cut, nil when none is due
+func (s *ClipService) LeaseJob(ctx context.Context) (*domains.LeasedClipJob, *response.Response[any]) {
+	return s.clipRepo.Lease(ctx, time.Duration(s.config.LeaseDuration)*time.Second)
+}
+
+func (s *ClipService) CompleteJob(ctx context.Context, clipId uuid.UUID, req dto.CompleteClipJobRequestDTO) *response.Response[any] {
+	if req.LeaseId == uuid.Nil || len(req.PlaybackUrl) == 0 || req.Duration < 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.clipRepo.Complete(ctx, clipId, req.LeaseId, req.PlaybackUrl, req.ThumbnailUrl, req.Duration)
+}
+
+// FailJob records a failed attempt and returns whether the clip will be retried ('pending') or gave up ('failed')
+func (s *ClipService) FailJob(ctx context.Context, clipId uuid.UUID, req dto.FailClipJobRequestDTO) (*dto.FailClipJobResponseDTO, *response.Response[any]) {
+	if req.LeaseId == uuid.Nil {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	status, err := s.clipRepo.Fail(ctx, clipId, req.LeaseId, req.ErrorMessage, req.Retryable, s.retryBackoff())
+	if err != nil {
+		return nil, err
+	}
+
+	if status == domains.ClipStatusFailed {
+		logger.Warnf(ctx, "clip %s failed for good: %s", clipId, req.ErrorMessage)
+	}
+
+	return &dto.FailClipJobResponseDTO{Status: string(status)}, nil
+}
@@ -0,0 +1,27 @@
+package clip
+
+import (
+	"context"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// RegisterView counts a view once half of the clip was watched, clips are too short for the thresholds of the vods
+func (s *ClipService) RegisterView(ctx context.Context, clipId uuid.UUID, watchedSeconds int64) *response.Response[any] {
+	clip, err := s.clipRepo.GetById(ctx, clipId)
+	if err != nil {
+		return err
+	}
+
+	if watchedSeconds < clip.Duration/2 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_VIEW_THRESHOLD,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.clipRepo.IncrementViewCount(ctx, clipId)
+}
@@ -0,0 +1,22 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (s *TranscodeJobService) Complete(ctx context.Context, jobId uuid.UUID, req dto.CompleteTranscodeJobRequestDTO) *response.Response[any] {
+	if req.LeaseId == uuid.Nil || req.PlaybackUrl == nil || len(*req.PlaybackUrl) == 0 {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.transcodeJobRepo.Complete(ctx, jobId, req.LeaseId, req.PlaybackUrl, req.ThumbnailUrl, req.ThumbnailCandidateUrls, req.PreviewTrackUrl, req.Duration)
+}
@@ -0,0 +1,34 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Fail records a failed attempt and returns whether the job will be retried ('pending') after the backoff or gave up ('failed')
+func (s *TranscodeJobService) Fail(ctx context.Context, jobId uuid.UUID, req dto.FailTranscodeJobRequestDTO) (*dto.FailTranscodeJobResponseDTO, *response.Response[any]) {
+	if req.LeaseId == uuid.Nil {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	status, err := s.transcodeJobRepo.Fail(ctx, jobId, req.LeaseId, req.ErrorMessage, req.Retryable, s.retryBackoff())
+	if err != nil {
+		return nil, err
+	}
+
+	if status == domains.TranscodeJobFailed {
+		logger.Warnf(ctx, "transcode job %s failed for good: %s", jobId, req.ErrorMessage)
+	}
+
+	return &dto.FailTranscodeJobResponseDTO{Status: string(status)}, nil
+}
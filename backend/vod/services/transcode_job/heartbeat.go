@@ -0,0 +1,29 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Heartbeat extends the lease of a running job, the worker stops when the job was cancelled
+// because its vod is deleted or when its lease already expired and the job went back to the queue
+func (s *TranscodeJobService) Heartbeat(ctx context.Context, jobId uuid.UUID, req dto.HeartbeatTranscodeJobRequestDTO) (*dto.HeartbeatTranscodeJobResponseDTO, *response.Response[any]) {
+	if req.LeaseId == uuid.Nil {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	leaseExpiresAt, err := s.transcodeJobRepo.Heartbeat(ctx, jobId, req.LeaseId, s.leaseDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.HeartbeatTranscodeJobResponseDTO{LeaseExpiresAt: *leaseExpiresAt}, nil
+}
@@ -0,0 +1,12 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+)
+
+// Lease returns the next job to process, nil when no job is due
+func (s *TranscodeJobService) Lease(ctx context.Context) (*domains.LeasedTranscodeJob, *response.Response[any]) {
+	return s.transcodeJobRepo.Lease(ctx, s.leaseDuration())
+}
@@ -0,0 +1,45 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"time"
+)
+
+// RunReaper reclaims the jobs whose worker stopped sending heartbeats until the context is cancelled,
+// they go back to the queue after the backoff or are failed together with their vod at max_attempts
+func (s *TranscodeJobService) RunReaper(ctx context.Context) {
+	interval := time.Duration(s.config.ReapInterval) * time.Second
+	logger.Infof(ctx, "transcode job reaper started, running every %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.reapExpiredJobs(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "transcode job reaper stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TranscodeJobService) reapExpiredJobs(ctx context.Context) {
+	jobs, err := s.transcodeJobRepo.ReapExpired(ctx, s.config.ReapBatchSize, s.retryBackoff())
+	if err != nil {
+		logger.Errorf(ctx, "failed to reap expired transcode jobs: %s", err.Message)
+		return
+	}
+
+	for _, job := range jobs {
+		if job.Status == domains.TranscodeJobFailed {
+			logger.Warnf(ctx, "transcode job %s of vod %s failed for good, its lease expired on attempt %d/%d", job.Id, job.VodId, job.Attempts, job.MaxAttempts)
+			continue
+		}
+		logger.Infof(ctx, "transcode job %s of vod %s lost its lease on attempt %d/%d, retrying at %s", job.Id, job.VodId, job.Attempts, job.MaxAttempts, job.NextAttemptAt.Format(time.RFC3339))
+	}
+}
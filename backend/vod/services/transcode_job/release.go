@@ -0,0 +1,29 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Release hands the job of a stopping worker to the other workers, the interrupted attempt is not counted
+func (s *TranscodeJobService) Release(ctx context.Context, jobId uuid.UUID, req dto.ReleaseTranscodeJobRequestDTO) *response.Response[any] {
+	if req.LeaseId == uuid.Nil {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vodId, err := s.transcodeJobRepo.Release(ctx, jobId, req.LeaseId)
+	if err != nil {
+		return err
+	}
+	s.jobNotifier.NotifyQueued(ctx, *vodId)
+
+	return nil
+}
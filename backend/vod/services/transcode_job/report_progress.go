@@ -0,0 +1,23 @@
+package transcodejob
+
+import (
+	"context"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// ReportProgress records how far the worker is in the running attempt, shown to the owner of the vod
+func (s *TranscodeJobService) ReportProgress(ctx context.Context, jobId uuid.UUID, req dto.ReportTranscodeJobProgressRequestDTO) *response.Response[any] {
+	if req.LeaseId == uuid.Nil || req.Progress < 0 || req.Progress > 100 || (req.EtaSeconds != nil && *req.EtaSeconds < 0) {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.transcodeJobRepo.ReportProgress(ctx, jobId, req.LeaseId, req.Progress, req.EtaSeconds)
+}
@@ -0,0 +1,34 @@
+package transcodejob
+
+import (
+	"sen1or/letslive/vod/config"
+	"sen1or/letslive/vod/domains"
+	"time"
+)
+
+// TranscodeJobService hands the transcode jobs to the workers of the transcode service,
+// which only reach the queue through the internal endpoints
+type TranscodeJobService struct {
+	transcodeJobRepo domains.TranscodeJobRepository
+	jobNotifier      domains.TranscodeJobNotifier
+	config           config.TranscodeJob
+}
+
+func NewTranscodeJobService(transcodeJobRepo domains.TranscodeJobRepository, jobNotifier domains.TranscodeJobNotifier, cfg config.TranscodeJob) *TranscodeJobService {
+	return &TranscodeJobService{
+		transcodeJobRepo: transcodeJobRepo,
+		jobNotifier:      jobNotifier,
+		config:           cfg,
+	}
+}
+
+func (s *TranscodeJobService) leaseDuration() time.Duration {
+	return time.Duration(s.config.LeaseDuration) * time.Second
+}
+
+func (s *TranscodeJobService) retryBackoff() domains.RetryBackoff {
+	return domains.RetryBackoff{
+		Base: time.Duration(s.config.RetryBaseDelay) * time.Second,
+		Max:  time.Duration(s.config.RetryMaxDelay) * time.Second,
+	}
+}
@@ -0,0 +1,39 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// AbortUpload drops the uploaded parts and the vod that was waiting for them
+func (s *VODService) AbortUpload(ctx context.Context, uploadId uuid.UUID, userId uuid.UUID) *response.Response[any] {
+	upload, err := s.getOwnedUpload(ctx, uploadId, userId)
+	if err != nil {
+		return err
+	}
+
+	if upload.Status == domains.VODUploadCompleting {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_BUSY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if abortErr := s.removeUpload(ctx, *upload); abortErr != nil {
+		logger.Errorf(ctx, "failed to abort upload %s: %v", uploadId, abortErr)
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
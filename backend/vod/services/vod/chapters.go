@@ -0,0 +1,146 @@
+package vod
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"sen1or/letslive/vod/utils"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const maxChapterTitleLength = 100
+
+// UpdateChapters replaces the chapters of the vod
+func (s *VODService) UpdateChapters(ctx context.Context, vodId uuid.UUID, authorId uuid.UUID, data dto.UpdateVODChaptersRequestDTO) ([]domains.VODChapter, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+	if vod.UserId != authorId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_FORBIDDEN, nil, nil, nil)
+	}
+
+	chapters := make([]domains.VODChapter, len(data.Chapters))
+	for i, chapter := range data.Chapters {
+		chapters[i] = domains.VODChapter{VodId: vodId, StartSeconds: chapter.StartSeconds, Title: strings.TrimSpace(chapter.Title)}
+	}
+	if !validChapters(chapters, float64(vod.Duration)) {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_INVALID_CHAPTERS, nil, nil, nil)
+	}
+
+	if err := s.vodChapterRepo.Replace(ctx, vodId, chapters); err != nil {
+		return nil, err
+	}
+	return chapters, nil
+}
+
+// GetChaptersTrack returns the chapters of the vod as a WebVTT chapters track
+func (s *VODService) GetChaptersTrack(ctx context.Context, vodId uuid.UUID) (string, *response.Response[any]) {
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return "", err
+	}
+
+	chapters, err := s.vodChapterRepo.GetByVodId(ctx, vodId)
+	if err != nil {
+		return "", err
+	}
+	return chaptersWebVTT(chapters, float64(vod.Duration)), nil
+}
+
+// setInitialChapters stores the chapters a vod was created with, e.g. from the title changes of its livestream.
+// They are fitted to the vod rather than rejected, a vod is never failed for its chapters
+func (s *VODService) setInitialChapters(ctx context.Context, vod *domains.VOD, chapters []domains.VODChapter) {
+	chapters = fitChapters(chapters, float64(vod.Duration))
+	if len(chapters) == 0 {
+		return
+	}
+
+	if err := s.vodChapterRepo.Replace(ctx, vod.Id, chapters); err != nil {
+		logger.Warnf(ctx, "failed to set the chapters of vod %s: %s", vod.Id, err.Message)
+		return
+	}
+	vod.Chapters = chapters
+}
+
+// validChapters checks the chapters have a title and start within the vod, strictly in order
+func validChapters(chapters []domains.VODChapter, duration float64) bool {
+	previousStart := -1.0
+	for _, chapter := range chapters {
+		if chapter.StartSeconds <= previousStart || chapter.StartSeconds >= duration {
+			return false
+		}
+		if len(chapter.Title) == 0 || utf8.RuneCountInString(chapter.Title) > maxChapterTitleLength {
+			return false
+		}
+		previousStart = chapter.StartSeconds
+	}
+	return true
+}
+
+// fitChapters sorts the chapters, drops the ones without a title or starting outside the vod, keeps the last one of
+// those sharing a start and cuts the titles that are too long
+func fitChapters(chapters []domains.VODChapter, duration float64) []domains.VODChapter {
+	sorted := slices.Clone(chapters)
+	slices.SortStableFunc(sorted, func(a, b domains.VODChapter) int {
+		return cmp.Compare(a.StartSeconds, b.StartSeconds)
+	})
+
+	var fitted []domains.VODChapter
+	for _, chapter := range sorted {
+		chapter.Title = strings.TrimSpace(chapter.Title)
+		if len(chapter.Title) == 0 || chapter.StartSeconds < 0 || chapter.StartSeconds >= duration {
+			continue
+		}
+		if runes := []rune(chapter.Title); len(runes) > maxChapterTitleLength {
+			chapter.Title = strings.TrimSpace(string(runes[:maxChapterTitleLength]))
+		}
+
+		if len(fitted) > 0 && fitted[len(fitted)-1].StartSeconds == chapter.StartSeconds {
+			fitted[len(fitted)-1] = chapter
+			continue
+		}
+		fitted = append(fitted, chapter)
+	}
+	return fitted
+}
+
+// chaptersWebVTT writes the chapters as the cues of a WebVTT track, each one ending where the next one starts
+func chaptersWebVTT(chapters []domains.VODChapter, duration float64) string {
+	var track strings.Builder
+	track.WriteString("WEBVTT\n")
+	for i, chapter := range chapters {
+		end := duration
+		if i+1 < len(chapters) {
+			end = chapters[i+1].StartSeconds
+		}
+		fmt.Fprintf(&track, "\n%s --> %s\n%s\n", formatVTTTimestamp(chapter.StartSeconds), formatVTTTimestamp(end), escapeVTTCueText(chapter.Title))
+	}
+	return track.String()
+}
+
+// formatVTTTimestamp formats seconds as hh:mm:ss.mmm
+func formatVTTTimestamp(seconds float64) string {
+	ms := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, ms%1000)
+}
+
+var vttCueTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r\n", " ", "\n", " ", "\r", " ")
+
+// escapeVTTCueText keeps a title on one line of cue text, escaping what the cue text syntax reserves
+func escapeVTTCueText(title string) string {
+	return vttCueTextEscaper.Replace(title)
+}
@@ -0,0 +1,63 @@
+package vod
+
+import (
+	"reflect"
+	"sen1or/letslive/vod/domains"
+	"strings"
+	"testing"
+)
+
+func TestValidChapters(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters []domains.VODChapter
+		want     bool
+	}{
+		{"no chapter", nil, true},
+		{"sorted", []domains.VODChapter{{StartSeconds: 0, Title: "a"}, {StartSeconds: 30.5, Title: "b"}}, true},
+		{"first one after the start", []domains.VODChapter{{StartSeconds: 10, Title: "a"}}, true},
+		{"unsorted", []domains.VODChapter{{StartSeconds: 30, Title: "a"}, {StartSeconds: 0, Title: "b"}}, false},
+		{"same start", []domains.VODChapter{{StartSeconds: 0, Title: "a"}, {StartSeconds: 0, Title: "b"}}, false},
+		{"starts at the end", []domains.VODChapter{{StartSeconds: 100, Title: "a"}}, false},
+		{"negative start", []domains.VODChapter{{StartSeconds: -1, Title: "a"}}, false},
+		{"empty title", []domains.VODChapter{{StartSeconds: 0, Title: ""}}, false},
+		{"title too long", []domains.VODChapter{{StartSeconds: 0, Title: strings.Repeat("é", 101)}}, false},
+	}
+
+	for _, test := range tests {
+		if got := validChapters(test.chapters, 100); got != test.want {
+			t.Errorf("%s: validChapters() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFitChapters(t *testing.T) {
+	chapters := []domains.VODChapter{
+		{StartSeconds: 120, Title: "after the end"},
+		{StartSeconds: 40, Title: " second "},
+		{StartSeconds: 0, Title: "first"},
+		{StartSeconds: 40, Title: "second renamed"},
+		{StartSeconds: 60, Title: "  "},
+		{StartSeconds: 80, Title: strings.Repeat("x", 120)},
+	}
+	want := []domains.VODChapter{
+		{StartSeconds: 0, Title: "first"},
+		{StartSeconds: 40, Title: "second renamed"},
+		{StartSeconds: 80, Title: strings.Repeat("x", 100)},
+	}
+
+	if got := fitChapters(chapters, 100); !reflect.DeepEqual(got, want) {
+		t.Errorf("fitChapters() = %v, want %v", got, want)
+	}
+}
+
+func TestChaptersWebVTT(t *testing.T) {
+	chapters := []domains.VODChapter{{StartSeconds: 0, Title: "Intro"}, {StartSeconds: 65.25, Title: "Q&A <live>\nround 2"}}
+	want := "WEBVTT\n" +
+		"\n00:00:00.000 --> 00:01:05.250\nIntro\n" +
+		"\n00:01:05.250 --> 01:00:00.000\nQ&amp;A &lt;live&gt; round 2\n"
+
+	if got := chaptersWebVTT(chapters, 3600); got != want {
+		t.Errorf("chaptersWebVTT() = %q, want %q", got, want)
+	}
+}
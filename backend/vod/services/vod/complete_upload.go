@@ -0,0 +1,91 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/minio/minio-go/v7"
+)
+
+// how long a completing upload is protected from the cleanup, assembling the parts is expected to take far less
+const uploadCompleteLease = 10 * time.Minute
+
+// CompleteUpload assembles the uploaded parts into the raw file and queues the transcode job of the vod
+func (s *VODService) CompleteUpload(ctx context.Context, uploadId uuid.UUID, userId uuid.UUID) (*domains.VOD, *response.Response[any]) {
+	upload, err := s.getOwnedUpload(ctx, uploadId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUploadWritable(*upload); err != nil {
+		return nil, err
+	}
+
+	parts, err := s.vodUploadRepo.GetParts(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if uploadOffset(*upload, parts) != upload.TotalSize {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_INCOMPLETE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := s.vodUploadRepo.MarkCompleting(ctx, uploadId, uploadCompleteLease); err != nil {
+		return nil, err
+	}
+
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+
+	rawFileURL, completeErr := s.minioStorage.CompleteMultipartUpload(ctx, upload.ObjectName, upload.MultipartUploadId, completeParts)
+	if completeErr != nil {
+		logger.Errorf(ctx, "failed to complete upload %s: %v", uploadId, completeErr)
+		if err := s.vodUploadRepo.ReleaseCompleting(ctx, uploadId, time.Now().Add(s.uploadExpiry())); err != nil {
+			logger.Errorf(ctx, "failed to release completing upload %s: %s", uploadId, err.Message)
+		}
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	mediaInfo, probeErr := s.probeRawFile(ctx, upload.ObjectName)
+	if probeErr != nil {
+		// a rejected file can't be fixed by resuming, the vod is dropped with its file,
+		// when the probe itself failed the upload stays in 'completing' and the cleanup removes it after the lease
+		if probeErr.Code != response.RES_ERR_INTERNAL_SERVER_CODE {
+			if err := s.removeUpload(ctx, *upload); err != nil {
+				logger.Errorf(ctx, "failed to remove rejected upload %s: %v", uploadId, err)
+			}
+		}
+		return nil, probeErr
+	}
+
+	vod, completeUploadErr := s.vodUploadRepo.Complete(ctx, uploadId, rawFileURL, *mediaInfo, domains.TranscodeJob{
+		VodId:       upload.VodId,
+		Status:      domains.TranscodeJobPending,
+		Attempts:    0,
+		MaxAttempts: transcodeJobMaxAttempts,
+	})
+	if completeUploadErr != nil {
+		// the upload stays in 'completing' and is removed with its file by the cleanup once the lease is over
+		logger.Errorf(ctx, "failed to queue the transcode of upload %s: %s", uploadId, completeUploadErr.Message)
+		return nil, completeUploadErr
+	}
+	s.jobNotifier.NotifyQueued(ctx, vod.Id)
+
+	return vod, nil
+}
@@ -7,5 +7,13 @@ import (
 )
 
 func (s *VODService) Create(ctx context.Context, vod domains.VOD) (*domains.VOD, *response.Response[any]) {
-	return s.vodRepo.Create(ctx, vod)
+	createdVOD, err := s.vodRepo.Create(ctx, vod)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vod.Chapters) > 0 {
+		s.setInitialChapters(ctx, createdVOD, vod.Chapters)
+	}
+	return createdVOD, nil
 }
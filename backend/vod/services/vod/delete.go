@@ -3,10 +3,13 @@ package vod
 import (
 	"context"
 	"sen1or/letslive/vod/response"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 )
 
+// Delete hides the vod right away and cancels its transcode job,
+// its media is removed from storage in the background by RunMediaCleanup
 func (s *VODService) Delete(ctx context.Context, vodId uuid.UUID, authorId uuid.UUID) *response.Response[any] {
 	vod, err := s.vodRepo.GetById(ctx, vodId)
 	if err != nil {
@@ -22,5 +25,16 @@ func (s *VODService) Delete(ctx context.Context, vodId uuid.UUID, authorId uuid.
 		)
 	}
 
-	return s.vodRepo.Delete(ctx, vodId)
+	gracePeriod := time.Duration(s.cleanupConfig.TranscodeGracePeriod) * time.Second
+	if err := s.vodDeletionRepo.MarkDeleting(ctx, vodId, gracePeriod); err != nil {
+		return err
+	}
+
+	// wake the cleanup up instead of waiting for the next tick
+	select {
+	case s.cleanupTrigger <- struct{}{}:
+	default:
+	}
+
+	return nil
 }
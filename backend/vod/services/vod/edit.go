@@ -0,0 +1,274 @@
+package vod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sen1or/letslive/shared/pkg/hls"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"sen1or/letslive/vod/utils"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const hlsPlaylistContentType = "application/vnd.apple.mpegurl"
+
+// Trim keeps the ranges of the vod, joined with a discontinuity between them
+func (s *VODService) Trim(ctx context.Context, vodId uuid.UUID, authorId uuid.UUID, data dto.TrimVODRequestDTO) (*domains.VOD, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	ranges := make([]domains.VODRange, len(data.Ranges))
+	for i, r := range data.Ranges {
+		ranges[i] = domains.VODRange{Start: r.StartSeconds, End: r.EndSeconds}
+	}
+
+	vods, err := s.edit(ctx, vodId, authorId, [][]domains.VODRange{ranges})
+	if err != nil {
+		return nil, err
+	}
+	return &vods[0], nil
+}
+
+// Split cuts the vod at the given offsets, the vod keeps the first part and a vod is created for every other one.
+// Returns the vod followed by the new ones
+func (s *VODService) Split(ctx context.Context, vodId uuid.UUID, authorId uuid.UUID, data dto.SplitVODRequestDTO) ([]domains.VOD, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	return s.edit(ctx, vodId, authorId, splitParts(data.AtSeconds))
+}
+
+// edit writes a playlist for every part, made of the ranges of the vod it keeps, and applies them:
+// the vod plays the first one and a vod is created for each other one.
+// The cuts fall on the closest segment boundaries since the segments are referenced as they are
+func (s *VODService) edit(ctx context.Context, vodId uuid.UUID, authorId uuid.UUID, parts [][]domains.VODRange) ([]domains.VOD, *response.Response[any]) {
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+	if vod.UserId != authorId {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_FORBIDDEN, nil, nil, nil)
+	}
+	if vod.Status != domains.VODStatusReady || vod.PlaybackURL == nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_NOT_EDITABLE, nil, nil, nil)
+	}
+
+	renditions, fetchErr := hls.FetchRenditions(ctx, *vod.PlaybackURL)
+	if fetchErr != nil {
+		logger.Errorf(ctx, "failed to read the playlists of vod %s to edit it: %v", vodId, fetchErr)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+
+	sourceSegments := renditions[0].Segments
+	duration := sourceSegments[len(sourceSegments)-1].End()
+	if !validEditParts(parts, duration, float64(s.editConfig.MinPartDuration)) {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_INVALID_EDIT, nil, nil, nil)
+	}
+
+	chapters, err := s.vodChapterRepo.GetByVodId(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := vod.MediaFolder()
+	bucket := s.vodBucketName
+	if len(bucket) == 0 {
+		bucket = bucketFromObjectURL(sourceSegments[0].URL, []string{folder})
+	}
+	if len(bucket) == 0 {
+		logger.Errorf(ctx, "can not find the bucket of the segments of vod %s, set minio.vodBucketName", vodId)
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+	}
+
+	edit := domains.VODEdit{
+		VodId:               vod.Id,
+		PreviousPlaybackURL: *vod.PlaybackURL,
+		MediaFolder:         folder,
+		Bucket:              bucket,
+		CollectAt:           time.Now().Add(time.Duration(s.editConfig.CollectDelay) * time.Second),
+	}
+
+	// the thumbnails uploaded by the owner go with the vod, the parts only keep one of the media folder
+	var partThumbnailURL *string
+	if vod.ThumbnailURL != nil && strings.HasPrefix(objectKeyFromURL(*vod.ThumbnailURL, bucket), folder+"/") {
+		partThumbnailURL = vod.ThumbnailURL
+	}
+
+	for i, ranges := range parts {
+		playbackURL, partDuration, writeErr := s.writeEditPlaylists(ctx, renditions, ranges, bucket, fmt.Sprintf("%s/edits/%s", folder, uuid.Must(uuid.NewV4())))
+		if writeErr != nil {
+			logger.Errorf(ctx, "failed to write the edited playlists of vod %s: %v", vodId, writeErr)
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INTERNAL_SERVER, nil, nil, nil)
+		}
+		if partDuration == 0 {
+			return nil, response.NewResponseFromTemplate[any](response.RES_ERR_VOD_INVALID_EDIT, nil, nil, nil)
+		}
+
+		partChapters := fitChapters(remapChapters(chapters, editSegments(sourceSegments, ranges)), float64(partDuration))
+
+		if i == 0 {
+			edit.PlaybackURL, edit.Duration, edit.Chapters = playbackURL, partDuration, partChapters
+			continue
+		}
+		edit.Parts = append(edit.Parts, domains.VOD{
+			UserId:             vod.UserId,
+			Title:              fmt.Sprintf("%s (part %d)", vod.Title, i+1),
+			Description:        vod.Description,
+			ThumbnailURL:       partThumbnailURL,
+			Visibility:         vod.Visibility,
+			Category:           vod.Category,
+			Tags:               vod.Tags,
+			PeakViewerCount:    vod.PeakViewerCount, // the parts were watched live as one stream
+			AverageViewerCount: vod.AverageViewerCount,
+			Duration:           partDuration,
+			PlaybackURL:        &playbackURL,
+			Width:              vod.Width,
+			Height:             vod.Height,
+			FrameRate:          vod.FrameRate,
+			VideoCodec:         vod.VideoCodec,
+			AudioCodec:         vod.AudioCodec,
+			Chapters:           partChapters,
+		})
+	}
+
+	return s.vodEditRepo.Apply(ctx, edit)
+}
+
+// writeEditPlaylists uploads a playlist of every rendition keeping the ranges, and their master playlist, under folder.
+// Returns the url of the master playlist and the duration, 0 when the ranges keep no segment
+func (s *VODService) writeEditPlaylists(ctx context.Context, renditions []hls.Rendition, ranges []domains.VODRange, bucket string, folder string) (string, int64, error) {
+	variants := make([]hls.Variant, len(renditions))
+	var duration float64
+	for i, rendition := range renditions {
+		segments := editSegments(rendition.Segments, ranges)
+		if len(segments) == 0 {
+			return "", 0, nil
+		}
+		if i == 0 {
+			for _, segment := range segments {
+				duration += segment.Duration
+			}
+		}
+
+		// the segments stay where they are, the playlist references them by their absolute url
+		urls := make([]string, len(segments))
+		for j, segment := range segments {
+			urls[j] = segment.URL
+		}
+
+		var playlist bytes.Buffer
+		if err := hls.WriteMediaPlaylist(&playlist, segments, urls); err != nil {
+			return "", 0, err
+		}
+		if _, err := s.minioStorage.PutObject(ctx, bucket, fmt.Sprintf("%s/%d/stream.m3u8", folder, i), playlist.Bytes(), hlsPlaylistContentType); err != nil {
+			return "", 0, err
+		}
+
+		variants[i] = hls.Variant{StreamInf: rendition.StreamInf, URL: fmt.Sprintf("%d/stream.m3u8", i)}
+	}
+
+	// the master playlist is uploaded last so it never points to missing variants
+	var master bytes.Buffer
+	if err := hls.WriteMasterPlaylist(&master, variants); err != nil {
+		return "", 0, err
+	}
+	playbackURL, err := s.minioStorage.PutObject(ctx, bucket, folder+"/index.m3u8", master.Bytes(), hlsPlaylistContentType)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return playbackURL, int64(math.Round(duration)), nil
+}
+
+// splitParts turns the offsets to split at into the range of every part, the last one runs until the end of the vod
+func splitParts(atSeconds []float64) [][]domains.VODRange {
+	parts := make([][]domains.VODRange, 0, len(atSeconds)+1)
+	start := 0.0
+	for _, at := range atSeconds {
+		parts = append(parts, []domains.VODRange{{Start: start, End: at}})
+		start = at
+	}
+	return append(parts, []domains.VODRange{{Start: start, End: math.Inf(1)}})
+}
+
+// validEditParts checks the ranges of every part are sorted, do not overlap, start within the vod and keep at least
+// minDuration seconds. The ranges ending after the vod are cut at its end
+func validEditParts(parts [][]domains.VODRange, duration float64, minDuration float64) bool {
+	for _, ranges := range parts {
+		if len(ranges) == 0 {
+			return false
+		}
+
+		var kept float64
+		previousEnd := 0.0
+		for _, r := range ranges {
+			if r.Start < previousEnd || r.End <= r.Start || r.Start >= duration {
+				return false
+			}
+			previousEnd = r.End
+			kept += min(r.End, duration) - r.Start
+		}
+		if kept < minDuration {
+			return false
+		}
+	}
+	return true
+}
+
+// editSegments returns the segments whose middle falls in one of the sorted ranges, so every cut moves to the closest
+// segment boundary. A segment that does not follow the previous one kept is marked as a discontinuity
+func editSegments(segments []hls.Segment, ranges []domains.VODRange) []hls.Segment {
+	var kept []hls.Segment
+	next := 0 // the segment following the last one kept
+	for _, r := range ranges {
+		for i := next; i < len(segments); i++ {
+			middle := segments[i].Start + segments[i].Duration/2
+			if middle < r.Start {
+				continue
+			}
+			if middle >= r.End {
+				break
+			}
+
+			segment := segments[i]
+			segment.Discontinuity = segment.Discontinuity || (len(kept) > 0 && i != next)
+			kept = append(kept, segment)
+			next = i + 1
+		}
+	}
+	return kept
+}
+
+// remapChapters moves the chapters onto the timeline of the kept segments. A chapter whose start was cut starts with
+// the first segment kept of the ones it spans, the chapters spanning no kept segment are dropped
+func remapChapters(chapters []domains.VODChapter, kept []hls.Segment) []domains.VODChapter {
+	var remapped []domains.VODChapter
+	for i, chapter := range chapters {
+		end := math.Inf(1)
+		if i+1 < len(chapters) {
+			end = chapters[i+1].StartSeconds
+		}
+
+		offset := 0.0 // the start of the segment in the kept timeline
+		for _, segment := range kept {
+			if segment.End() > chapter.StartSeconds && segment.Start < end {
+				start := math.Round((offset+max(chapter.StartSeconds-segment.Start, 0))*1000) / 1000
+				if len(remapped) == 0 || start > remapped[len(remapped)-1].StartSeconds {
+					remapped = append(remapped, domains.VODChapter{StartSeconds: start, Title: chapter.Title})
+				}
+				break
+			}
+			offset += segment.Duration
+		}
+	}
+	return remapped
+}
@@ -0,0 +1,100 @@
+package vod
+
+import (
+	"math"
+	"reflect"
+	"sen1or/letslive/shared/pkg/hls"
+	"sen1or/letslive/vod/domains"
+	"testing"
+)
+
+func TestValidEditParts(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]domains.VODRange
+		want  bool
+	}{
+		{"one range", [][]domains.VODRange{{{Start: 10, End: 50}}}, true},
+		{"sorted ranges", [][]domains.VODRange{{{Start: 0, End: 10}, {Start: 20, End: 30}}}, true},
+		{"touching ranges", [][]domains.VODRange{{{Start: 0, End: 10}, {Start: 10, End: 30}}}, true},
+		{"end after the vod is cut", [][]domains.VODRange{{{Start: 90, End: math.Inf(1)}}}, true},
+		{"overlapping ranges", [][]domains.VODRange{{{Start: 0, End: 20}, {Start: 10, End: 30}}}, false},
+		{"unsorted ranges", [][]domains.VODRange{{{Start: 20, End: 30}, {Start: 0, End: 10}}}, false},
+		{"empty range", [][]domains.VODRange{{{Start: 20, End: 20}}}, false},
+		{"starts after the vod", [][]domains.VODRange{{{Start: 100, End: 120}}}, false},
+		{"too short", [][]domains.VODRange{{{Start: 0, End: 3}}}, false},
+		{"too short once cut", [][]domains.VODRange{{{Start: 98, End: 120}}}, false},
+		{"part without range", [][]domains.VODRange{{{Start: 0, End: 50}}, {}}, false},
+		{"split", splitParts([]float64{30, 60}), true},
+		{"unsorted split", splitParts([]float64{60, 30}), false},
+		{"split after the vod", splitParts([]float64{120}), false},
+	}
+
+	for _, test := range tests {
+		if got := validEditParts(test.parts, 100, 5); got != test.want {
+			t.Errorf("%s: validEditParts() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEditSegments(t *testing.T) {
+	// six segments of 4s, the third one follows a discontinuity of the source
+	var segments []hls.Segment
+	for i := range 6 {
+		segments = append(segments, hls.Segment{URL: string(rune('a' + i)), Start: float64(i * 4), Duration: 4, Discontinuity: i == 2})
+	}
+
+	type kept struct {
+		url           string
+		discontinuity bool
+	}
+	tests := []struct {
+		name   string
+		ranges []domains.VODRange
+		want   []kept
+	}{
+		{"cuts move to the closest boundary", []domains.VODRange{{Start: 3, End: 13}}, []kept{{"b", false}, {"c", true}}},
+		{"gap between ranges", []domains.VODRange{{Start: 0, End: 4}, {Start: 16, End: 24}}, []kept{{"a", false}, {"e", true}, {"f", false}}},
+		{"touching ranges stay continuous", []domains.VODRange{{Start: 0, End: 4}, {Start: 4, End: 8}}, []kept{{"a", false}, {"b", false}}},
+		{"overlapping segment kept once", []domains.VODRange{{Start: 0, End: 7}, {Start: 5, End: 8}}, []kept{{"a", false}, {"b", false}}},
+		{"range shorter than half a segment", []domains.VODRange{{Start: 0.5, End: 1.5}}, nil},
+	}
+
+	for _, test := range tests {
+		var got []kept
+		for _, segment := range editSegments(segments, test.ranges) {
+			got = append(got, kept{segment.URL, segment.Discontinuity})
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: editSegments() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRemapChapters(t *testing.T) {
+	var segments []hls.Segment
+	for i := range 6 {
+		segments = append(segments, hls.Segment{Start: float64(i * 4), Duration: 4})
+	}
+	chapters := []domains.VODChapter{{StartSeconds: 0, Title: "intro"}, {StartSeconds: 6, Title: "game"}, {StartSeconds: 18, Title: "outro"}}
+
+	tests := []struct {
+		name   string
+		ranges []domains.VODRange
+		want   []domains.VODChapter
+	}{
+		{"nothing cut", []domains.VODRange{{Start: 0, End: 24}}, chapters},
+		{"cut start moves to the first kept segment", []domains.VODRange{{Start: 8, End: 24}},
+			[]domains.VODChapter{{StartSeconds: 0, Title: "game"}, {StartSeconds: 10, Title: "outro"}}},
+		{"gap shifts the later chapters", []domains.VODRange{{Start: 0, End: 8}, {Start: 16, End: 24}},
+			[]domains.VODChapter{{StartSeconds: 0, Title: "intro"}, {StartSeconds: 6, Title: "game"}, {StartSeconds: 10, Title: "outro"}}},
+		{"chapter entirely cut", []domains.VODRange{{Start: 0, End: 4}, {Start: 20, End: 24}},
+			[]domains.VODChapter{{StartSeconds: 0, Title: "intro"}, {StartSeconds: 4, Title: "outro"}}},
+	}
+
+	for _, test := range tests {
+		if got := remapChapters(chapters, editSegments(segments, test.ranges)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: remapChapters() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
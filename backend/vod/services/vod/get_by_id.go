@@ -9,5 +9,16 @@ import (
 )
 
 func (s *VODService) GetVODById(ctx context.Context, vodId uuid.UUID) (*domains.VOD, *response.Response[any]) {
-	return s.vodRepo.GetById(ctx, vodId)
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters, err := s.vodChapterRepo.GetByVodId(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+	vod.Chapters = chapters
+
+	return vod, nil
 }
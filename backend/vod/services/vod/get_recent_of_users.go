@@ -0,0 +1,21 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	response "sen1or/letslive/vod/response"
+	"sen1or/letslive/vod/utils"
+)
+
+// GetRecentVODsOfUsers returns a page of the public ready vods of the users, newest first
+func (s *VODService) GetRecentVODsOfUsers(ctx context.Context, data dto.GetRecentVODsOfUsersRequestDTO) ([]domains.VOD, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	if len(data.UserIds) == 0 {
+		return []domains.VOD{}, nil
+	}
+	return s.vodRepo.GetRecentPublicByUsers(ctx, data.UserIds, data.Before, data.Limit)
+}
@@ -2,15 +2,25 @@ package vod
 
 import (
 	"context"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/shared/pkg/ranking"
 	"sen1or/letslive/vod/domains"
 	response "sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
 )
 
-func (s *VODService) GetRecommendedVODs(ctx context.Context, page int, limit int) ([]domains.VOD, *response.Response[any]) {
-	if page < 0 {
-		page = 0
-	}
+// the vods ranked for a request are the newest ones and the most viewed ones, so an old vod with a lot of views can
+// still show up while most of the pool stays fresh
+const (
+	recentRecommendationCandidates  = 500
+	popularRecommendationCandidates = 200
+)
 
+// GetRecommendedVODs ranks the public vods for the viewer, nil when anonymous. The pages after the first one are
+// ranked at the time kept in the cursor so they line up with the previous ones
+func (s *VODService) GetRecommendedVODs(ctx context.Context, filter domains.VODFilter, viewerId *uuid.UUID, after *ranking.Cursor, limit int) ([]domains.VOD, *ranking.Cursor, *response.Response[any]) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -19,5 +29,52 @@ func (s *VODService) GetRecommendedVODs(ctx context.Context, page int, limit int
 		limit = 50
 	}
 
-	return s.vodRepo.GetPopular(ctx, page, limit)
+	candidates, err := s.vodRepo.GetRecommendationCandidates(ctx, filter, recentRecommendationCandidates, popularRecommendationCandidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asOf := time.Now()
+	if after != nil {
+		asOf = after.AsOf
+	}
+
+	items := make([]ranking.Item, len(candidates))
+	byId := make(map[uuid.UUID]domains.VOD, len(candidates))
+	for i, vod := range candidates {
+		items[i] = ranking.Item{
+			Id:          vod.Id,
+			AuthorId:    vod.UserId,
+			PublishedAt: vod.CreatedAt,
+			Popularity:  float64(vod.ViewCount),
+		}
+		if vod.Category != nil {
+			items[i].Category = *vod.Category
+		}
+		byId[vod.Id] = vod
+	}
+
+	ranked := ranking.Rank(items, s.viewerAffinity(ctx, viewerId), ranking.VODWeights, asOf)
+	page, next := ranking.Page(ranked, after, limit, asOf)
+
+	vods := make([]domains.VOD, len(page))
+	for i, scored := range page {
+		vods[i] = byId[scored.Id]
+	}
+	return vods, next, nil
+}
+
+// viewerAffinity asks the user service what the viewer follows, the vods are still ranked for an anonymous viewer
+// when it cannot tell
+func (s *VODService) viewerAffinity(ctx context.Context, viewerId *uuid.UUID) ranking.Affinity {
+	if viewerId == nil {
+		return ranking.Affinity{}
+	}
+
+	affinity, err := s.userGateway.GetViewerAffinity(ctx, *viewerId)
+	if err != nil {
+		logger.Warnf(ctx, "failed to get the affinity of viewer %s, ranking without it: %v", viewerId, err)
+		return ranking.Affinity{}
+	}
+	return ranking.NewAffinity(affinity.FollowedUserIds, affinity.Categories)
 }
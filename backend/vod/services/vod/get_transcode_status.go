@@ -0,0 +1,53 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetTranscodeStatus returns the progress of the vod's transcode to its owner
+func (s *VODService) GetTranscodeStatus(ctx context.Context, vodId uuid.UUID, userId uuid.UUID) (*dto.VODTranscodeStatusResponseDTO, *response.Response[any]) {
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+
+	if vod.UserId != userId {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_FORBIDDEN,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	job, err := s.transcodeJobRepo.GetLatestByVodId(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &dto.VODTranscodeStatusResponseDTO{
+		VodId:  vod.Id,
+		Status: string(vod.Status),
+	}
+	if job == nil {
+		return status, nil
+	}
+
+	jobStatus := string(job.Status)
+	status.JobStatus = &jobStatus
+	status.Attempts = job.Attempts
+	status.MaxAttempts = job.MaxAttempts
+	status.Progress = job.Progress
+	status.EtaSeconds = job.EtaSeconds
+	status.UpdatedAt = job.ProgressUpdatedAt
+	if job.Status == domains.TranscodeJobPending && job.Attempts > 0 {
+		status.NextAttemptAt = &job.NextAttemptAt
+	}
+
+	return status, nil
+}
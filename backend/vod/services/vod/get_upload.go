@@ -0,0 +1,24 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetUpload returns the progress of an upload so the client can resume it
+func (s *VODService) GetUpload(ctx context.Context, uploadId uuid.UUID, userId uuid.UUID) (*dto.VODUploadResponseDTO, *response.Response[any]) {
+	upload, err := s.getOwnedUpload(ctx, uploadId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.vodUploadRepo.GetParts(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVODUploadResponse(*upload, parts), nil
+}
@@ -0,0 +1,184 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// storage does not accept more parts in a multipart upload
+const maxUploadParts = 10000
+
+// InitiateUpload creates the vod in 'uploading' together with a multipart upload of its raw file,
+// the parts are then sent with UploadPart and the transcode job is only queued by CompleteUpload
+func (s *VODService) InitiateUpload(ctx context.Context, userId uuid.UUID, req dto.InitiateVODUploadRequestDTO) (*dto.VODUploadResponseDTO, *response.Response[any]) {
+	filename := filepath.Base(req.Filename)
+	ext := strings.ToLower(filepath.Ext(filename))
+	if !allowedVideoExtensions[ext] || req.FileSize <= 0 {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_INPUT,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if req.FileSize > s.uploadConfig.MaxFileSize {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VIDEO_TOO_LARGE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	vodId, err := uuid.NewV4()
+	if err != nil {
+		logger.Errorf(ctx, "failed to generate uuid: %v", err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	title := req.Title
+	if len(title) == 0 {
+		title = strings.TrimSuffix(filename, filepath.Ext(filename))
+	}
+	vodVisibility := domains.VODPublicVisibility
+	if req.Visibility == "private" {
+		vodVisibility = domains.VODPrivateVisibility
+	}
+
+	objectName := fmt.Sprintf("raw-videos/%s/%s", vodId.String(), filename)
+	contentType := "video/" + strings.TrimPrefix(ext, ".")
+	multipartUploadId, uploadErr := s.minioStorage.NewMultipartUpload(ctx, objectName, contentType)
+	if uploadErr != nil {
+		logger.Errorf(ctx, "failed to initiate raw video upload: %v", uploadErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	// large files get bigger parts so they fit in the parts limit
+	partSize := max(s.uploadConfig.PartSize, (req.FileSize+maxUploadParts-1)/maxUploadParts)
+
+	description := req.Description
+	upload, createErr := s.vodUploadRepo.Create(ctx, domains.VOD{
+		Id:          vodId,
+		UserId:      userId,
+		Title:       title,
+		Description: &description,
+		Visibility:  vodVisibility,
+	}, domains.VODUpload{
+		UserId:            userId,
+		Filename:          filename,
+		ObjectName:        objectName,
+		MultipartUploadId: multipartUploadId,
+		ContentType:       contentType,
+		TotalSize:         req.FileSize,
+		PartSize:          partSize,
+		ExpiresAt:         time.Now().Add(s.uploadExpiry()),
+	})
+	if createErr != nil {
+		if err := s.minioStorage.AbortMultipartUpload(ctx, objectName, multipartUploadId); err != nil {
+			logger.Warnf(ctx, "failed to abort multipart upload of %s: %v", objectName, err)
+		}
+		return nil, createErr
+	}
+
+	return newVODUploadResponse(*upload, nil), nil
+}
+
+func (s *VODService) uploadExpiry() time.Duration {
+	return time.Duration(s.uploadConfig.Expiry) * time.Second
+}
+
+// getOwnedUpload returns the upload if it belongs to the user
+func (s *VODService) getOwnedUpload(ctx context.Context, uploadId uuid.UUID, userId uuid.UUID) (*domains.VODUpload, *response.Response[any]) {
+	upload, err := s.vodUploadRepo.GetById(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.UserId != userId {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_FORBIDDEN,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return upload, nil
+}
+
+// checkUploadWritable rejects uploads that are expired or being completed
+func checkUploadWritable(upload domains.VODUpload) *response.Response[any] {
+	if upload.Status == domains.VODUploadExpired || !upload.ExpiresAt.After(time.Now()) {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_EXPIRED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if upload.Status == domains.VODUploadCompleting {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_BUSY,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func newVODUploadResponse(upload domains.VODUpload, parts []domains.VODUploadPart) *dto.VODUploadResponseDTO {
+	uploadedParts := make([]int, 0, len(parts))
+	for _, part := range parts {
+		uploadedParts = append(uploadedParts, part.PartNumber)
+	}
+
+	return &dto.VODUploadResponseDTO{
+		Id:            upload.Id,
+		VodId:         upload.VodId,
+		Filename:      upload.Filename,
+		Status:        string(upload.Status),
+		TotalSize:     upload.TotalSize,
+		PartSize:      upload.PartSize,
+		PartCount:     upload.PartCount(),
+		UploadedParts: uploadedParts,
+		Offset:        uploadOffset(upload, parts),
+		ExpiresAt:     upload.ExpiresAt,
+	}
+}
+
+// uploadOffset is the number of bytes received without a gap from the start of the file,
+// a client resumes by sending the part starting at this offset; parts must be sorted by part number
+func uploadOffset(upload domains.VODUpload, parts []domains.VODUploadPart) int64 {
+	var offset int64
+	for i, part := range parts {
+		if part.PartNumber != i+1 || part.Size != upload.ExpectedPartSize(part.PartNumber) {
+			break
+		}
+		offset += part.Size
+	}
+
+	return offset
+}
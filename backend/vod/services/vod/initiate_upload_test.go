@@ -0,0 +1,53 @@
+package vod
+
+import (
+	"sen1or/letslive/vod/domains"
+	"testing"
+)
+
+func TestUploadOffset(t *testing.T) {
+	// 3 parts: 10 + 10 + 5 bytes
+	upload := domains.VODUpload{TotalSize: 25, PartSize: 10}
+
+	part := func(number int, size int64) domains.VODUploadPart {
+		return domains.VODUploadPart{PartNumber: number, Size: size}
+	}
+
+	tests := []struct {
+		name  string
+		parts []domains.VODUploadPart
+		want  int64
+	}{
+		{"no parts", nil, 0},
+		{"first part", []domains.VODUploadPart{part(1, 10)}, 10},
+		{"gap after the first part", []domains.VODUploadPart{part(1, 10), part(3, 5)}, 10},
+		{"missing first part", []domains.VODUploadPart{part(2, 10), part(3, 5)}, 0},
+		{"every part", []domains.VODUploadPart{part(1, 10), part(2, 10), part(3, 5)}, 25},
+		{"short middle part", []domains.VODUploadPart{part(1, 10), part(2, 7), part(3, 5)}, 10},
+	}
+
+	for _, test := range tests {
+		if got := uploadOffset(upload, test.parts); got != test.want {
+			t.Errorf("%s: uploadOffset() = %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+func TestExpectedPartSize(t *testing.T) {
+	upload := domains.VODUpload{TotalSize: 25, PartSize: 10}
+
+	if count := upload.PartCount(); count != 3 {
+		t.Fatalf("PartCount() = %d, want 3", count)
+	}
+
+	for partNumber, want := range map[int]int64{0: 0, 1: 10, 2: 10, 3: 5, 4: 0} {
+		if got := upload.ExpectedPartSize(partNumber); got != want {
+			t.Errorf("ExpectedPartSize(%d) = %d, want %d", partNumber, got, want)
+		}
+	}
+
+	exact := domains.VODUpload{TotalSize: 20, PartSize: 10}
+	if got := exact.ExpectedPartSize(2); got != 10 {
+		t.Errorf("ExpectedPartSize(2) of an exact multiple = %d, want 10", got)
+	}
+}
@@ -0,0 +1,186 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"slices"
+	"strings"
+	"time"
+)
+
+// how long a claimed deletion is hidden from other replicas, a crashed run is retried after it
+const mediaCleanupLease = 5 * time.Minute
+
+// RunMediaCleanup removes the objects of deleted vods, and the segments the edits left unused, until the context is cancelled,
+// a failed deletion is retried with exponential backoff and the vod row is only deleted once its media is gone
+func (s *VODService) RunMediaCleanup(ctx context.Context) {
+	interval := time.Duration(s.cleanupConfig.Interval) * time.Second
+	logger.Infof(ctx, "vod media cleanup started, running every %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.cleanupDueDeletions(ctx)
+		s.collectDueMedia(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "vod media cleanup stopped")
+			return
+		case <-ticker.C:
+		case <-s.cleanupTrigger:
+		}
+	}
+}
+
+func (s *VODService) cleanupDueDeletions(ctx context.Context) {
+	deletions, err := s.vodDeletionRepo.ClaimDue(ctx, s.cleanupConfig.BatchSize, mediaCleanupLease)
+	if err != nil {
+		logger.Errorf(ctx, "failed to claim due vod deletions: %s", err.Message)
+		return
+	}
+
+	for _, deletion := range deletions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if cleanupErr := s.deleteMedia(ctx, deletion); cleanupErr != nil {
+			nextAttemptAt := time.Now().Add(s.cleanupBackoff(deletion.Attempts))
+			logger.Warnf(ctx, "failed to clean up media of vod %s (attempt %d), retrying at %s: %v", deletion.VodId, deletion.Attempts, nextAttemptAt, cleanupErr)
+			if err := s.vodDeletionRepo.RecordFailure(ctx, deletion.VodId, cleanupErr.Error(), nextAttemptAt); err != nil {
+				logger.Errorf(ctx, "failed to record vod deletion failure: %s", err.Message)
+			}
+			continue
+		}
+
+		// the transcode jobs, comments and the deletion itself go with the row
+		if err := s.vodRepo.Delete(ctx, deletion.VodId); err != nil {
+			logger.Errorf(ctx, "failed to delete vod %s after cleaning its media: %s", deletion.VodId, err.Message)
+			continue
+		}
+
+		logger.Infof(ctx, "deleted vod %s and its media", deletion.VodId)
+	}
+}
+
+func (s *VODService) deleteMedia(ctx context.Context, deletion domains.VODDeletion) error {
+	vodId := deletion.VodId.String()
+
+	// the uploaded file, only left when the transcode did not finish,
+	// and the parts of an upload that was never completed
+	rawPrefix := fmt.Sprintf("raw-videos/%s/", vodId)
+	if err := s.minioStorage.AbortIncompleteUploads(ctx, rawPrefix); err != nil {
+		return err
+	}
+	if err := s.minioStorage.DeleteFolder(ctx, s.minioStorage.BucketName(), rawPrefix); err != nil {
+		return err
+	}
+
+	// custom thumbnails uploaded by the owner
+	if err := s.minioStorage.DeleteFolder(ctx, s.minioStorage.BucketName(), thumbnailPrefix(deletion.VodId)); err != nil {
+		return err
+	}
+
+	// uploaded vods are transcoded under their own id, livestream vods under the livestream id,
+	// the vods split from another one reference the segments of its folder
+	folder := deletion.MediaFolder()
+	prefixes := []string{vodId}
+	if deletion.LivestreamId != nil {
+		prefixes = append(prefixes, deletion.LivestreamId.String())
+	}
+	if !slices.Contains(prefixes, folder) {
+		prefixes = append(prefixes, folder)
+	}
+
+	bucket := s.vodBucketName
+	if len(bucket) == 0 {
+		for _, rawURL := range []*string{deletion.PlaybackURL, deletion.ThumbnailURL} {
+			if rawURL != nil {
+				if bucket = bucketFromObjectURL(*rawURL, prefixes); len(bucket) > 0 {
+					break
+				}
+			}
+		}
+	}
+
+	if len(bucket) == 0 {
+		if deletion.PlaybackURL != nil {
+			return fmt.Errorf("can not find the bucket of playback url %s, set minio.vodBucketName", *deletion.PlaybackURL)
+		}
+		// never transcoded, nothing was written outside the raw upload
+		return nil
+	}
+
+	sharingVods, err := s.vodRepo.GetByMediaFolder(ctx, folder)
+	if err != nil {
+		return fmt.Errorf("failed to get the vods of media folder %s: %s", folder, err.Message)
+	}
+
+	for _, prefix := range prefixes {
+		if prefix == folder && len(sharingVods) > 0 {
+			continue
+		}
+		if err := s.minioStorage.DeleteFolder(ctx, bucket, prefix+"/"); err != nil {
+			return err
+		}
+	}
+
+	// the other vods of the folder still play its segments, only the ones they do not reference go
+	if len(sharingVods) > 0 {
+		return s.collectUnusedMedia(ctx, bucket, folder)
+	}
+
+	return nil
+}
+
+func (s *VODService) cleanupBackoff(attempts int) time.Duration {
+	maxBackoff := time.Duration(s.cleanupConfig.MaxBackoff) * time.Second
+	backoff := time.Duration(s.cleanupConfig.Interval) * time.Second
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
+
+// bucketFromObjectURL finds the bucket in urls shaped like {returnURL}/{bucket}/{prefix}/...,
+// the segment before the first known prefix is the bucket
+func bucketFromObjectURL(rawURL string, prefixes []string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		for _, prefix := range prefixes {
+			if segments[i] == prefix {
+				return segments[i-1]
+			}
+		}
+	}
+
+	return ""
+}
+
+// objectKeyFromURL returns the name of the object in urls shaped like {returnURL}/{bucket}/{name},
+// empty when the url is not in the bucket
+func objectKeyFromURL(rawURL string, bucket string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	// the return url may have a path of its own, the bucket is the first segment matching
+	objectPath := "/" + strings.TrimPrefix(parsed.Path, "/")
+	idx := strings.Index(objectPath, "/"+bucket+"/")
+	if idx < 0 {
+		return ""
+	}
+	return objectPath[idx+len(bucket)+2:]
+}
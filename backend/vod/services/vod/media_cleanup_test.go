@@ -0,0 +1,70 @@
+package vod
+
+import (
+	"sen1or/letslive/vod/config"
+	"testing"
+	"time"
+)
+
+func TestBucketFromObjectURL(t *testing.T) {
+	prefixes := []string{"7d4c0b1e-vod", "a1b2c3d4-stream"}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"http://localhost:9000/vods/7d4c0b1e-vod/index.m3u8", "vods"},
+		{"https://cdn.example.com/static/vods/a1b2c3d4-stream/index.m3u8", "vods"},
+		{"http://localhost:9000/vods/7d4c0b1e-vod/0/segment_001.ts", "vods"},
+		{"http://localhost:9000/general-files/thumbnails/someone-else.jpg", ""},
+		{"http://localhost:9000/7d4c0b1e-vod/index.m3u8", ""},
+		{"::not a url", ""},
+	}
+
+	for _, test := range tests {
+		if got := bucketFromObjectURL(test.url, prefixes); got != test.want {
+			t.Errorf("bucketFromObjectURL(%q) = %q, want %q", test.url, got, test.want)
+		}
+	}
+}
+
+func TestCleanupBackoff(t *testing.T) {
+	s := &VODService{cleanupConfig: config.MediaCleanup{Interval: 30, MaxBackoff: 300}}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, 60 * time.Second},
+		{3, 120 * time.Second},
+		{4, 240 * time.Second},
+		{5, 300 * time.Second},
+		{50, 300 * time.Second},
+	}
+
+	for _, test := range tests {
+		if got := s.cleanupBackoff(test.attempts); got != test.want {
+			t.Errorf("cleanupBackoff(%d) = %s, want %s", test.attempts, got, test.want)
+		}
+	}
+}
+
+func TestObjectKeyFromURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"http://localhost:9000/vods/7d4c0b1e-vod/0/segment_001.ts", "7d4c0b1e-vod/0/segment_001.ts"},
+		{"https://cdn.example.com/static/vods/a1b2c3d4-stream/edits/e1/index.m3u8", "a1b2c3d4-stream/edits/e1/index.m3u8"},
+		{"http://localhost:9000/myvods/7d4c0b1e-vod/index.m3u8", ""},
+		{"http://localhost:8000/transcode/vods-live/a1b2c3d4-stream/index.m3u8", ""},
+		{"::not a url", ""},
+	}
+
+	for _, test := range tests {
+		if got := objectKeyFromURL(test.url, "vods"); got != test.want {
+			t.Errorf("objectKeyFromURL(%q) = %q, want %q", test.url, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sen1or/letslive/shared/pkg/hls"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"strings"
+	"time"
+)
+
+// collectDueMedia removes the segments the trims and splits left unused once their collection is due
+func (s *VODService) collectDueMedia(ctx context.Context) {
+	collections, err := s.vodEditRepo.ClaimDueCollections(ctx, s.cleanupConfig.BatchSize, mediaCleanupLease)
+	if err != nil {
+		logger.Errorf(ctx, "failed to claim due vod media collections: %s", err.Message)
+		return
+	}
+
+	for _, collection := range collections {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if collectErr := s.collectUnusedMedia(ctx, collection.Bucket, collection.MediaPrefix); collectErr != nil {
+			nextAttemptAt := time.Now().Add(s.cleanupBackoff(collection.Attempts))
+			logger.Warnf(ctx, "failed to collect media folder %s (attempt %d), retrying at %s: %v", collection.MediaPrefix, collection.Attempts, nextAttemptAt, collectErr)
+			if err := s.vodEditRepo.RecordCollectionFailure(ctx, collection.MediaPrefix, collectErr.Error(), nextAttemptAt); err != nil {
+				logger.Errorf(ctx, "failed to record vod media collection failure: %s", err.Message)
+			}
+			continue
+		}
+
+		if err := s.vodEditRepo.CompleteCollection(ctx, collection.MediaPrefix, collection.ScheduledAt); err != nil {
+			logger.Errorf(ctx, "failed to complete the collection of media folder %s: %s", collection.MediaPrefix, err.Message)
+		}
+	}
+}
+
+// collectUnusedMedia removes the segments of the media folder no playlist of its vods references anymore,
+// and the edited playlists they no longer play. Nothing is removed unless every playlist could be read
+func (s *VODService) collectUnusedMedia(ctx context.Context, bucket string, folder string) error {
+	vods, err := s.vodRepo.GetByMediaFolder(ctx, folder)
+	if err != nil {
+		return fmt.Errorf("failed to get the vods of media folder %s: %s", folder, err.Message)
+	}
+	// the vods of the folder were all deleted, their cleanup removes the whole folder
+	if len(vods) == 0 {
+		return nil
+	}
+
+	usedSegments := make(map[string]bool)
+	var usedPlaylistDirs []string
+	for _, vod := range vods {
+		if vod.Status == domains.VODStatusUploading || vod.Status == domains.VODStatusProcessing {
+			return fmt.Errorf("vod %s of the folder is still %s", vod.Id, vod.Status)
+		}
+		if vod.PlaybackURL == nil {
+			continue
+		}
+
+		renditions, err := hls.FetchRenditions(ctx, *vod.PlaybackURL)
+		if err != nil {
+			return fmt.Errorf("failed to read the playlists of vod %s: %w", vod.Id, err)
+		}
+		for _, rendition := range renditions {
+			for _, segment := range rendition.Segments {
+				usedSegments[objectKeyFromURL(segment.URL, bucket)] = true
+			}
+		}
+
+		if key := objectKeyFromURL(*vod.PlaybackURL, bucket); strings.HasPrefix(key, folder+"/edits/") {
+			usedPlaylistDirs = append(usedPlaylistDirs, path.Dir(key)+"/")
+		}
+	}
+
+	objects, listErr := s.minioStorage.ListObjects(ctx, bucket, folder+"/")
+	if listErr != nil {
+		return listErr
+	}
+
+	var unused []string
+	for _, object := range objects {
+		if strings.HasPrefix(object, folder+"/edits/") {
+			if !hasAnyPrefix(object, usedPlaylistDirs) {
+				unused = append(unused, object)
+			}
+			continue
+		}
+		// the playlists, thumbnails and previews of the folder stay until its last vod is deleted
+		if path.Ext(object) == ".ts" && !usedSegments[object] {
+			unused = append(unused, object)
+		}
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+
+	logger.Infof(ctx, "removing %d unused objects of media folder %s", len(unused), folder)
+	return s.minioStorage.RemoveObjects(ctx, bucket, unused)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package vod
+
+import (
+	"context"
+	"errors"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/config"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"slices"
+	"time"
+)
+
+// probeRawFile reads the uploaded raw file and checks it against the configured limits,
+// the file is left in storage whatever the outcome
+func (s *VODService) probeRawFile(ctx context.Context, objectName string) (*domains.VODMediaInfo, *response.Response[any]) {
+	probeTimeout := time.Duration(s.probeConfig.Timeout) * time.Second
+
+	url, err := s.minioStorage.PresignedGetURL(ctx, objectName, probeTimeout+time.Minute)
+	if err != nil {
+		logger.Errorf(ctx, "failed to get a url to probe %s: %v", objectName, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	info, err := s.mediaProber.Probe(ctx, url)
+	if err != nil {
+		if errors.Is(err, domains.ErrUnreadableMedia) {
+			logger.Infof(ctx, "rejected unreadable upload %s: %v", objectName, err)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_UNREADABLE_MEDIA,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		logger.Errorf(ctx, "failed to probe %s: %v", objectName, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := validateMediaInfo(*info, s.probeConfig); err != nil {
+		logger.Infof(ctx, "rejected upload %s (%s %dx%d, %s): %s", objectName, info.VideoCodec, info.Width, info.Height, info.Duration, err.Message)
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// validateMediaInfo checks the probed file against the limits, the resolution limit is applied to the longer
+// and the shorter side so portrait videos get the same limit as landscape ones
+func validateMediaInfo(info domains.VODMediaInfo, limits config.MediaProbe) *response.Response[any] {
+	if !slices.Contains(limits.VideoCodecs, info.VideoCodec) {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UNSUPPORTED_CODEC,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	// an unknown duration is only found after transcoding
+	if info.Duration > time.Duration(limits.MaxDuration)*time.Second {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_DURATION_TOO_LONG,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	longSide, shortSide := max(info.Width, info.Height), min(info.Width, info.Height)
+	maxLongSide, maxShortSide := max(limits.MaxWidth, limits.MaxHeight), min(limits.MaxWidth, limits.MaxHeight)
+	if longSide > maxLongSide || shortSide > maxShortSide {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_RESOLUTION_TOO_HIGH,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
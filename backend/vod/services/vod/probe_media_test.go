@@ -0,0 +1,48 @@
+package vod
+
+import (
+	"sen1or/letslive/vod/config"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"testing"
+	"time"
+)
+
+func TestValidateMediaInfo(t *testing.T) {
+	limits := config.MediaProbe{
+		MaxDuration: 3600,
+		MaxWidth:    1920,
+		MaxHeight:   1080,
+		VideoCodecs: []string{"h264", "vp9"},
+	}
+
+	media := func(codec string, width, height int, duration time.Duration) domains.VODMediaInfo {
+		return domains.VODMediaInfo{VideoCodec: codec, Width: width, Height: height, Duration: duration}
+	}
+
+	tests := []struct {
+		name     string
+		info     domains.VODMediaInfo
+		wantCode int // 0 when the file is accepted
+	}{
+		{"landscape within limits", media("h264", 1920, 1080, time.Hour), 0},
+		{"portrait within limits", media("vp9", 1080, 1920, time.Minute), 0},
+		{"unknown duration", media("h264", 1280, 720, 0), 0},
+		{"unsupported codec", media("prores", 1280, 720, time.Minute), response.RES_ERR_VOD_UNSUPPORTED_CODEC_CODE},
+		{"too long", media("h264", 1280, 720, time.Hour+time.Second), response.RES_ERR_VOD_DURATION_TOO_LONG_CODE},
+		{"too wide", media("h264", 3840, 1080, time.Minute), response.RES_ERR_VOD_RESOLUTION_TOO_HIGH_CODE},
+		{"square above the short side", media("h264", 1200, 1200, time.Minute), response.RES_ERR_VOD_RESOLUTION_TOO_HIGH_CODE},
+	}
+
+	for _, test := range tests {
+		err := validateMediaInfo(test.info, limits)
+		switch {
+		case test.wantCode == 0 && err != nil:
+			t.Errorf("%s: validateMediaInfo() = %d, want nil", test.name, err.Code)
+		case test.wantCode != 0 && err == nil:
+			t.Errorf("%s: validateMediaInfo() = nil, want %d", test.name, test.wantCode)
+		case test.wantCode != 0 && err.Code != test.wantCode:
+			t.Errorf("%s: validateMediaInfo() = %d, want %d", test.name, err.Code, test.wantCode)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package vod
+
+import (
+	"context"
+	"sen1or/letslive/shared/pkg/search"
+	"sen1or/letslive/vod/domains"
+	response "sen1or/letslive/vod/response"
+)
+
+// SearchVODs returns a page of the public ready vods matching the query, stemmed in the language
+func (s *VODService) SearchVODs(ctx context.Context, query string, language string, page int, limit int) ([]domains.VOD, *response.Response[any]) {
+	query, ok := search.NormalizeQuery(query)
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	textSearchLanguage, ok := search.ParseLanguage(language)
+	if !ok {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_INPUT, nil, nil, nil)
+	}
+
+	return s.vodRepo.Search(ctx, query, textSearchLanguage, page, limit)
+}
@@ -0,0 +1,200 @@
+package vod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"io"
+	"net/http"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"sen1or/letslive/vod/utils"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// the smallest custom thumbnail accepted, on its longer and shorter side
+const (
+	minThumbnailLongSide  = 320
+	minThumbnailShortSide = 180
+)
+
+var allowedThumbnailTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// GetThumbnails returns the thumbnail of the vod and the candidates taken by the transcode to its owner
+func (s *VODService) GetThumbnails(ctx context.Context, vodId uuid.UUID, userId uuid.UUID) (*dto.VODThumbnailsResponseDTO, *response.Response[any]) {
+	vod, err := s.getOwnedVOD(ctx, vodId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := vod.ThumbnailCandidates
+	if candidates == nil {
+		candidates = []string{}
+	}
+
+	return &dto.VODThumbnailsResponseDTO{
+		ThumbnailUrl: vod.ThumbnailURL,
+		Candidates:   candidates,
+	}, nil
+}
+
+// SelectThumbnail sets one of the candidates as the thumbnail of the vod
+func (s *VODService) SelectThumbnail(ctx context.Context, vodId uuid.UUID, userId uuid.UUID, data dto.SelectVODThumbnailRequestDTO) (*domains.VOD, *response.Response[any]) {
+	if err := utils.Validator.Struct(&data); err != nil {
+		return nil, response.NewResponseFromTemplate[any](response.RES_ERR_INVALID_PAYLOAD, nil, nil, nil)
+	}
+
+	vod, err := s.getOwnedVOD(ctx, vodId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := *data.CandidateIndex
+	if index >= len(vod.ThumbnailCandidates) {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_THUMBNAIL_NOT_FOUND,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.vodRepo.UpdateThumbnail(ctx, vodId, vod.ThumbnailCandidates[index])
+}
+
+// UploadThumbnail validates the image, scales it down to the configured size and sets it as the thumbnail of the vod
+func (s *VODService) UploadThumbnail(ctx context.Context, vodId uuid.UUID, userId uuid.UUID, imageReader io.Reader) (*domains.VOD, *response.Response[any]) {
+	if _, err := s.getOwnedVOD(ctx, vodId, userId); err != nil {
+		return nil, err
+	}
+
+	original, readErr := io.ReadAll(io.LimitReader(imageReader, s.thumbnailConfig.MaxFileSize+1))
+	if readErr != nil {
+		logger.Errorf(ctx, "failed to read thumbnail of vod %s: %v", vodId, readErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INVALID_PAYLOAD,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if int64(len(original)) > s.thumbnailConfig.MaxFileSize {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_THUMBNAIL_TOO_LARGE,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	// the content is checked rather than the filename or the content type sent by the client
+	if !allowedThumbnailTypes[http.DetectContentType(original)] {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_INVALID_THUMBNAIL,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	resized, resizeErr := s.imageResizer.ResizeToJPEG(ctx, original, s.thumbnailConfig.MaxWidth, s.thumbnailConfig.MaxHeight)
+	if resizeErr != nil {
+		if errors.Is(resizeErr, domains.ErrUnreadableImage) {
+			logger.Infof(ctx, "rejected thumbnail of vod %s: %v", vodId, resizeErr)
+			return nil, response.NewResponseFromTemplate[any](
+				response.RES_ERR_VOD_INVALID_THUMBNAIL,
+				nil,
+				nil,
+				nil,
+			)
+		}
+
+		logger.Errorf(ctx, "failed to resize thumbnail of vod %s: %v", vodId, resizeErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := checkThumbnailSize(resized); err != nil {
+		return nil, err
+	}
+
+	// every upload gets its own name so players and caches never show a stale image
+	thumbnailId, uuidErr := uuid.NewV4()
+	if uuidErr != nil {
+		logger.Errorf(ctx, "failed to generate uuid: %v", uuidErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	objectName := fmt.Sprintf("%s%s.jpg", thumbnailPrefix(vodId), thumbnailId)
+	thumbnailURL, uploadErr := s.minioStorage.UploadFile(ctx, objectName, bytes.NewReader(resized), int64(len(resized)), "image/jpeg")
+	if uploadErr != nil {
+		logger.Errorf(ctx, "failed to upload thumbnail of vod %s: %v", vodId, uploadErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return s.vodRepo.UpdateThumbnail(ctx, vodId, thumbnailURL)
+}
+
+// checkThumbnailSize rejects images that are too small to be shown as a thumbnail, portrait ones included
+func checkThumbnailSize(jpeg []byte) *response.Response[any] {
+	config, _, err := image.DecodeConfig(bytes.NewReader(jpeg))
+	if err != nil ||
+		max(config.Width, config.Height) < minThumbnailLongSide ||
+		min(config.Width, config.Height) < minThumbnailShortSide {
+		return response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_INVALID_THUMBNAIL,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// thumbnailPrefix is the folder of the custom thumbnails of a vod in the uploads bucket
+func thumbnailPrefix(vodId uuid.UUID) string {
+	return fmt.Sprintf("thumbnails/%s/", vodId)
+}
+
+func (s *VODService) getOwnedVOD(ctx context.Context, vodId uuid.UUID, userId uuid.UUID) (*domains.VOD, *response.Response[any]) {
+	vod, err := s.vodRepo.GetById(ctx, vodId)
+	if err != nil {
+		return nil, err
+	}
+
+	if vod.UserId != userId {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_FORBIDDEN,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	return vod, nil
+}
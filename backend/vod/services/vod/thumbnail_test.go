@@ -0,0 +1,37 @@
+package vod
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"testing"
+)
+
+func TestCheckThumbnailSize(t *testing.T) {
+	encode := func(width, height int) []byte {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil); err != nil {
+			t.Fatalf("failed to encode %dx%d jpeg: %v", width, height, err)
+		}
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name   string
+		image  []byte
+		accept bool
+	}{
+		{"landscape", encode(1280, 720), true},
+		{"smallest landscape", encode(320, 180), true},
+		{"smallest portrait", encode(180, 320), true},
+		{"too narrow", encode(319, 180), false},
+		{"too short", encode(320, 179), false},
+		{"not an image", []byte("not an image"), false},
+	}
+
+	for _, test := range tests {
+		if err := checkThumbnailSize(test.image); (err == nil) != test.accept {
+			t.Errorf("%s: checkThumbnailSize() accepted = %v, want %v", test.name, err == nil, test.accept)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package vod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/response"
+	"time"
+)
+
+// how long a claimed expired upload is hidden from other replicas, a crashed run is retried after it
+const uploadCleanupLease = 5 * time.Minute
+
+// RunUploadCleanup aborts the uploads that expired before being completed until the context is cancelled,
+// the parts are dropped from storage and the vod that was waiting for them is deleted
+func (s *VODService) RunUploadCleanup(ctx context.Context) {
+	interval := time.Duration(s.uploadConfig.CleanupInterval) * time.Second
+	logger.Infof(ctx, "vod upload cleanup started, running every %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.cleanupExpiredUploads(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "vod upload cleanup stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *VODService) cleanupExpiredUploads(ctx context.Context) {
+	uploads, err := s.vodUploadRepo.ClaimExpired(ctx, s.uploadConfig.CleanupBatchSize, uploadCleanupLease)
+	if err != nil {
+		logger.Errorf(ctx, "failed to claim expired vod uploads: %s", err.Message)
+		return
+	}
+
+	for _, upload := range uploads {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// a failed removal is retried once the lease is over
+		if removeErr := s.removeUpload(ctx, upload); removeErr != nil {
+			logger.Warnf(ctx, "failed to remove expired upload %s of vod %s: %v", upload.Id, upload.VodId, removeErr)
+			continue
+		}
+
+		logger.Infof(ctx, "removed expired upload %s of vod %s", upload.Id, upload.VodId)
+	}
+}
+
+// removeUpload drops the parts and the file of an upload, then the vod with the upload row,
+// the file only exists when the upload was assembled but the transcode job could not be queued
+func (s *VODService) removeUpload(ctx context.Context, upload domains.VODUpload) error {
+	if err := s.minioStorage.AbortMultipartUpload(ctx, upload.ObjectName, upload.MultipartUploadId); err != nil {
+		return err
+	}
+
+	if err := s.minioStorage.DeleteFile(ctx, upload.ObjectName); err != nil {
+		return fmt.Errorf("failed to remove %s: %v", upload.ObjectName, err)
+	}
+
+	if err := s.vodRepo.Delete(ctx, upload.VodId); err != nil && err.Code != response.RES_ERR_VOD_NOT_FOUND_CODE {
+		return errors.New(err.Message)
+	}
+
+	return nil
+}
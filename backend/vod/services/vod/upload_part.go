@@ -0,0 +1,64 @@
+package vod
+
+import (
+	"context"
+	"io"
+	"sen1or/letslive/shared/pkg/logger"
+	"sen1or/letslive/vod/domains"
+	"sen1or/letslive/vod/dto"
+	"sen1or/letslive/vod/response"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// UploadPart stores one part of an upload, the part must have exactly its expected size
+// and sending a part again replaces it, every part pushes the expiry of the upload
+func (s *VODService) UploadPart(
+	ctx context.Context,
+	uploadId uuid.UUID,
+	userId uuid.UUID,
+	partNumber int,
+	partSize int64,
+	reader io.Reader,
+) (*dto.VODUploadResponseDTO, *response.Response[any]) {
+	upload, err := s.getOwnedUpload(ctx, uploadId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUploadWritable(*upload); err != nil {
+		return nil, err
+	}
+
+	if expectedSize := upload.ExpectedPartSize(partNumber); expectedSize == 0 || partSize != expectedSize {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_UPLOAD_INVALID_PART,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	etag, uploadErr := s.minioStorage.UploadPart(ctx, upload.ObjectName, upload.MultipartUploadId, partNumber, reader, partSize)
+	if uploadErr != nil {
+		logger.Errorf(ctx, "failed to upload part %d of upload %s: %v", partNumber, uploadId, uploadErr)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
+	if err := s.vodUploadRepo.SavePart(ctx, domains.VODUploadPart{
+		UploadId:   uploadId,
+		PartNumber: partNumber,
+		ETag:       etag,
+		Size:       partSize,
+	}, time.Now().Add(s.uploadExpiry())); err != nil {
+		return nil, err
+	}
+
+	return s.GetUpload(ctx, uploadId, userId)
+}
@@ -22,6 +22,8 @@ var allowedVideoExtensions = map[string]bool{
 	".webm": true,
 }
 
+const transcodeJobMaxAttempts = 3
+
 func (s *VODService) UploadVOD(
 	ctx context.Context,
 	userId uuid.UUID,
@@ -68,6 +70,15 @@ func (s *VODService) UploadVOD(
 		)
 	}
 
+	// Reject files that would only fail once transcoded
+	mediaInfo, probeErr := s.probeRawFile(ctx, objectName)
+	if probeErr != nil {
+		if err := s.minioStorage.DeleteFile(ctx, objectName); err != nil {
+			logger.Errorf(ctx, "failed to remove rejected raw video %s: %v", objectName, err)
+		}
+		return nil, probeErr
+	}
+
 	// Determine visibility
 	vodVisibility := domains.VODPublicVisibility
 	if visibility == "private" {
@@ -88,6 +99,7 @@ func (s *VODService) UploadVOD(
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
+	vodData.ApplyMediaInfo(*mediaInfo)
 
 	createdVOD, createErr := s.vodRepo.Create(ctx, vodData)
 	if createErr != nil {
@@ -99,7 +111,7 @@ func (s *VODService) UploadVOD(
 		VodId:       createdVOD.Id,
 		Status:      domains.TranscodeJobPending,
 		Attempts:    0,
-		MaxAttempts: 3,
+		MaxAttempts: transcodeJobMaxAttempts,
 	}
 
 	_, jobErr := s.transcodeJobRepo.Create(ctx, transcodeJob)
@@ -110,6 +122,7 @@ func (s *VODService) UploadVOD(
 		s.vodRepo.Update(ctx, *createdVOD)
 		return nil, jobErr
 	}
+	s.jobNotifier.NotifyQueued(ctx, createdVOD.Id)
 
 	return createdVOD, nil
 }
@@ -1,20 +1,71 @@
 package vod
 
 import (
+	"sen1or/letslive/vod/config"
 	"sen1or/letslive/vod/domains"
+	usergateway "sen1or/letslive/vod/gateway/user"
 	miniostorage "sen1or/letslive/vod/storage/minio"
 )
 
 type VODService struct {
 	vodRepo          domains.VODRepository
 	transcodeJobRepo domains.TranscodeJobRepository
+	vodDeletionRepo  domains.VODDeletionRepository
+	vodUploadRepo    domains.VODUploadRepository
+	vodEditRepo      domains.VODEditRepository
+	vodChapterRepo   domains.VODChapterRepository
 	minioStorage     *miniostorage.MinIOStorage
+	jobNotifier      domains.TranscodeJobNotifier
+	mediaProber      domains.MediaProber
+	imageResizer     domains.ImageResizer
+	userGateway      usergateway.UserGateway
+
+	cleanupConfig   config.MediaCleanup
+	uploadConfig    config.Upload
+	probeConfig     config.MediaProbe
+	thumbnailConfig config.Thumbnail
+	editConfig      config.VODEdit
+	vodBucketName   string
+	cleanupTrigger  chan struct{}
 }
 
-func NewVODService(vodRepo domains.VODRepository, transcodeJobRepo domains.TranscodeJobRepository, minioStorage *miniostorage.MinIOStorage) *VODService {
+func NewVODService(
+	vodRepo domains.VODRepository,
+	transcodeJobRepo domains.TranscodeJobRepository,
+	vodDeletionRepo domains.VODDeletionRepository,
+	vodUploadRepo domains.VODUploadRepository,
+	vodEditRepo domains.VODEditRepository,
+	vodChapterRepo domains.VODChapterRepository,
+	minioStorage *miniostorage.MinIOStorage,
+	jobNotifier domains.TranscodeJobNotifier,
+	mediaProber domains.MediaProber,
+	imageResizer domains.ImageResizer,
+	userGateway usergateway.UserGateway,
+	cleanupConfig config.MediaCleanup,
+	uploadConfig config.Upload,
+	probeConfig config.MediaProbe,
+	thumbnailConfig config.Thumbnail,
+	editConfig config.VODEdit,
+	vodBucketName string,
+) *VODService {
 	return &VODService{
 		vodRepo:          vodRepo,
 		transcodeJobRepo: transcodeJobRepo,
+		vodDeletionRepo:  vodDeletionRepo,
+		vodUploadRepo:    vodUploadRepo,
+		vodEditRepo:      vodEditRepo,
+		vodChapterRepo:   vodChapterRepo,
 		minioStorage:     minioStorage,
+		jobNotifier:      jobNotifier,
+		mediaProber:      mediaProber,
+		imageResizer:     imageResizer,
+		userGateway:      userGateway,
+		cleanupConfig:    cleanupConfig,
+		uploadConfig:     uploadConfig,
+		probeConfig:      probeConfig,
+		thumbnailConfig:  thumbnailConfig,
+		editConfig:       editConfig,
+		vodBucketName:    vodBucketName,
+		cleanupTrigger:   make(chan struct{}, 1),
 	}
 }
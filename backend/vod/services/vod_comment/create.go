@@ -17,11 +17,31 @@ func (s *VODCommentService) CreateComment(ctx context.Context, data dto.CreateVO
 	}
 
 	// verify VOD exists
-	_, vodErr := s.vodRepo.GetById(ctx, vodId)
+	vod, vodErr := s.vodRepo.GetById(ctx, vodId)
 	if vodErr != nil {
 		return nil, vodErr
 	}
 
+	// the creator may have blocked the commenter, the comment is rejected when that can not be checked
+	blocked, err := s.userGateway.IsBlocked(ctx, vod.UserId, userId)
+	if err != nil {
+		logger.Errorf(ctx, "failed to check if user %s is blocked by %s: %v", userId, vod.UserId, err)
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_INTERNAL_SERVER,
+			nil,
+			nil,
+			nil,
+		)
+	}
+	if blocked {
+		return nil, response.NewResponseFromTemplate[any](
+			response.RES_ERR_VOD_COMMENT_BLOCKED,
+			nil,
+			nil,
+			nil,
+		)
+	}
+
 	comment := domains.VODComment{
 		VODId:   vodId,
 		UserId:  userId,
@@ -1,12 +1,14 @@
 package minio
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"os"
 	"sen1or/letslive/vod/config"
 	"sen1or/letslive/shared/pkg/logger"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -84,3 +86,170 @@ func (s *MinIOStorage) DeleteFile(ctx context.Context, objectName string) error
 func (s *MinIOStorage) GetFile(ctx context.Context, objectName string) (*minio.Object, error) {
 	return s.client.GetObject(ctx, s.config.BucketName, objectName, minio.GetObjectOptions{})
 }
+
+// PresignedGetURL returns a url to read the object from the service network for the given time,
+// unlike the url returned on upload it does not go through the public host
+func (s *MinIOStorage) PresignedGetURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	url, err := s.client.PresignedGetObject(ctx, s.config.BucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign %s: %v", objectName, err)
+	}
+
+	return url.String(), nil
+}
+
+// BucketName returns the bucket uploads are stored in
+func (s *MinIOStorage) BucketName() string {
+	return s.config.BucketName
+}
+
+// DeleteFolder removes every object under the prefix in the given bucket,
+// removing a prefix with no objects is not an error so the call can be retried safely
+func (s *MinIOStorage) DeleteFolder(ctx context.Context, bucketName string, prefix string) error {
+	if len(prefix) == 0 || prefix == "/" {
+		return fmt.Errorf("refusing to delete the whole bucket %s", bucketName)
+	}
+
+	objectsCh := make(chan minio.ObjectInfo)
+	listErrCh := make(chan error, 1)
+	go func() {
+		defer close(objectsCh)
+		for object := range s.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if object.Err != nil {
+				listErrCh <- object.Err
+				return
+			}
+			objectsCh <- object
+		}
+	}()
+
+	var firstErr error
+	for removeErr := range s.client.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("failed to remove object %s: %v", removeErr.ObjectName, removeErr.Err)
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+
+	select {
+	case err := <-listErrCh:
+		return fmt.Errorf("failed to list objects under %s: %v", prefix, err)
+	default:
+		return nil
+	}
+}
+
+// PutObject uploads data as objectName in the given bucket and returns the object url
+func (s *MinIOStorage) PutObject(ctx context.Context, bucketName string, objectName string, data []byte, contentType string) (string, error) {
+	_, err := s.client.PutObject(ctx, bucketName, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %s to minio: %v", objectName, err)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", s.config.ReturnURL, bucketName, objectName), nil
+}
+
+// ListObjects returns the names of every object under the prefix in the given bucket
+func (s *MinIOStorage) ListObjects(ctx context.Context, bucketName string, prefix string) ([]string, error) {
+	var names []string
+	for object := range s.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("failed to list objects under %s: %v", prefix, object.Err)
+		}
+		names = append(names, object.Key)
+	}
+
+	return names, nil
+}
+
+// RemoveObjects removes the objects from the given bucket, removing a missing object is not an error
+func (s *MinIOStorage) RemoveObjects(ctx context.Context, bucketName string, objectNames []string) error {
+	objectsCh := make(chan minio.ObjectInfo, len(objectNames))
+	for _, name := range objectNames {
+		objectsCh <- minio.ObjectInfo{Key: name}
+	}
+	close(objectsCh)
+
+	var firstErr error
+	for removeErr := range s.client.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("failed to remove object %s: %v", removeErr.ObjectName, removeErr.Err)
+		}
+	}
+
+	return firstErr
+}
+
+// NewMultipartUpload starts a multipart upload of objectName in the uploads bucket and returns its id
+func (s *MinIOStorage) NewMultipartUpload(ctx context.Context, objectName string, contentType string) (string, error) {
+	core := minio.Core{Client: s.client}
+	uploadId, err := core.NewMultipartUpload(ctx, s.config.BucketName, objectName, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start multipart upload of %s: %v", objectName, err)
+	}
+
+	return uploadId, nil
+}
+
+// UploadPart uploads one part of a multipart upload and returns its etag
+func (s *MinIOStorage) UploadPart(ctx context.Context, objectName string, uploadId string, partNumber int, reader io.Reader, size int64) (string, error) {
+	core := minio.Core{Client: s.client}
+	part, err := core.PutObjectPart(ctx, s.config.BucketName, objectName, uploadId, partNumber, reader, size, minio.PutObjectPartOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d of %s: %v", partNumber, objectName, err)
+	}
+
+	return part.ETag, nil
+}
+
+// CompleteMultipartUpload assembles the parts, which must be sorted by part number, and returns the object url
+func (s *MinIOStorage) CompleteMultipartUpload(ctx context.Context, objectName string, uploadId string, parts []minio.CompletePart) (string, error) {
+	core := minio.Core{Client: s.client}
+	if _, err := core.CompleteMultipartUpload(ctx, s.config.BucketName, objectName, uploadId, parts, minio.PutObjectOptions{}); err != nil {
+		return "", fmt.Errorf("failed to complete multipart upload of %s: %v", objectName, err)
+	}
+
+	finalURL := fmt.Sprintf("%s/%s/%s", s.config.ReturnURL, s.config.BucketName, objectName)
+	return finalURL, nil
+}
+
+// AbortMultipartUpload drops the uploaded parts, aborting an upload that no longer exists is not an error
+func (s *MinIOStorage) AbortMultipartUpload(ctx context.Context, objectName string, uploadId string) error {
+	core := minio.Core{Client: s.client}
+	err := core.AbortMultipartUpload(ctx, s.config.BucketName, objectName, uploadId)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return fmt.Errorf("failed to abort multipart upload of %s: %v", objectName, err)
+	}
+
+	return nil
+}
+
+// AbortIncompleteUploads aborts every unfinished multipart upload under the prefix in the uploads bucket,
+// their parts are not listed as objects so DeleteFolder does not remove them
+func (s *MinIOStorage) AbortIncompleteUploads(ctx context.Context, prefix string) error {
+	if len(prefix) == 0 || prefix == "/" {
+		return fmt.Errorf("refusing to abort every upload of bucket %s", s.config.BucketName)
+	}
+
+	var firstErr error
+	for upload := range s.client.ListIncompleteUploads(ctx, s.config.BucketName, prefix, true) {
+		if upload.Err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to list incomplete uploads under %s: %v", prefix, upload.Err)
+			}
+			continue
+		}
+		if firstErr != nil {
+			continue
+		}
+		firstErr = s.AbortMultipartUpload(ctx, upload.Key, upload.UploadID)
+	}
+
+	return firstErr
+}
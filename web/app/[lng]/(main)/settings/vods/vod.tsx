@@ -15,9 +15,14 @@ import { Label } from "@/components/ui/label";
 import { Input } from "@/components/ui/input";
 import { Switch } from "@/components/ui/switch";
 import { Button } from "@/components/ui/button";
-import { DeleteVOD, UpdateVOD } from "@/lib/api/vod";
+import {
+    DeleteVOD,
+    GetVODThumbnails,
+    SelectVODThumbnail,
+    UpdateVOD,
+    UploadVODThumbnail,
+} from "@/lib/api/vod";
 import { toast } from "@/components/utils/toast";
-import { UploadFile } from "@/lib/api/utils";
 import GLOBAL from "@/global";
 import IconSave from "@/components/icons/save";
 import { VOD } from "@/types/vod";
@@ -44,6 +49,7 @@ export default function VODEditCard({
         thumbnailURL: string | null;
         image: File | undefined;
         selectedImage: string | undefined;
+        selectedCandidate: number | undefined;
         isPublic?: boolean;
     }>({
         title: vod.title,
@@ -53,10 +59,14 @@ export default function VODEditCard({
             : `${GLOBAL.API_URL}/files/livestreams/${vod.id}/thumbnail.jpeg`,
         image: undefined,
         selectedImage: undefined,
+        selectedCandidate: undefined,
         isPublic: vod.visibility === "public",
     });
 
     const [isSubmitting, setIsSubmitting] = useState(false);
+    const [thumbnailCandidates, setThumbnailCandidates] = useState<string[]>(
+        [],
+    );
     const selectedImageRef = useRef<string | null>(null);
 
     useEffect(() => {
@@ -75,6 +85,7 @@ export default function VODEditCard({
                 ...prev,
                 image: file,
                 selectedImage: imageUrl,
+                selectedCandidate: undefined,
             }));
         }
     };
@@ -88,9 +99,31 @@ export default function VODEditCard({
                 : `${GLOBAL.API_URL}/files/livestreams/${vod.id}/thumbnail.jpeg`,
             image: undefined,
             selectedImage: undefined,
+            selectedCandidate: undefined,
             isPublic: vod.visibility === "public",
         });
         setIsDialogOpen(true);
+
+        GetVODThumbnails(vod.id)
+            .then((res) => {
+                setThumbnailCandidates(
+                    res.success && res.data ? res.data.candidates : [],
+                );
+            })
+            .catch(() => setThumbnailCandidates([]));
+    };
+
+    const handleSelectCandidate = (index: number) => {
+        if (selectedImageRef.current) {
+            URL.revokeObjectURL(selectedImageRef.current);
+            selectedImageRef.current = null;
+        }
+        setFormData((prev) => ({
+            ...prev,
+            image: undefined,
+            selectedImage: thumbnailCandidates[index],
+            selectedCandidate: index,
+        }));
     };
 
     const handleDelete = () => {
@@ -118,23 +151,21 @@ export default function VODEditCard({
         setIsSubmitting(true);
         let newThumbnailPath: string | undefined;
 
-        if (formData.image) {
-            const res = await UploadFile(formData.image);
+        // the thumbnail is validated and resized by the vod service before the other fields are saved
+        if (formData.image || formData.selectedCandidate !== undefined) {
+            const res = formData.image
+                ? await UploadVODThumbnail(vod.id, formData.image)
+                : await SelectVODThumbnail(
+                      vod.id,
+                      formData.selectedCandidate as number,
+                  );
             if (!res.success) {
                 toast(t(`api-response:${res.key}`), { type: "error" });
                 setIsSubmitting(false);
                 return;
             }
 
-            if (!res.data?.newPath) {
-                toast(t("api-response:res_err_invalid_payload"), {
-                    type: "error",
-                });
-                setIsSubmitting(false);
-                return;
-            }
-
-            newThumbnailPath = res.data.newPath;
+            newThumbnailPath = res.data?.thumbnailUrl ?? undefined;
         }
 
         await UpdateVOD(
@@ -142,7 +173,6 @@ export default function VODEditCard({
             formData.title,
             formData.description,
             formData.isPublic ? "public" : "private",
-            newThumbnailPath,
         )
             .then((res) => {
                 if (!res.success) {
@@ -247,7 +277,7 @@ export default function VODEditCard({
                                     <input
                                         id="image-upload"
                                         type="file"
-                                        accept="image/*"
+                                        accept="image/jpeg,image/png,image/webp"
                                         onChange={handleImageChange}
                                         className="hidden"
                                     />
@@ -262,6 +292,26 @@ export default function VODEditCard({
                                     </div>
                                 </label>
                             </div>
+                            {thumbnailCandidates.length > 0 && (
+                                <div className="grid grid-cols-5 gap-2">
+                                    {thumbnailCandidates.map((candidate, index) => (
+                                        <button
+                                            key={candidate}
+                                            type="button"
+                                            title={t(
+                                                "settings:vods.edit_dialog.pick_thumbnail",
+                                            )}
+                                            onClick={() =>
+                                                handleSelectCandidate(index)
+                                            }
+                                            className={`aspect-video overflow-hidden rounded-md border-2 bg-cover bg-center ${formData.selectedCandidate === index ? "border-primary" : "border-transparent"}`}
+                                            style={{
+                                                backgroundImage: `url("${candidate}")`,
+                                            }}
+                                        />
+                                    ))}
+                                </div>
+                            )}
                         </div>
                         <div className="grid gap-2">
                             <Label htmlFor="title">
@@ -339,6 +339,29 @@ export default function ChatPanel({
                         );
                     }
                     const message = line.data;
+                    if (message.type === CHAT_MESSAGE_TYPE.DONATION) {
+                        return (
+                            <div
+                                key={idx}
+                                className="border-primary bg-primary/10 mb-3 rounded-md border px-3 py-2"
+                            >
+                                <span className="mr-2 font-semibold">
+                                    {message.username}
+                                </span>
+                                <span className="text-foreground">
+                                    {t("chat:tipped", {
+                                        amount: message.amount,
+                                        currencyCode: message.currencyCode,
+                                    })}
+                                </span>
+                                {message.text && (
+                                    <div className="text-foreground mt-1 text-sm">
+                                        {parseEmotes(message.text)}
+                                    </div>
+                                )}
+                            </div>
+                        );
+                    }
                     const isAction = message.type === CHAT_MESSAGE_TYPE.ACTION;
                     const displayText = message.text;
                     return (
@@ -19,6 +19,7 @@ import { VOD } from "@/types/vod";
 import { Livestream } from "@/types/livestream";
 import { GetPublicVODsOfUser } from "@/lib/api/vod";
 import useT from "@/hooks/use-translation";
+import useViewerHeartbeat from "@/hooks/use-viewer-heartbeat";
 
 export default function Livestreaming() {
     const { t } = useT(["common", "users", "fetch-error"]);
@@ -50,6 +51,8 @@ export default function Livestreaming() {
 
     const [timeVideoStart, setTimeVideoStart] = useState<Date>(new Date());
 
+    useViewerHeartbeat(livestream?.id ?? null);
+
     useEffect(() => {
         const fetchAll = async () => {
             try {
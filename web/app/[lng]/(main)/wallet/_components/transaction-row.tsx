@@ -21,6 +21,8 @@ const typeIcons: Record<TransactionType, string> = {
     [TransactionType.REFUND]: "&#8634;",
     [TransactionType.FEE]: "&#128176;",
     [TransactionType.ADJUSTMENT]: "&#9874;",
+    [TransactionType.SUBSCRIPTION]: "&#11088;",
+    [TransactionType.PAYOUT]: "&#128184;",
 };
 
 export default function TransactionRow({ transaction }: Props) {
@@ -12,6 +12,8 @@ const FILTER_OPTIONS: (TransactionType | "all")[] = [
     "all",
     TransactionType.DONATE,
     TransactionType.PURCHASE,
+    TransactionType.SUBSCRIPTION,
+    TransactionType.PAYOUT,
     TransactionType.REWARD,
     TransactionType.REFUND,
     TransactionType.TRADE,
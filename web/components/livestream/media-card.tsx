@@ -66,7 +66,7 @@ export default function MediaCard(props: MediaCardProps) {
     const id = isLive ? props.livestream.id : props.vod.id;
     const title = isLive ? props.livestream.title : props.vod.title;
     const viewCount = isLive
-        ? props.livestream.viewCount
+        ? props.livestream.viewerCount
         : props.vod.viewCount;
     const thumbnailUrl =
         (isLive ? props.livestream.thumbnailUrl : props.vod.thumbnailUrl) ??
@@ -181,7 +181,8 @@ export default function MediaCard(props: MediaCardProps) {
         }
         return (
             <span>
-                {viewCount} {viewCount < 2 ? "view" : "views"}
+                {viewCount}{" "}
+                {isLive ? "watching" : viewCount < 2 ? "view" : "views"}
             </span>
         );
     };
@@ -12,7 +12,7 @@ import { useVodsInfinite } from "@/hooks/queries/use-vods-infinite";
 export function VodFeedView() {
     const { data, isLoading, isFetchingNextPage, hasNextPage, fetchNextPage } =
         useVodsInfinite();
-    const vods = data?.pages.flat() ?? [];
+    const vods = data?.pages.flatMap((page) => page.vods) ?? [];
     const { t } = useT(["common"]);
     const sentinelRef = useRef<HTMLDivElement | null>(null);
 
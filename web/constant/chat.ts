@@ -3,4 +3,5 @@ export const CHAT_MESSAGE_TYPE = {
     JOIN: "join",
     LEAVE: "leave",
     ACTION: "action",
+    DONATION: "donation",
 } as const;
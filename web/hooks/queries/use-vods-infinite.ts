@@ -7,10 +7,14 @@ const VODS_PAGE_SIZE = 20;
 export function useVodsInfinite() {
     return useInfiniteQuery({
         queryKey: ["vods-feed"],
-        queryFn: async ({ pageParam }) =>
-            unwrapResponse(await GetPopularVODs(pageParam, VODS_PAGE_SIZE)),
-        initialPageParam: 0,
-        getNextPageParam: (lastPage, allPages) =>
-            lastPage.length > 0 ? allPages.length : undefined,
+        queryFn: async ({ pageParam }) => {
+            const res = await GetPopularVODs(pageParam, VODS_PAGE_SIZE);
+            return {
+                vods: unwrapResponse(res),
+                nextCursor: res.meta?.next_cursor,
+            };
+        },
+        initialPageParam: undefined as string | undefined,
+        getNextPageParam: (lastPage) => lastPage.nextCursor,
     });
 }
@@ -1,7 +1,17 @@
 import { create } from "zustand";
 import { ApiResponse } from "@/types/fetch-response";
-import { VOD } from "@/types/vod";
-import { uploadWithProgress, UploadClientError } from "@/utils/uploadClient";
+import { VOD, VODUpload } from "@/types/vod";
+import {
+    uploadWithProgress,
+    UploadClientError,
+    UPLOAD_ERROR_CODES,
+} from "@/utils/uploadClient";
+import {
+    AbortVODUpload,
+    CompleteVODUpload,
+    GetVODUpload,
+    InitiateVODUpload,
+} from "@/lib/api/vod";
 
 const MAX_CONCURRENT = 3;
 
@@ -36,6 +46,8 @@ export type UploadItem = {
     /** Client-side error code for i18n (settings:upload.<errorCode>) */
     errorCode?: string;
     vod?: VOD;
+    /** Server-side upload, kept so a retry resumes instead of starting over */
+    uploadId?: string;
     abort?: () => void;
 };
 
@@ -83,6 +95,9 @@ const useUploadStore = create<UploadState>((set, get) => ({
     cancel: (id) => {
         const item = get().items.find((i) => i.id === id);
         if (item?.abort) item.abort();
+        if (item?.uploadId && item.status !== UPLOAD_STATUS.COMPLETED) {
+            AbortVODUpload(item.uploadId).catch(() => {});
+        }
 
         set((state) => ({
             items: state.items.map((i) =>
@@ -155,102 +170,179 @@ function processQueue(
     }
 }
 
+// a failed part is sent again this many times before the upload is marked as failed,
+// retrying the item later resumes from the parts the server already has
+const PART_ATTEMPTS = 3;
+
+function patchItem(
+    set: (updater: (state: UploadState) => Partial<UploadState>) => void,
+    id: string,
+    patch: Partial<UploadItem>,
+) {
+    set((state) => ({
+        items: state.items.map((i) => (i.id === id ? { ...i, ...patch } : i)),
+    }));
+}
+
 function startUpload(
     item: UploadItem,
     get: () => UploadState,
     set: (updater: (state: UploadState) => Partial<UploadState>) => void,
 ) {
-    const formData = new FormData();
-    formData.append("file", item.file);
-    formData.append("title", item.title);
-    formData.append("description", item.description);
-    formData.append("visibility", item.visibility);
-
-    const { promise, abort } = uploadWithProgress<ApiResponse<VOD>>(
-        "/vods/upload",
-        formData,
-        (loaded, total) => {
-            set((state) => ({
-                items: state.items.map((i) =>
-                    i.id === item.id
-                        ? {
-                              ...i,
-                              loaded,
-                              total,
-                              progress:
-                                  total > 0
-                                      ? Math.round((loaded / total) * 100)
-                                      : 0,
-                          }
-                        : i,
-                ),
-            }));
-        },
-    );
+    let aborted = false;
+    let abortPart: (() => void) | undefined;
+    const abort = () => {
+        aborted = true;
+        abortPart?.();
+    };
 
     // Mark as uploading and store abort handle
-    set((state) => ({
-        items: state.items.map((i) =>
-            i.id === item.id
-                ? { ...i, status: UPLOAD_STATUS.UPLOADING, abort }
-                : i,
-        ),
-    }));
+    patchItem(set, item.id, { status: UPLOAD_STATUS.UPLOADING, abort });
 
-    promise
-        .then((res) => {
+    runChunkedUpload(
+        item,
+        set,
+        (fn) => {
+            abortPart = fn;
+        },
+        () => aborted,
+    )
+        .then((vod) => {
             const currentItem = get().items.find((i) => i.id === item.id);
             if (currentItem?.status === UPLOAD_STATUS.CANCELLED) return;
 
-            if (res.success) {
-                set((state) => ({
-                    items: state.items.map((i) =>
-                        i.id === item.id
-                            ? {
-                                  ...i,
-                                  status: UPLOAD_STATUS.COMPLETED,
-                                  progress: 100,
-                                  vod: res.data,
-                              }
-                            : i,
-                    ),
-                }));
-            } else {
-                set((state) => ({
-                    items: state.items.map((i) =>
-                        i.id === item.id
-                            ? {
-                                  ...i,
-                                  status: UPLOAD_STATUS.FAILED,
-                                  error: res.message,
-                                  errorCode: undefined,
-                              }
-                            : i,
-                    ),
-                }));
-            }
+            patchItem(set, item.id, {
+                status: UPLOAD_STATUS.COMPLETED,
+                progress: 100,
+                loaded: item.file.size,
+                vod,
+                abort: undefined,
+            });
         })
         .catch((err) => {
             const currentItem = get().items.find((i) => i.id === item.id);
             if (currentItem?.status === UPLOAD_STATUS.CANCELLED) return;
 
             const isClientError = err instanceof UploadClientError;
-            set((state) => ({
-                items: state.items.map((i) =>
-                    i.id === item.id
-                        ? {
-                              ...i,
-                              status: UPLOAD_STATUS.FAILED,
-                              error: isClientError ? err.message : err.message,
-                              errorCode: isClientError ? err.code : undefined,
-                          }
-                        : i,
-                ),
-            }));
+            patchItem(set, item.id, {
+                status: UPLOAD_STATUS.FAILED,
+                error: err.message,
+                errorCode: isClientError ? err.code : undefined,
+                abort: undefined,
+            });
         })
         .finally(() => {
             processQueue(get, set);
         });
 }
 
+/**
+ * Sends the file part by part, resuming the item's previous upload when the server still has it,
+ * and completes the upload once every part is received.
+ */
+async function runChunkedUpload(
+    item: UploadItem,
+    set: (updater: (state: UploadState) => Partial<UploadState>) => void,
+    setAbortPart: (abort: () => void) => void,
+    isAborted: () => boolean,
+): Promise<VOD> {
+    let upload: VODUpload | undefined;
+
+    if (item.uploadId) {
+        const res = await GetVODUpload(item.uploadId);
+        // an expired upload is gone from the server, start over
+        if (res.success && res.data && res.data.status === "uploading") {
+            upload = res.data;
+        }
+    }
+
+    if (!upload) {
+        const res = await InitiateVODUpload(
+            item.file,
+            item.title,
+            item.description,
+            item.visibility,
+        );
+        if (!res.success || !res.data) {
+            throw new Error(res.message);
+        }
+        upload = res.data;
+        patchItem(set, item.id, { uploadId: upload.id });
+    }
+
+    const { id: uploadId, partSize, partCount, totalSize } = upload;
+    const uploadedParts = new Set(upload.uploadedParts);
+    const partLength = (partNumber: number) =>
+        Math.min(partSize, totalSize - (partNumber - 1) * partSize);
+
+    let loadedBefore = 0;
+    uploadedParts.forEach((partNumber) => {
+        loadedBefore += partLength(partNumber);
+    });
+
+    const reportProgress = (loaded: number) => {
+        patchItem(set, item.id, {
+            loaded,
+            total: totalSize,
+            progress:
+                totalSize > 0 ? Math.round((loaded / totalSize) * 100) : 0,
+        });
+    };
+    reportProgress(loadedBefore);
+
+    for (let partNumber = 1; partNumber <= partCount; partNumber++) {
+        if (uploadedParts.has(partNumber)) continue;
+
+        const start = (partNumber - 1) * partSize;
+        const blob = item.file.slice(start, start + partLength(partNumber));
+
+        for (let attempt = 1; ; attempt++) {
+            if (isAborted()) {
+                throw new UploadClientError(
+                    "Upload cancelled",
+                    UPLOAD_ERROR_CODES.CANCELLED,
+                );
+            }
+
+            const { promise, abort } = uploadWithProgress<
+                ApiResponse<VODUpload>
+            >(
+                `/vod-uploads/${uploadId}/parts/${partNumber}`,
+                blob,
+                (loaded) => reportProgress(loadedBefore + loaded),
+                "PUT",
+            );
+            setAbortPart(abort);
+
+            try {
+                const res = await promise;
+                if (!res.success) {
+                    throw new Error(res.message);
+                }
+                break;
+            } catch (err) {
+                const retryable =
+                    err instanceof UploadClientError &&
+                    err.code === UPLOAD_ERROR_CODES.FAILED;
+                if (!retryable || attempt >= PART_ATTEMPTS) {
+                    throw err;
+                }
+                await new Promise((resolve) =>
+                    setTimeout(resolve, 1000 * 2 ** (attempt - 1)),
+                );
+            }
+        }
+
+        loadedBefore += blob.size;
+        reportProgress(loadedBefore);
+    }
+
+    const res = await CompleteVODUpload(uploadId);
+    if (!res.success || !res.data) {
+        throw new Error(res.message);
+    }
+
+    return res.data;
+}
+
 export default useUploadStore;
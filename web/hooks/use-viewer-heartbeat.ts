@@ -0,0 +1,57 @@
+"use client";
+
+import { useEffect, useState } from "react";
+import { SendViewerHeartbeat } from "@/lib/api/livestream";
+
+const SESSION_STORAGE_KEY = "livestream-viewer-session";
+const DEFAULT_HEARTBEAT_INTERVAL_SECONDS = 15;
+
+// one session per tab, so a reload keeps counting as the same viewer
+function getViewerSessionId(): string {
+    let sessionId = sessionStorage.getItem(SESSION_STORAGE_KEY);
+    if (!sessionId) {
+        sessionId = crypto.randomUUID();
+        sessionStorage.setItem(SESSION_STORAGE_KEY, sessionId);
+    }
+    return sessionId;
+}
+
+/** Counts the tab as a viewer of the livestream while it is shown, returns the current viewer count. */
+export default function useViewerHeartbeat(livestreamId: string | null) {
+    const [viewerCount, setViewerCount] = useState<number | null>(null);
+
+    useEffect(() => {
+        if (!livestreamId) return;
+
+        const sessionId = getViewerSessionId();
+        let timer: ReturnType<typeof setTimeout> | undefined;
+        let stopped = false;
+
+        const sendHeartbeat = async () => {
+            let intervalSeconds = DEFAULT_HEARTBEAT_INTERVAL_SECONDS;
+            try {
+                const res = await SendViewerHeartbeat(livestreamId, sessionId);
+                if (stopped) return;
+                // the livestream is not live anymore
+                if (!res.success) return;
+                if (res.data) {
+                    setViewerCount(res.data.viewerCount);
+                    intervalSeconds = res.data.heartbeatIntervalSeconds;
+                }
+            } catch {
+                // a failed heartbeat is retried with the next one
+            }
+            if (!stopped) {
+                timer = setTimeout(sendHeartbeat, intervalSeconds * 1000);
+            }
+        };
+
+        sendHeartbeat();
+        return () => {
+            stopped = true;
+            clearTimeout(timer);
+        };
+    }, [livestreamId]);
+
+    return viewerCount;
+}
@@ -0,0 +1,46 @@
+import { Clip, CreateClipRequest } from "@/types/clip";
+import { ApiResponse } from "@/types/fetch-response";
+import { fetchClient } from "@/utils/fetchClient";
+
+export async function CreateClip(
+    data: CreateClipRequest,
+): Promise<ApiResponse<Clip>> {
+    return fetchClient<ApiResponse<Clip>>(`/clips`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify(data),
+    });
+}
+
+export async function GetClip(clipId: string): Promise<ApiResponse<Clip>> {
+    return fetchClient<ApiResponse<Clip>>(`/clips/${clipId}`);
+}
+
+// only the ready clips are listed, newest first
+export async function GetClips(
+    source: { channelId: string } | { vodId: string } | { livestreamId: string },
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Clip[]>> {
+    const params = new URLSearchParams({
+        ...source,
+        page: page.toString(),
+        limit: limit.toString(),
+    });
+    return fetchClient<ApiResponse<Clip[]>>(`/clips?${params.toString()}`);
+}
+
+export async function RegisterClipView(
+    clipId: string,
+    watchedSeconds: number,
+): Promise<ApiResponse<void>> {
+    return fetchClient<ApiResponse<void>>(`/clips/${clipId}/view`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ watchedSeconds }),
+    });
+}
@@ -1,16 +1,32 @@
 import { ApiResponse } from "@/types/fetch-response";
-import { Livestream } from "../../types/livestream";
+import {
+    BrowseFilter,
+    Category,
+    Livestream,
+    LivestreamUpdate,
+    ViewerHeartbeat,
+} from "../../types/livestream";
 import { fetchClient } from "@/utils/fetchClient";
 
 export async function GetPopularLivestreams(
-    page: number = 0,
+    cursor?: string,
     limit: number = 10,
+    filter: BrowseFilter = {},
 ): Promise<ApiResponse<Livestream[]>> {
+    const params = new URLSearchParams({ limit: String(limit) });
+    if (cursor) params.append("cursor", cursor);
+    if (filter.category) params.append("category", filter.category);
+    if (filter.tag) params.append("tag", filter.tag);
+
     return fetchClient<ApiResponse<Livestream[]>>(
-        `/popular-livestreams?page=${page}&limit=${limit}`,
+        `/popular-livestreams?${params.toString()}`,
     );
 }
 
+export async function GetCategories(): Promise<ApiResponse<Category[]>> {
+    return fetchClient<ApiResponse<Category[]>>(`/categories`);
+}
+
 export async function GetLivestreamOfUser(
     userId: string,
 ): Promise<ApiResponse<Livestream | null>> {
@@ -18,3 +34,35 @@ export async function GetLivestreamOfUser(
         `/livestreams?userId=${userId}`,
     );
 }
+
+export async function UpdateLivestream(
+    livestreamId: string,
+    update: LivestreamUpdate,
+): Promise<ApiResponse<Livestream>> {
+    return fetchClient<ApiResponse<Livestream>>(
+        `/livestreams/${livestreamId}`,
+        {
+            method: "PATCH",
+            headers: {
+                "Content-Type": "application/json",
+            },
+            body: JSON.stringify(update),
+        },
+    );
+}
+
+export async function SendViewerHeartbeat(
+    livestreamId: string,
+    sessionId: string,
+): Promise<ApiResponse<ViewerHeartbeat>> {
+    return fetchClient<ApiResponse<ViewerHeartbeat>>(
+        `/livestreams/${livestreamId}/heartbeat`,
+        {
+            method: "POST",
+            headers: {
+                "Content-Type": "application/json",
+            },
+            body: JSON.stringify({ sessionId }),
+        },
+    );
+}
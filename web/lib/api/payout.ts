@@ -0,0 +1,49 @@
+import { ApiResponse } from "@/types/fetch-response";
+import {
+    Payout,
+    PayoutAccount,
+    RequestPayoutRequest,
+    SetPayoutAccountRequest,
+} from "@/types/payout";
+import { fetchClient } from "@/utils/fetchClient";
+
+export async function GetPayoutAccount(): Promise<
+    ApiResponse<PayoutAccount | null>
+> {
+    return fetchClient<ApiResponse<PayoutAccount | null>>(`/payout-account`);
+}
+
+export async function SetPayoutAccount(
+    data: SetPayoutAccountRequest,
+): Promise<ApiResponse<PayoutAccount>> {
+    return fetchClient<ApiResponse<PayoutAccount>>(`/payout-account`, {
+        method: "PUT",
+        body: JSON.stringify(data),
+    });
+}
+
+export async function RequestPayout(
+    data: RequestPayoutRequest,
+): Promise<ApiResponse<Payout>> {
+    return fetchClient<ApiResponse<Payout>>(`/payouts`, {
+        method: "POST",
+        body: JSON.stringify(data),
+    });
+}
+
+export async function GetPayouts(
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Payout[]>> {
+    return fetchClient<ApiResponse<Payout[]>>(
+        `/payouts?page=${page}&limit=${limit}`,
+    );
+}
+
+export async function CancelPayout(
+    payoutId: string,
+): Promise<ApiResponse<Payout>> {
+    return fetchClient<ApiResponse<Payout>>(`/payouts/${payoutId}`, {
+        method: "DELETE",
+    });
+}
@@ -0,0 +1,21 @@
+import { ApiResponse } from "@/types/fetch-response";
+import { SearchResults, SearchType } from "@/types/search";
+import { fetchClient } from "@/utils/fetchClient";
+
+export async function Search(
+    query: string,
+    type: SearchType = "all",
+    page: number = 0,
+    limit: number = 10,
+): Promise<ApiResponse<SearchResults>> {
+    const params = new URLSearchParams({
+        q: query,
+        type,
+        page: String(page),
+        limit: String(limit),
+    });
+
+    return fetchClient<ApiResponse<SearchResults>>(
+        `/search?${params.toString()}`,
+    );
+}
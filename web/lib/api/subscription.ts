@@ -0,0 +1,84 @@
+import { ApiResponse } from "@/types/fetch-response";
+import {
+    CreateSubscriptionTierRequest,
+    Subscription,
+    SubscriptionTier,
+    UpdateSubscriptionTierRequest,
+} from "@/types/subscription";
+import { fetchClient } from "@/utils/fetchClient";
+
+export async function GetChannelSubscriptionTiers(
+    channelId: string,
+): Promise<ApiResponse<SubscriptionTier[]>> {
+    return fetchClient<ApiResponse<SubscriptionTier[]>>(
+        `/channels/${channelId}/subscription-tiers`,
+    );
+}
+
+export async function CreateSubscriptionTier(
+    data: CreateSubscriptionTierRequest,
+): Promise<ApiResponse<SubscriptionTier>> {
+    return fetchClient<ApiResponse<SubscriptionTier>>(`/subscription-tiers`, {
+        method: "POST",
+        body: JSON.stringify(data),
+    });
+}
+
+export async function UpdateSubscriptionTier(
+    tierId: string,
+    data: UpdateSubscriptionTierRequest,
+): Promise<ApiResponse<SubscriptionTier>> {
+    return fetchClient<ApiResponse<SubscriptionTier>>(
+        `/subscription-tiers/${tierId}`,
+        {
+            method: "PATCH",
+            body: JSON.stringify(data),
+        },
+    );
+}
+
+export async function DeleteSubscriptionTier(
+    tierId: string,
+): Promise<ApiResponse<void>> {
+    return fetchClient<ApiResponse<void>>(`/subscription-tiers/${tierId}`, {
+        method: "DELETE",
+    });
+}
+
+export async function Subscribe(
+    tierId: string,
+): Promise<ApiResponse<Subscription>> {
+    return fetchClient<ApiResponse<Subscription>>(`/subscriptions`, {
+        method: "POST",
+        body: JSON.stringify({ tierId }),
+    });
+}
+
+export async function GetMySubscriptions(
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Subscription[]>> {
+    return fetchClient<ApiResponse<Subscription[]>>(
+        `/subscriptions?page=${page}&limit=${limit}`,
+    );
+}
+
+export async function CancelSubscription(
+    subscriptionId: string,
+): Promise<ApiResponse<Subscription>> {
+    return fetchClient<ApiResponse<Subscription>>(
+        `/subscriptions/${subscriptionId}`,
+        {
+            method: "DELETE",
+        },
+    );
+}
+
+export async function GetMySubscribers(
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Subscription[]>> {
+    return fetchClient<ApiResponse<Subscription[]>>(
+        `/subscribers?page=${page}&limit=${limit}`,
+    );
+}
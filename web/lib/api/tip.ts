@@ -0,0 +1,30 @@
+import { ApiResponse } from "@/types/fetch-response";
+import { SendTipRequest, Tip } from "@/types/tip";
+import { fetchClient } from "@/utils/fetchClient";
+
+export async function SendTip(
+    data: SendTipRequest,
+): Promise<ApiResponse<Tip>> {
+    return fetchClient<ApiResponse<Tip>>(`/tips`, {
+        method: "POST",
+        body: JSON.stringify(data),
+    });
+}
+
+export async function GetReceivedTips(
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Tip[]>> {
+    return fetchClient<ApiResponse<Tip[]>>(
+        `/tips/received?page=${page}&limit=${limit}`,
+    );
+}
+
+export async function GetSentTips(
+    page: number = 0,
+    limit: number = 20,
+): Promise<ApiResponse<Tip[]>> {
+    return fetchClient<ApiResponse<Tip[]>>(
+        `/tips/sent?page=${page}&limit=${limit}`,
+    );
+}
@@ -1,5 +1,10 @@
 import { ApiResponse } from "@/types/fetch-response";
-import { LivestreamInformation, MeUser, PublicUser } from "../../types/user";
+import {
+    FollowingFeedItem,
+    LivestreamInformation,
+    MeUser,
+    PublicUser,
+} from "../../types/user";
 import { fetchClient } from "@/utils/fetchClient";
 
 export async function SearchUsersByUsername(
@@ -30,6 +35,18 @@ export async function GetFollowingChannels(): Promise<
     return fetchClient<ApiResponse<PublicUser[]>>(`/user/me/following`);
 }
 
+export async function GetFollowingFeed(
+    cursor?: string,
+    limit: number = 20,
+): Promise<ApiResponse<FollowingFeedItem[]>> {
+    const params = new URLSearchParams({ limit: String(limit) });
+    if (cursor) params.append("cursor", cursor);
+
+    return fetchClient<ApiResponse<FollowingFeedItem[]>>(
+        `/user/me/following/feed?${params.toString()}`,
+    );
+}
+
 export async function GetMeProfile(): Promise<ApiResponse<MeUser>> {
     return fetchClient<ApiResponse<MeUser>>(`/user/me`);
 }
@@ -72,6 +89,8 @@ export async function UpdateLivestreamInformation(
     thumbnailUrl: string | null,
     title: string,
     description: string,
+    category?: string | null, // null clears the category, undefined keeps it
+    tags?: string[], // an empty list clears the tags, undefined keeps them
 ): Promise<ApiResponse<LivestreamInformation>> {
     const formData = new FormData();
 
@@ -81,6 +100,11 @@ export async function UpdateLivestreamInformation(
 
     formData.append("title", title);
     formData.append("description", description);
+    if (category !== undefined) formData.append("category", category ?? "");
+    if (tags !== undefined) {
+        if (tags.length === 0) formData.append("tags", "");
+        tags.forEach((tag) => formData.append("tags", tag));
+    }
 
     return fetchClient<ApiResponse<LivestreamInformation>>(
         `/user/me/livestream-information`,
@@ -114,3 +138,21 @@ export async function UnfollowOtherUser(
         method: "DELETE",
     });
 }
+
+export async function BlockUser(blockedId: string): Promise<ApiResponse<void>> {
+    return fetchClient<ApiResponse<void>>(`/user/${blockedId}/block`, {
+        method: "POST",
+    });
+}
+
+export async function UnblockUser(
+    blockedId: string,
+): Promise<ApiResponse<void>> {
+    return fetchClient<ApiResponse<void>>(`/user/${blockedId}/unblock`, {
+        method: "DELETE",
+    });
+}
+
+export async function GetBlockedUsers(): Promise<ApiResponse<PublicUser[]>> {
+    return fetchClient<ApiResponse<PublicUser[]>>(`/user/me/blocks`);
+}
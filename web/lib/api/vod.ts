@@ -1,5 +1,7 @@
+import GLOBAL from "@/global";
 import { ApiResponse } from "@/types/fetch-response";
-import { VOD } from "@/types/vod";
+import { BrowseFilter } from "@/types/livestream";
+import { VOD, VODChapter, VODRange, VODThumbnails, VODUpload } from "@/types/vod";
 import { fetchClient } from "@/utils/fetchClient";
 
 export async function GetAllVODsAsAuthor(): Promise<ApiResponse<VOD[]>> {
@@ -17,11 +19,17 @@ export async function GetPublicVODsOfUser(
 }
 
 export async function GetPopularVODs(
-    page: number = 0,
+    cursor?: string,
     limit: number = 20,
+    filter: BrowseFilter = {},
 ): Promise<ApiResponse<VOD[]>> {
+    const params = new URLSearchParams({ limit: String(limit) });
+    if (cursor) params.append("cursor", cursor);
+    if (filter.category) params.append("category", filter.category);
+    if (filter.tag) params.append("tag", filter.tag);
+
     return fetchClient<ApiResponse<VOD[]>>(
-        `/popular-vods?page=${page}&limit=${limit}`,
+        `/popular-vods?${params.toString()}`,
     );
 }
 
@@ -54,6 +62,85 @@ export async function UpdateVOD(
     });
 }
 
+export async function GetVODThumbnails(
+    vodId: string,
+): Promise<ApiResponse<VODThumbnails>> {
+    return fetchClient<ApiResponse<VODThumbnails>>(`/vods/${vodId}/thumbnails`);
+}
+
+export async function SelectVODThumbnail(
+    vodId: string,
+    candidateIndex: number,
+): Promise<ApiResponse<VOD>> {
+    return fetchClient<ApiResponse<VOD>>(`/vods/${vodId}/thumbnail`, {
+        method: "PUT",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ candidateIndex }),
+    });
+}
+
+export async function UploadVODThumbnail(
+    vodId: string,
+    image: File,
+): Promise<ApiResponse<VOD>> {
+    const formData = new FormData();
+    formData.append("image", image);
+
+    return fetchClient<ApiResponse<VOD>>(`/vods/${vodId}/thumbnail`, {
+        method: "POST",
+        body: formData,
+    });
+}
+
+// the cuts fall on the closest segment boundaries, ranges are in seconds from the start of the vod
+export async function TrimVOD(
+    vodId: string,
+    ranges: VODRange[],
+): Promise<ApiResponse<VOD>> {
+    return fetchClient<ApiResponse<VOD>>(`/vods/${vodId}/trim`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ ranges }),
+    });
+}
+
+// returns the vod, which keeps the first part, followed by a new vod for every other part
+export async function SplitVOD(
+    vodId: string,
+    atSeconds: number[],
+): Promise<ApiResponse<VOD[]>> {
+    return fetchClient<ApiResponse<VOD[]>>(`/vods/${vodId}/split`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ atSeconds }),
+    });
+}
+
+// replaces the chapters of the vod, sorted by their start, an empty list removes them
+export async function UpdateVODChapters(
+    vodId: string,
+    chapters: VODChapter[],
+): Promise<ApiResponse<VODChapter[]>> {
+    return fetchClient<ApiResponse<VODChapter[]>>(`/vods/${vodId}/chapters`, {
+        method: "PUT",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ chapters }),
+    });
+}
+
+// src of a <track kind="chapters"> for the player
+export function GetVODChaptersTrackURL(vodId: string): string {
+    return `${GLOBAL.API_URL}/vods/${vodId}/chapters.vtt`;
+}
+
 export async function DeleteVOD(vodId: string): Promise<ApiResponse<void>> {
     return fetchClient<ApiResponse<void>>(`/vods/${vodId}`, {
         method: "DELETE",
@@ -91,3 +178,47 @@ export async function UploadVOD(
         disableTimeout: true,
     });
 }
+
+export async function InitiateVODUpload(
+    file: File,
+    title: string,
+    description: string,
+    visibility: string,
+): Promise<ApiResponse<VODUpload>> {
+    return fetchClient<ApiResponse<VODUpload>>(`/vod-uploads`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({
+            filename: file.name,
+            fileSize: file.size,
+            title,
+            description,
+            visibility,
+        }),
+    });
+}
+
+export async function GetVODUpload(
+    uploadId: string,
+): Promise<ApiResponse<VODUpload>> {
+    return fetchClient<ApiResponse<VODUpload>>(`/vod-uploads/${uploadId}`);
+}
+
+export async function CompleteVODUpload(
+    uploadId: string,
+): Promise<ApiResponse<VOD>> {
+    return fetchClient<ApiResponse<VOD>>(`/vod-uploads/${uploadId}/complete`, {
+        method: "POST",
+        disableTimeout: true,
+    });
+}
+
+export async function AbortVODUpload(
+    uploadId: string,
+): Promise<ApiResponse<void>> {
+    return fetchClient<ApiResponse<void>>(`/vod-uploads/${uploadId}`, {
+        method: "DELETE",
+    });
+}
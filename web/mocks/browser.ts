@@ -6,6 +6,10 @@ import { vodHandlers } from "./handlers/vod";
 import { chatHandlers } from "./handlers/chat";
 import { dmHandlers } from "./handlers/dm";
 import { financeHandlers } from "./handlers/finance";
+import { searchHandlers } from "./handlers/search";
+import { subscriptionHandlers } from "./handlers/subscription";
+import { tipHandlers } from "./handlers/tip";
+import { payoutHandlers } from "./handlers/payout";
 
 export const worker = setupWorker(
     ...authHandlers,
@@ -15,4 +19,8 @@ export const worker = setupWorker(
     ...chatHandlers,
     ...dmHandlers,
     ...financeHandlers,
+    ...searchHandlers,
+    ...subscriptionHandlers,
+    ...tipHandlers,
+    ...payoutHandlers,
 );
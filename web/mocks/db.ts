@@ -6,7 +6,7 @@
 
 import { AuthProvider, MeUser, PublicUser, UserStatus } from "@/types/user";
 import { Notification } from "@/types/notification";
-import { Livestream } from "@/types/livestream";
+import { Category, Livestream } from "@/types/livestream";
 import { VOD } from "@/types/vod";
 import { VODComment } from "@/types/vod-comment";
 import { ChatCommand } from "@/types/chat-command";
@@ -67,6 +67,8 @@ export const meUser: MeUser = {
         description: "Welcome to my channel!",
         thumbnailUrl:
             "https://images.unsplash.com/photo-1511512578047-dfb367046420?w=640&q=80",
+        category: null,
+        tags: [],
     },
     socialMediaLinks: {
         github: "https://github.com/mockuser",
@@ -90,6 +92,8 @@ export const otherUsers: PublicUser[] = [
             description: "Best games, best vibes",
             thumbnailUrl:
                 "https://images.unsplash.com/photo-1542751371-adc38448a05e?w=640&q=80",
+            category: null,
+            tags: [],
         },
         isFollowing: true,
     },
@@ -108,6 +112,8 @@ export const otherUsers: PublicUser[] = [
             description: "Building real apps from scratch",
             thumbnailUrl:
                 "https://images.unsplash.com/photo-1461749280684-dccba630e2f6?w=640&q=80",
+            category: null,
+            tags: [],
         },
         isFollowing: false,
     },
@@ -126,6 +132,8 @@ export const otherUsers: PublicUser[] = [
             description: "Lo-fi beats live",
             thumbnailUrl:
                 "https://images.unsplash.com/photo-1493225457124-a3eb161ffa5f?w=640&q=80",
+            category: null,
+            tags: [],
         },
         isFollowing: true,
     },
@@ -144,6 +152,8 @@ export const otherUsers: PublicUser[] = [
             description: "Real-time travel content",
             thumbnailUrl:
                 "https://images.unsplash.com/photo-1503220317375-aaad61436b1b?w=640&q=80",
+            category: null,
+            tags: [],
         },
         isFollowing: false,
     },
@@ -162,7 +172,11 @@ export const livestreams: Livestream[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1542751371-adc38448a05e?w=640&q=80",
         viewCount: 234,
+        viewerCount: 78,
+        peakViewerCount: 310,
         visibility: "public",
+        category: "gaming",
+        tags: ["rpg"],
         startedAt: daysAgo(0),
         endedAt: null,
         createdAt: daysAgo(0),
@@ -177,7 +191,11 @@ export const livestreams: Livestream[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1461749280684-dccba630e2f6?w=640&q=80",
         viewCount: 89,
+        viewerCount: 29,
+        peakViewerCount: 120,
         visibility: "public",
+        category: "software-development",
+        tags: ["rust", "cli"],
         startedAt: daysAgo(0),
         endedAt: null,
         createdAt: daysAgo(0),
@@ -192,7 +210,11 @@ export const livestreams: Livestream[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1493225457124-a3eb161ffa5f?w=640&q=80",
         viewCount: 512,
+        viewerCount: 0,
+        peakViewerCount: 640,
         visibility: "public",
+        category: "music",
+        tags: ["lo-fi"],
         startedAt: daysAgo(1),
         endedAt: now(),
         createdAt: daysAgo(1),
@@ -201,6 +223,17 @@ export const livestreams: Livestream[] = [
     },
 ];
 
+// ---------------------------------------------------------------------------
+// Seed: Categories
+// ---------------------------------------------------------------------------
+
+export const categories: Omit<Category, "liveCount" | "viewerCount">[] = [
+    { slug: "just-chatting", name: "Just Chatting" },
+    { slug: "gaming", name: "Gaming" },
+    { slug: "music", name: "Music" },
+    { slug: "software-development", name: "Software Development" },
+];
+
 // ---------------------------------------------------------------------------
 // Seed: VODs
 // ---------------------------------------------------------------------------
@@ -215,6 +248,8 @@ export const vods: VOD[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1511512578047-dfb367046420?w=640&q=80",
         visibility: "public",
+        category: null,
+        tags: [],
         viewCount: 17,
         duration: 210,
         playbackUrl:
@@ -232,6 +267,8 @@ export const vods: VOD[] = [
         description: "Just for me",
         thumbnailUrl: null,
         visibility: "private",
+        category: null,
+        tags: [],
         viewCount: 2,
         duration: 600,
         playbackUrl: null,
@@ -249,6 +286,8 @@ export const vods: VOD[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1493225457124-a3eb161ffa5f?w=640&q=80",
         visibility: "public",
+        category: "music",
+        tags: ["lo-fi"],
         viewCount: 441,
         duration: 210,
         playbackUrl:
@@ -267,6 +306,8 @@ export const vods: VOD[] = [
         thumbnailUrl:
             "https://images.unsplash.com/photo-1542751371-adc38448a05e?w=640&q=80",
         visibility: "public",
+        category: "gaming",
+        tags: ["highlights"],
         viewCount: 1102,
         duration: 210,
         playbackUrl:
@@ -1,7 +1,7 @@
 import { http } from "msw";
-import { API_BASE, ok, notFound } from "../utils";
-import { livestreams } from "../db";
-import { Livestream } from "@/types/livestream";
+import { API_BASE, ok, notFound, conflict } from "../utils";
+import { categories, livestreams } from "../db";
+import { Category, Livestream, ViewerHeartbeat } from "@/types/livestream";
 
 export const livestreamHandlers = [
     // GET /livestreams?userId=
@@ -14,17 +14,55 @@ export const livestreamHandlers = [
         return ok<Livestream[]>(results);
     }),
 
-    // GET /popular-livestreams?page=&limit=
+    // GET /popular-livestreams?cursor=&limit=&category=&tag=
     http.get(`${API_BASE}/popular-livestreams`, ({ request }) => {
         const url = new URL(request.url);
-        const page = parseInt(url.searchParams.get("page") ?? "0");
+        const offset = parseInt(url.searchParams.get("cursor") ?? "0");
         const limit = parseInt(url.searchParams.get("limit") ?? "10");
-        const active = livestreams.filter((ls) => ls.endedAt === null);
-        const slice = active.slice(page * limit, page * limit + limit);
-        return ok<Livestream[]>(slice, {
-            page,
-            page_size: limit,
-            total: active.length,
+        const category = url.searchParams.get("category");
+        const tag = url.searchParams.get("tag");
+        const active = livestreams
+            .filter((ls) => ls.endedAt === null)
+            .filter((ls) => !category || ls.category === category)
+            .filter((ls) => !tag || ls.tags.includes(tag));
+        const next = offset + limit;
+        return ok<Livestream[]>(active.slice(offset, next), {
+            next_cursor: next < active.length ? String(next) : undefined,
         });
     }),
+
+    // GET /categories — with the live streams of each one
+    http.get(`${API_BASE}/categories`, () => {
+        const results = categories.map((category) => {
+            const live = livestreams.filter(
+                (ls) => ls.endedAt === null && ls.category === category.slug,
+            );
+            return {
+                ...category,
+                liveCount: live.length,
+                viewerCount: live.reduce((sum, ls) => sum + ls.viewerCount, 0),
+            };
+        });
+        return ok<Category[]>(results);
+    }),
+
+    // POST /livestreams/:livestreamId/heartbeat
+    http.post(
+        `${API_BASE}/livestreams/:livestreamId/heartbeat`,
+        ({ params }) => {
+            const { livestreamId } = params as { livestreamId: string };
+            const livestream = livestreams.find(
+                (ls) => ls.id === livestreamId,
+            );
+            if (!livestream || livestream.endedAt !== null)
+                return conflict(
+                    "res_err_livestream_not_live",
+                    "The livestream is not live.",
+                );
+            return ok<ViewerHeartbeat>({
+                viewerCount: livestream.viewerCount,
+                heartbeatIntervalSeconds: 15,
+            });
+        },
+    ),
 ];
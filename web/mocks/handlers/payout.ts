@@ -0,0 +1,131 @@
+import { http } from "msw";
+import { API_BASE, ok, notFound, badRequest, conflict } from "../utils";
+import { ME_USER_ID, now, uid, walletBalances } from "../db";
+import {
+    Payout,
+    PayoutAccount,
+    PayoutStatus,
+    RequestPayoutRequest,
+    SetPayoutAccountRequest,
+} from "@/types/payout";
+
+const MIN_PAYOUT_AMOUNT = 10;
+
+let payoutAccount: PayoutAccount | null = null;
+const payouts: Payout[] = [];
+
+const inFlight = [
+    PayoutStatus.PENDING,
+    PayoutStatus.APPROVED,
+    PayoutStatus.PROCESSING,
+];
+
+export const payoutHandlers = [
+    http.get(`${API_BASE}/payout-account`, () =>
+        ok<PayoutAccount | null>(payoutAccount),
+    ),
+
+    http.put(`${API_BASE}/payout-account`, async ({ request }) => {
+        const body = (await request.json()) as SetPayoutAccountRequest;
+        if (body.provider !== "mock" || !body.destination)
+            return badRequest("res_err_invalid_input", "Invalid input");
+
+        // there is no kyc review to wait for in the mocks
+        payoutAccount = {
+            ownerId: ME_USER_ID,
+            provider: body.provider,
+            destination: body.destination,
+            kycStatus: "verified",
+            createdAt: payoutAccount?.createdAt ?? now(),
+            updatedAt: now(),
+        };
+        return ok<PayoutAccount>(payoutAccount);
+    }),
+
+    http.post(`${API_BASE}/payouts`, async ({ request }) => {
+        const body = (await request.json()) as RequestPayoutRequest;
+        const amount = parseFloat(body.amount);
+        if (!(amount > 0))
+            return badRequest("res_err_invalid_amount", "Invalid amount");
+        if (amount < MIN_PAYOUT_AMOUNT)
+            return badRequest(
+                "res_err_payout_below_minimum",
+                "The amount is below the minimum payout.",
+            );
+        if (!payoutAccount)
+            return badRequest(
+                "res_err_payout_account_required",
+                "Set up a payout account first.",
+            );
+        if (payouts.some((p) => inFlight.includes(p.status)))
+            return conflict(
+                "res_err_payout_in_progress",
+                "A payout is already in progress.",
+            );
+
+        const balance = walletBalances.find(
+            (b) => b.currencyCode === body.currencyCode,
+        );
+        if (!balance || parseFloat(balance.balance) < amount)
+            return badRequest(
+                "res_err_insufficient_balance",
+                "Insufficient balance",
+            );
+        balance.balance = (parseFloat(balance.balance) - amount).toFixed(2);
+
+        const payout: Payout = {
+            id: `payout-${uid()}`,
+            ownerId: ME_USER_ID,
+            currencyCode: body.currencyCode,
+            amount: amount.toFixed(2),
+            status: PayoutStatus.PENDING,
+            provider: payoutAccount.provider,
+            destination: payoutAccount.destination,
+            providerReference: null,
+            holdTransactionId: `tx-${uid()}`,
+            reversalTransactionId: null,
+            failureReason: null,
+            reviewedBy: null,
+            reviewedAt: null,
+            createdAt: now(),
+            updatedAt: now(),
+        };
+        payouts.unshift(payout);
+        return ok<Payout>(payout);
+    }),
+
+    http.get(`${API_BASE}/payouts`, ({ request }) => {
+        const url = new URL(request.url);
+        const page = parseInt(url.searchParams.get("page") ?? "0");
+        const limit = parseInt(url.searchParams.get("limit") ?? "20");
+        return ok<Payout[]>(payouts.slice(page * limit, page * limit + limit), {
+            page,
+            page_size: limit,
+            total: payouts.length,
+        });
+    }),
+
+    http.delete(`${API_BASE}/payouts/:payoutId`, ({ params }) => {
+        const payout = payouts.find((p) => p.id === params.payoutId);
+        if (!payout)
+            return notFound("res_err_payout_not_found", "Payout not found.");
+        if (payout.status !== PayoutStatus.PENDING)
+            return conflict(
+                "res_err_payout_invalid_status",
+                "The payout cannot be changed in its current status.",
+            );
+
+        const balance = walletBalances.find(
+            (b) => b.currencyCode === payout.currencyCode,
+        );
+        if (balance)
+            balance.balance = (
+                parseFloat(balance.balance) + parseFloat(payout.amount)
+            ).toFixed(2);
+
+        payout.status = PayoutStatus.CANCELLED;
+        payout.reversalTransactionId = `tx-${uid()}`;
+        payout.updatedAt = now();
+        return ok<Payout>(payout);
+    }),
+];
@@ -0,0 +1,50 @@
+import { http } from "msw";
+import { API_BASE, ok } from "../utils";
+import { livestreams, otherUsers, vods } from "../db";
+import { SearchResults } from "@/types/search";
+
+const matches = (query: string, ...texts: (string | null | undefined)[]) =>
+    texts.some((text) => text?.toLowerCase().includes(query));
+
+export const searchHandlers = [
+    // GET /search?q=&type=&page=&limit= — substring matching instead of stemming
+    http.get(`${API_BASE}/search`, ({ request }) => {
+        const url = new URL(request.url);
+        const query = url.searchParams.get("q")?.trim().toLowerCase() ?? "";
+        const type = url.searchParams.get("type") ?? "all";
+        const page = parseInt(url.searchParams.get("page") ?? "0");
+        const limit = parseInt(url.searchParams.get("limit") ?? "10");
+        const pageOf = <T>(items: T[], searched: boolean) =>
+            searched ? items.slice(page * limit, page * limit + limit) : [];
+
+        return ok<SearchResults>(
+            {
+                channels: pageOf(
+                    otherUsers.filter((u) =>
+                        matches(query, u.username, u.bio),
+                    ),
+                    type === "all" || type === "channel",
+                ),
+                livestreams: pageOf(
+                    livestreams.filter(
+                        (ls) =>
+                            ls.endedAt === null &&
+                            ls.visibility === "public" &&
+                            matches(query, ls.title, ls.description),
+                    ),
+                    type === "all" || type === "livestream",
+                ),
+                vods: pageOf(
+                    vods.filter(
+                        (v) =>
+                            v.visibility === "public" &&
+                            v.status === "ready" &&
+                            matches(query, v.title, v.description),
+                    ),
+                    type === "all" || type === "vod",
+                ),
+            },
+            { page, page_size: limit },
+        );
+    }),
+];
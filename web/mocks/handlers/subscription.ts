@@ -0,0 +1,236 @@
+import { http } from "msw";
+import { API_BASE, ok, notFound, badRequest, conflict } from "../utils";
+import { ME_USER_ID, daysAgo, now, uid, walletBalances } from "../db";
+import {
+    CreateSubscriptionTierRequest,
+    Subscription,
+    SubscriptionStatus,
+    SubscriptionTier,
+    UpdateSubscriptionTierRequest,
+} from "@/types/subscription";
+
+const MAX_TIERS_PER_CHANNEL = 3;
+
+const subscriptionTiers: SubscriptionTier[] = [
+    {
+        id: "tier-001",
+        channelId: "user-002",
+        name: "Supporter",
+        description: "A badge next to your name in chat",
+        price: "50.00",
+        currencyCode: "SPARK",
+        isActive: true,
+        createdAt: daysAgo(60),
+        updatedAt: daysAgo(60),
+    },
+    {
+        id: "tier-002",
+        channelId: "user-002",
+        name: "Super fan",
+        description: null,
+        price: "150.00",
+        currencyCode: "SPARK",
+        isActive: true,
+        createdAt: daysAgo(60),
+        updatedAt: daysAgo(60),
+    },
+];
+
+const subscriptions: Subscription[] = [];
+
+const isRunning = (s: Subscription) =>
+    s.status === SubscriptionStatus.PENDING ||
+    s.status === SubscriptionStatus.ACTIVE ||
+    s.status === SubscriptionStatus.PAST_DUE;
+
+const withTier = (s: Subscription): Subscription => ({
+    ...s,
+    tier: subscriptionTiers.find((t) => t.id === s.tierId) ?? null,
+});
+
+const pageOf = <T>(items: T[], request: Request) => {
+    const url = new URL(request.url);
+    const page = parseInt(url.searchParams.get("page") ?? "0");
+    const limit = parseInt(url.searchParams.get("limit") ?? "20");
+    return ok<T[]>(items.slice(page * limit, page * limit + limit), {
+        page,
+        page_size: limit,
+        total: items.length,
+    });
+};
+
+export const subscriptionHandlers = [
+    http.get(
+        `${API_BASE}/channels/:channelId/subscription-tiers`,
+        ({ params }) => {
+            const { channelId } = params as { channelId: string };
+            return ok<SubscriptionTier[]>(
+                subscriptionTiers.filter(
+                    (t) => t.channelId === channelId && t.isActive,
+                ),
+            );
+        },
+    ),
+
+    http.post(`${API_BASE}/subscription-tiers`, async ({ request }) => {
+        const body = (await request.json()) as CreateSubscriptionTierRequest;
+        const activeTiers = subscriptionTiers.filter(
+            (t) => t.channelId === ME_USER_ID && t.isActive,
+        );
+        if (activeTiers.length >= MAX_TIERS_PER_CHANNEL)
+            return conflict(
+                "res_err_subscription_tier_limit",
+                "Subscription tier limit reached",
+            );
+
+        const tier: SubscriptionTier = {
+            id: `tier-${uid()}`,
+            channelId: ME_USER_ID,
+            name: body.name,
+            description: body.description ?? null,
+            price: parseFloat(body.price).toFixed(2),
+            currencyCode: body.currencyCode,
+            isActive: true,
+            createdAt: now(),
+            updatedAt: now(),
+        };
+        subscriptionTiers.push(tier);
+        return ok<SubscriptionTier>(tier);
+    }),
+
+    http.patch(
+        `${API_BASE}/subscription-tiers/:tierId`,
+        async ({ params, request }) => {
+            const { tierId } = params as { tierId: string };
+            const tier = subscriptionTiers.find(
+                (t) => t.id === tierId && t.channelId === ME_USER_ID,
+            );
+            if (!tier)
+                return notFound(
+                    "res_err_subscription_tier_not_found",
+                    "Subscription tier not found",
+                );
+
+            const body =
+                (await request.json()) as UpdateSubscriptionTierRequest;
+            if (body.name !== undefined) tier.name = body.name;
+            if (body.description !== undefined)
+                tier.description = body.description;
+            if (body.price !== undefined)
+                tier.price = parseFloat(body.price).toFixed(2);
+            tier.updatedAt = now();
+            return ok<SubscriptionTier>(tier);
+        },
+    ),
+
+    http.delete(`${API_BASE}/subscription-tiers/:tierId`, ({ params }) => {
+        const { tierId } = params as { tierId: string };
+        const tier = subscriptionTiers.find(
+            (t) => t.id === tierId && t.channelId === ME_USER_ID,
+        );
+        if (!tier)
+            return notFound(
+                "res_err_subscription_tier_not_found",
+                "Subscription tier not found",
+            );
+        tier.isActive = false;
+        tier.updatedAt = now();
+        return ok(null);
+    }),
+
+    http.get(`${API_BASE}/subscriptions`, ({ request }) =>
+        pageOf(
+            subscriptions
+                .filter((s) => s.subscriberId === ME_USER_ID)
+                .map(withTier),
+            request,
+        ),
+    ),
+
+    // POST /subscriptions — charges the first month from the wallet at once
+    http.post(`${API_BASE}/subscriptions`, async ({ request }) => {
+        const { tierId } = (await request.json()) as { tierId: string };
+        const tier = subscriptionTiers.find(
+            (t) => t.id === tierId && t.isActive,
+        );
+        if (!tier)
+            return notFound(
+                "res_err_subscription_tier_not_found",
+                "Subscription tier not found",
+            );
+        if (
+            subscriptions.some(
+                (s) =>
+                    s.subscriberId === ME_USER_ID &&
+                    s.channelId === tier.channelId &&
+                    isRunning(s),
+            )
+        )
+            return conflict(
+                "res_err_already_subscribed",
+                "Already subscribed to this channel",
+            );
+
+        const balance = walletBalances.find(
+            (b) => b.currencyCode === tier.currencyCode,
+        );
+        if (!balance || parseFloat(balance.balance) < parseFloat(tier.price))
+            return badRequest(
+                "res_err_insufficient_balance",
+                "Insufficient balance",
+            );
+        balance.balance = (
+            parseFloat(balance.balance) - parseFloat(tier.price)
+        ).toFixed(2);
+
+        const periodEnd = new Date();
+        periodEnd.setMonth(periodEnd.getMonth() + 1);
+        const subscription: Subscription = {
+            id: `sub-${uid()}`,
+            subscriberId: ME_USER_ID,
+            channelId: tier.channelId,
+            tierId: tier.id,
+            status: SubscriptionStatus.ACTIVE,
+            months: 1,
+            currentPeriodStart: now(),
+            currentPeriodEnd: periodEnd.toISOString(),
+            cancelAtPeriodEnd: false,
+            failedAttempts: 0,
+            graceUntil: null,
+            createdAt: now(),
+            updatedAt: now(),
+            tier: null,
+        };
+        subscriptions.push(subscription);
+        return ok<Subscription>(withTier(subscription));
+    }),
+
+    http.delete(`${API_BASE}/subscriptions/:subscriptionId`, ({ params }) => {
+        const { subscriptionId } = params as { subscriptionId: string };
+        const subscription = subscriptions.find(
+            (s) => s.id === subscriptionId && s.subscriberId === ME_USER_ID,
+        );
+        if (!subscription)
+            return notFound(
+                "res_err_subscription_not_found",
+                "Subscription not found",
+            );
+
+        if (subscription.status === SubscriptionStatus.ACTIVE) {
+            subscription.cancelAtPeriodEnd = true;
+        } else if (subscription.status === SubscriptionStatus.PAST_DUE) {
+            subscription.status = SubscriptionStatus.CANCELLED;
+        }
+        subscription.updatedAt = now();
+        return ok<Subscription>(withTier(subscription));
+    }),
+
+    http.get(`${API_BASE}/subscribers`, ({ request }) =>
+        pageOf(
+            subscriptions
+                .filter((s) => s.channelId === ME_USER_ID && isRunning(s))
+                .map(withTier),
+            request,
+        ),
+    ),
+];
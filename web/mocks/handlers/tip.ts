@@ -0,0 +1,72 @@
+import { http } from "msw";
+import { API_BASE, ok, notFound, badRequest } from "../utils";
+import { ME_USER_ID, now, otherUsers, uid, walletBalances } from "../db";
+import { SendTipRequest, Tip } from "@/types/tip";
+
+// mirrors the seeded fee rule: 5% rounded half up
+const FEE_BASIS_POINTS = 500;
+
+const tips: Tip[] = [];
+
+const pageOf = <T>(items: T[], request: Request) => {
+    const url = new URL(request.url);
+    const page = parseInt(url.searchParams.get("page") ?? "0");
+    const limit = parseInt(url.searchParams.get("limit") ?? "20");
+    return ok<T[]>(items.slice(page * limit, page * limit + limit), {
+        page,
+        page_size: limit,
+        total: items.length,
+    });
+};
+
+export const tipHandlers = [
+    http.post(`${API_BASE}/tips`, async ({ request }) => {
+        const body = (await request.json()) as SendTipRequest;
+        if (body.recipientId === ME_USER_ID)
+            return badRequest("res_err_invalid_input", "Invalid input");
+        if (!otherUsers.some((u) => u.id === body.recipientId))
+            return notFound(
+                "res_err_tip_recipient_not_found",
+                "Tip recipient not found",
+            );
+
+        const amount = parseFloat(body.amount);
+        if (!(amount > 0))
+            return badRequest("res_err_invalid_amount", "Invalid amount");
+
+        const balance = walletBalances.find(
+            (b) => b.currencyCode === body.currencyCode,
+        );
+        if (!balance || parseFloat(balance.balance) < amount)
+            return badRequest(
+                "res_err_insufficient_balance",
+                "Insufficient balance",
+            );
+        balance.balance = (parseFloat(balance.balance) - amount).toFixed(2);
+
+        const fee = Math.round((amount * 100 * FEE_BASIS_POINTS) / 10000) / 100;
+        const tip: Tip = {
+            id: `tip-${uid()}`,
+            transactionId: `tx-${uid()}`,
+            senderId: ME_USER_ID,
+            recipientId: body.recipientId,
+            currencyCode: body.currencyCode,
+            amount: amount.toFixed(2),
+            creatorAmount: (amount - fee).toFixed(2),
+            feeAmount: fee.toFixed(2),
+            feeRuleId: "fee-rule-001",
+            message: body.message?.trim() || null,
+            createdAt: now(),
+        };
+        tips.unshift(tip);
+        return ok<Tip>(tip);
+    }),
+
+    http.get(`${API_BASE}/tips/received`, ({ request }) =>
+        pageOf(tips.filter((t) => t.recipientId === ME_USER_ID), request),
+    ),
+
+    http.get(`${API_BASE}/tips/sent`, ({ request }) =>
+        pageOf(tips.filter((t) => t.senderId === ME_USER_ID), request),
+    ),
+];
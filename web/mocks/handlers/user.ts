@@ -7,13 +7,18 @@ import {
     now,
     notifications,
     likedCommentIds,
+    livestreams,
+    vods,
 } from "../db";
-import { MeUser, PublicUser } from "@/types/user";
+import { FollowingFeedItem, MeUser, PublicUser } from "@/types/user";
 import { Notification, UnreadCountResponse } from "@/types/notification";
 
 // Combined list for look-ups
 const getAllUsers = (): (PublicUser | MeUser)[] => [meUser, ...otherUsers];
 
+// Users blocked by meUser, the latest blocked first
+const blockedUserIds: string[] = [];
+
 export const userHandlers = [
     // GET /user/me
     http.get(`${API_BASE}/user/me`, () => {
@@ -54,6 +59,12 @@ export const userHandlers = [
                 meUser.livestreamInformation.description = description;
             if (thumbnailUrl !== null)
                 meUser.livestreamInformation.thumbnailUrl = thumbnailUrl;
+            const category = formData.get("category") as string | null;
+            const tags = formData.getAll("tags") as string[];
+            if (category !== null)
+                meUser.livestreamInformation.category = category || null;
+            if (formData.has("tags"))
+                meUser.livestreamInformation.tags = tags.filter(Boolean);
             return ok(meUser.livestreamInformation);
         },
     ),
@@ -98,6 +109,37 @@ export const userHandlers = [
         return noContent();
     }),
 
+    // POST /user/:blockedId/block
+    http.post(`${API_BASE}/user/:blockedId/block`, ({ params }) => {
+        const { blockedId } = params as { blockedId: string };
+        const user = otherUsers.find((u) => u.id === blockedId);
+        if (!user) return notFound("res_err_user_not_found", "User not found");
+        if (!blockedUserIds.includes(blockedId)) {
+            blockedUserIds.unshift(blockedId);
+        }
+        if (user.isFollowing) {
+            user.isFollowing = false;
+            user.followerCount = Math.max(0, user.followerCount - 1);
+        }
+        return noContent();
+    }),
+
+    // DELETE /user/:blockedId/unblock
+    http.delete(`${API_BASE}/user/:blockedId/unblock`, ({ params }) => {
+        const { blockedId } = params as { blockedId: string };
+        const index = blockedUserIds.indexOf(blockedId);
+        if (index !== -1) blockedUserIds.splice(index, 1);
+        return noContent();
+    }),
+
+    // GET /user/me/blocks
+    http.get(`${API_BASE}/user/me/blocks`, () => {
+        const blocked = blockedUserIds
+            .map((id) => otherUsers.find((u) => u.id === id))
+            .filter((u): u is PublicUser => u !== undefined);
+        return ok<PublicUser[]>(blocked);
+    }),
+
     // GET /users/search?username=
     http.get(`${API_BASE}/users/search`, ({ request }) => {
         const url = new URL(request.url);
@@ -123,6 +165,45 @@ export const userHandlers = [
         return ok<PublicUser[]>(following);
     }),
 
+    // GET /user/me/following/feed?cursor=&limit= — the cursor is an offset here
+    http.get(`${API_BASE}/user/me/following/feed`, ({ request }) => {
+        const url = new URL(request.url);
+        const offset = parseInt(url.searchParams.get("cursor") ?? "0");
+        const limit = parseInt(url.searchParams.get("limit") ?? "20");
+        const followed = new Set(
+            otherUsers.filter((u) => u.isFollowing).map((u) => u.id),
+        );
+
+        const feed: FollowingFeedItem[] = [
+            ...livestreams
+                .filter(
+                    (ls) =>
+                        followed.has(ls.userId) &&
+                        ls.endedAt === null &&
+                        ls.visibility === "public",
+                )
+                .sort((a, b) => b.startedAt.localeCompare(a.startedAt))
+                .map((livestream) => ({
+                    type: "livestream" as const,
+                    livestream,
+                })),
+            ...vods
+                .filter(
+                    (v) =>
+                        followed.has(v.userId) &&
+                        v.visibility === "public" &&
+                        v.status === "ready",
+                )
+                .sort((a, b) => b.createdAt.localeCompare(a.createdAt))
+                .map((vod) => ({ type: "vod" as const, vod })),
+        ];
+
+        const next = offset + limit;
+        return ok<FollowingFeedItem[]>(feed.slice(offset, next), {
+            next_cursor: next < feed.length ? String(next) : undefined,
+        });
+    }),
+
     // -------------------------------------------------------------------------
     // Notifications
     // -------------------------------------------------------------------------
@@ -37,21 +37,23 @@ export const vodHandlers = [
         });
     }),
 
-    // GET /popular-vods
+    // GET /popular-vods?cursor=&limit=&category=&tag=
     http.get(`${API_BASE}/popular-vods`, ({ request }) => {
         const url = new URL(request.url);
-        const page = parseInt(url.searchParams.get("page") ?? "0");
+        const offset = parseInt(url.searchParams.get("cursor") ?? "0");
         const limit = parseInt(url.searchParams.get("limit") ?? "10");
+        const category = url.searchParams.get("category");
+        const tag = url.searchParams.get("tag");
 
         const sorted = [...vods]
             .filter((v) => v.visibility === "public" && v.status === "ready")
+            .filter((v) => !category || v.category === category)
+            .filter((v) => !tag || v.tags.includes(tag))
             .sort((a, b) => b.viewCount - a.viewCount);
 
-        const slice = sorted.slice(page * limit, page * limit + limit);
-        return ok<VOD[]>(slice, {
-            page,
-            page_size: limit,
-            total: sorted.length,
+        const next = offset + limit;
+        return ok<VOD[]>(sorted.slice(offset, next), {
+            next_cursor: next < sorted.length ? String(next) : undefined,
         });
     }),
 
@@ -94,6 +96,8 @@ export const vodHandlers = [
             description: (formData.get("description") as string) ?? null,
             visibility: ((formData.get("visibility") as string) ??
                 "public") as VOD["visibility"],
+            category: null,
+            tags: [],
             thumbnailUrl: null,
             viewCount: 0,
             duration: 0,
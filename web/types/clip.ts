@@ -0,0 +1,31 @@
+export type ClipMode = "copy" | "reencode";
+
+export type ClipStatus = "pending" | "processing" | "ready" | "failed";
+
+export type Clip = {
+    id: string;
+    userId: string; // the author of the clip
+    channelId: string; // the owner of the source
+    sourceVodId?: string;
+    sourceLivestreamId?: string;
+    title: string;
+    startSeconds: number; // offset from the start of the source, the start of the stream for a livestream
+    endSeconds: number;
+    mode: ClipMode;
+    status: ClipStatus;
+    playbackUrl: string | null;
+    thumbnailUrl: string | null;
+    duration: number;
+    viewCount: number;
+    createdAt: string; // ISO 8601 timestamp
+    updatedAt: string; // ISO 8601 timestamp
+};
+
+export type CreateClipRequest = {
+    vodId?: string;
+    livestreamId?: string;
+    title: string;
+    startSeconds: number;
+    endSeconds: number;
+    mode?: ClipMode;
+};
@@ -2,6 +2,7 @@ export type Meta = {
     page?: number;
     page_size?: number;
     total?: number;
+    next_cursor?: string; // opaque cursor of the next page of a ranked listing
 };
 
 export type ErrorDetail = Record<string, any>;
@@ -4,11 +4,42 @@ export type Livestream = {
     title: string;
     description: string | null;
     thumbnailUrl: string | null;
-    viewCount: number;
+    viewCount: number; // sessions that watched the livestream
+    viewerCount: number; // sessions watching it right now
+    peakViewerCount: number;
     visibility: "public" | "private";
+    category: string | null; // slug of a category
+    tags: string[];
     startedAt: string;
     endedAt: string | null;
     createdAt: string;
     updatedAt: string;
     vodId: string | null;
 };
+
+// fields left out are not changed, an empty category removes it
+export type LivestreamUpdate = {
+    title?: string;
+    description?: string;
+    thumbnailUrl?: string;
+    visibility?: "public" | "private";
+    category?: string;
+    tags?: string[];
+};
+
+export type ViewerHeartbeat = {
+    viewerCount: number;
+    heartbeatIntervalSeconds: number; // when to send the next heartbeat
+};
+
+export type Category = {
+    slug: string;
+    name: string;
+    liveCount: number; // public streams live in the category
+    viewerCount: number;
+};
+
+export type BrowseFilter = {
+    category?: string;
+    tag?: string;
+};
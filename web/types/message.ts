@@ -17,4 +17,7 @@ export type ReceivedMessage = {
     username: string;
     text: string;
     timestamp: number;
+    // only set on donation alerts, amount is a decimal string
+    amount?: string;
+    currencyCode?: string;
 };
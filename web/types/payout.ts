@@ -0,0 +1,48 @@
+export type KYCStatus = "pending" | "verified" | "rejected";
+
+export type PayoutAccount = {
+    ownerId: string;
+    provider: string;
+    destination: string; // the creator's account at the provider
+    kycStatus: KYCStatus;
+    createdAt: string;
+    updatedAt: string;
+};
+
+export type SetPayoutAccountRequest = {
+    provider: string;
+    destination: string;
+};
+
+export enum PayoutStatus {
+    PENDING = "pending",
+    APPROVED = "approved",
+    PROCESSING = "processing",
+    COMPLETED = "completed",
+    FAILED = "failed",
+    REJECTED = "rejected",
+    CANCELLED = "cancelled",
+}
+
+export type Payout = {
+    id: string;
+    ownerId: string;
+    currencyCode: string;
+    amount: string;
+    status: PayoutStatus;
+    provider: string;
+    destination: string;
+    providerReference: string | null;
+    holdTransactionId: string;
+    reversalTransactionId: string | null; // set once the held amount is given back
+    failureReason: string | null;
+    reviewedBy: string | null;
+    reviewedAt: string | null;
+    createdAt: string;
+    updatedAt: string;
+};
+
+export type RequestPayoutRequest = {
+    amount: string;
+    currencyCode: string;
+};
@@ -0,0 +1,12 @@
+import { Livestream } from "./livestream";
+import { PublicUser } from "./user";
+import { VOD } from "./vod";
+
+export type SearchType = "all" | "channel" | "livestream" | "vod";
+
+// a page of every type searched, each one ranked on its own; the types not searched are empty
+export type SearchResults = {
+    channels: PublicUser[];
+    livestreams: Livestream[];
+    vods: VOD[];
+};
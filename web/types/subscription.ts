@@ -0,0 +1,58 @@
+export enum SubscriptionStatus {
+    PENDING = "pending",
+    ACTIVE = "active",
+    PAST_DUE = "past_due",
+    CANCELLED = "cancelled",
+    EXPIRED = "expired",
+}
+
+export type SubscriptionTier = {
+    id: string;
+    channelId: string;
+    name: string;
+    description: string | null;
+    price: string; // decimal amount of currencyCode charged every month
+    currencyCode: string;
+    isActive: boolean;
+    createdAt: string;
+    updatedAt: string;
+};
+
+export type Subscription = {
+    id: string;
+    subscriberId: string;
+    channelId: string;
+    tierId: string;
+    status: SubscriptionStatus;
+    months: number;
+    currentPeriodStart: string;
+    currentPeriodEnd: string;
+    cancelAtPeriodEnd: boolean;
+    failedAttempts: number;
+    /** Set while past due, the subscription expires when no renewal succeeded by then. */
+    graceUntil: string | null;
+    createdAt: string;
+    updatedAt: string;
+    tier: SubscriptionTier | null;
+};
+
+/** What a channel shows next to one of its subscribers. */
+export type SubscriberBadge = {
+    tierId: string;
+    tierName: string;
+    months: number;
+    status: SubscriptionStatus;
+};
+
+export type CreateSubscriptionTierRequest = {
+    name: string;
+    description?: string;
+    price: string;
+    currencyCode: string;
+};
+
+export type UpdateSubscriptionTierRequest = {
+    name?: string;
+    description?: string;
+    price?: string;
+};
@@ -0,0 +1,20 @@
+export type Tip = {
+    id: string;
+    transactionId: string;
+    senderId: string;
+    recipientId: string;
+    currencyCode: string;
+    amount: string; // decimal amount the sender gave
+    creatorAmount: string; // what the creator received after the platform fee
+    feeAmount: string;
+    feeRuleId: string | null;
+    message: string | null;
+    createdAt: string;
+};
+
+export type SendTipRequest = {
+    recipientId: string;
+    amount: string;
+    currencyCode: string;
+    message?: string;
+};
@@ -1,3 +1,7 @@
+import { Livestream } from "./livestream";
+import { VOD } from "./vod";
+import { SubscriberBadge } from "./subscription";
+
 export type SocialMediaLinks = {
     facebook?: string;
     twitter?: string;
@@ -13,6 +17,8 @@ export type LivestreamInformation = {
     title: string | null;
     description: string | null;
     thumbnailUrl: string | null;
+    category: string | null;
+    tags: string[];
 };
 
 export enum AuthProvider {
@@ -45,6 +51,9 @@ export type PublicUser = BaseUser & {
 
     /** AUTH ONLY - indicates if the current user is following this public user*/
     isFollowing?: boolean;
+
+    /** AUTH ONLY - the current user's subscription to this public user's channel, if any */
+    subscription?: SubscriberBadge;
 };
 
 export type MeUser = BaseUser & {
@@ -57,3 +66,8 @@ export type MeUser = BaseUser & {
 
 /** Union for code that can receive either (e.g. profile header when viewing self vs others). */
 export type User = PublicUser | MeUser;
+
+/** A live stream or a recent VOD of a followed channel, the live streams come first. */
+export type FollowingFeedItem =
+    | { type: "livestream"; livestream: Livestream }
+    | { type: "vod"; vod: VOD };
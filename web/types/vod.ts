@@ -8,11 +8,46 @@ export type VOD = {
     description: string | null;
     thumbnailUrl: string | null;
     visibility: "public" | "private";
+    category: string | null; // copied from the livestream it was recorded from
+    tags: string[];
     viewCount: number;
+    peakViewerCount?: number; // viewers of the livestream it was recorded from
+    averageViewerCount?: number;
     duration: number;
     playbackUrl: string | null;
+    previewTrackUrl?: string; // WebVTT track of the seek bar previews
+    chapters?: VODChapter[]; // only returned when a single vod is fetched
     status: VODStatus;
     originalFileUrl: string | null;
     createdAt: string; // ISO 8601 timestamp
     updatedAt: string; // ISO 8601 timestamp
 };
+
+export type VODUpload = {
+    id: string;
+    vodId: string;
+    filename: string;
+    status: "uploading" | "completing" | "expired";
+    totalSize: number;
+    partSize: number;
+    partCount: number;
+    uploadedParts: number[];
+    offset: number; // bytes received without a gap from the start of the file
+    expiresAt: string; // ISO 8601 timestamp
+};
+
+export type VODThumbnails = {
+    thumbnailUrl: string | null;
+    candidates: string[]; // frames taken at 10/30/50/70/90% of the vod
+};
+
+export type VODRange = {
+    startSeconds: number;
+    endSeconds: number;
+};
+
+// a chapter runs until the start of the next one or the end of the vod
+export type VODChapter = {
+    startSeconds: number;
+    title: string;
+};
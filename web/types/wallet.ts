@@ -63,6 +63,8 @@ export enum TransactionType {
     REFUND = "refund",
     FEE = "fee",
     ADJUSTMENT = "adjustment",
+    SUBSCRIPTION = "subscription",
+    PAYOUT = "payout",
 }
 
 export enum TransactionStatus {
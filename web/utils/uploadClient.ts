@@ -27,14 +27,15 @@ export class UploadClientError extends Error {
  */
 export function uploadWithProgress<T = ApiResponse<unknown>>(
     path: string,
-    formData: FormData,
+    body: FormData | Blob,
     onProgress?: UploadProgressCallback,
+    method: "POST" | "PUT" = "POST",
 ): { promise: Promise<T>; abort: () => void } {
     const url = path.startsWith("http") ? path : GLOBAL.API_URL + path;
     const xhr = new XMLHttpRequest();
 
     const promise = new Promise<T>((resolve, reject) => {
-        xhr.open("POST", url);
+        xhr.open(method, url);
         xhr.withCredentials = true;
         xhr.setRequestHeader("Cache-Control", "no-store");
 
@@ -78,7 +79,7 @@ export function uploadWithProgress<T = ApiResponse<unknown>>(
             );
         });
 
-        xhr.send(formData);
+        xhr.send(body);
     });
 
     return { promise, abort: () => xhr.abort() };